@@ -3,16 +3,21 @@ package config
 import (
 	"fmt"
 	"github/kijunpos/config/db"
+	"github/kijunpos/config/redis"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
 type (
 	App struct {
-		Port    int
-		Env     string
-		Name    string
-		Version string
+		Port              int
+		HTTPPort          int
+		Env               string
+		Name              string
+		Version           string
+		DevMode           bool
+		TrustProxyHeaders bool
 	}
 	Otel struct {
 		ApiKey      string
@@ -31,11 +36,76 @@ type (
 		SMTPPassword string
 	}
 
+	WhatsApp struct {
+		Provider         string
+		APIURL           string
+		PhoneNumberID    string
+		AccessToken      string
+		TemplateName     string
+		TemplateLanguage string
+		Timeout          time.Duration
+	}
+
+	Payment struct {
+		QRISProvider      string
+		QRISAPIURL        string
+		QRISAPIKey        string
+		QRISWebhookSecret string
+		QRISExpiry        time.Duration
+		QRISTimeout       time.Duration
+		// The simulator runs on QRISSimulatorPort and sends notifications
+		// to QRISSimulatorWebhookURL
+		QRISSimulatorPort       int
+		QRISSimulatorWebhookURL string
+	}
+
+	Refund struct {
+		// Refunds taking the refunded total of a sale above ApprovalThreshold
+		// need the approval of a manager
+		ApprovalThreshold int64
+	}
+
+	Token struct {
+		Issuer          string
+		SigningKeyID    string
+		SigningKey      string
+		AccessTokenTTL  time.Duration
+		RefreshTokenTTL time.Duration
+	}
+
+	Verification struct {
+		Store           string
+		CodeSecret      string
+		MaxAttempts     int
+		ResendCooldown  time.Duration
+		DailyLimit      int
+		IPDailyLimit    int
+		MinResponseTime time.Duration
+	}
+
+	Lockout struct {
+		MaxFailedAttempts int
+		BaseLockDuration  time.Duration
+		MaxLockDuration   time.Duration
+	}
+
+	Authorization struct {
+		PermissionCacheTTL time.Duration
+	}
+
 	Config struct {
-		App       App
-		Otel      Otel
-		Email     Email
-		Databases []db.Config
+		App           App
+		Otel          Otel
+		Email         Email
+		WhatsApp      WhatsApp
+		Payment       Payment
+		Refund        Refund
+		Token         Token
+		Lockout       Lockout
+		Verification  Verification
+		Authorization Authorization
+		Databases     []db.Config
+		Redis         redis.Config
 	}
 )
 
@@ -53,10 +123,13 @@ func LoadConfig() error {
 
 	configData = &Config{
 		App: App{
-			Port:    getRequiredInt("APP_PORT"),
-			Env:     getRequiredString("APP_ENV"),
-			Name:    getRequiredString("APP_NAME"),
-			Version: getRequiredString("APP_VERSION"),
+			Port:              getRequiredInt("APP_PORT"),
+			HTTPPort:          getRequiredInt("APP_HTTP_PORT"),
+			Env:               getRequiredString("APP_ENV"),
+			Name:              getRequiredString("APP_NAME"),
+			Version:           getRequiredString("APP_VERSION"),
+			DevMode:           getRequiredBool("APP_DEV_MODE"),
+			TrustProxyHeaders: getRequiredBool("APP_TRUST_PROXY_HEADERS"),
 		},
 		Otel: Otel{
 			ApiKey:      getRequiredString("OTEL_API_KEY"),
@@ -73,6 +146,52 @@ func LoadConfig() error {
 			SenderName:   getRequiredString("EMAIL_SENDER_NAME"),
 			SMTPPassword: getRequiredString("EMAIL_SMTP_PASSWORD"),
 		},
+		WhatsApp: WhatsApp{
+			Provider:         getRequiredString("WHATSAPP_PROVIDER"),
+			APIURL:           getRequiredString("WHATSAPP_API_URL"),
+			PhoneNumberID:    getRequiredString("WHATSAPP_PHONE_NUMBER_ID"),
+			AccessToken:      getRequiredString("WHATSAPP_ACCESS_TOKEN"),
+			TemplateName:     getRequiredString("WHATSAPP_TEMPLATE_NAME"),
+			TemplateLanguage: getRequiredString("WHATSAPP_TEMPLATE_LANGUAGE"),
+			Timeout:          getRequiredDuration("WHATSAPP_TIMEOUT"),
+		},
+		Payment: Payment{
+			QRISProvider:            getRequiredString("PAYMENT_QRIS_PROVIDER"),
+			QRISAPIURL:              getRequiredString("PAYMENT_QRIS_API_URL"),
+			QRISAPIKey:              getRequiredString("PAYMENT_QRIS_API_KEY"),
+			QRISWebhookSecret:       getRequiredString("PAYMENT_QRIS_WEBHOOK_SECRET"),
+			QRISExpiry:              getRequiredDuration("PAYMENT_QRIS_EXPIRY"),
+			QRISTimeout:             getRequiredDuration("PAYMENT_QRIS_TIMEOUT"),
+			QRISSimulatorPort:       getRequiredInt("PAYMENT_QRIS_SIMULATOR_PORT"),
+			QRISSimulatorWebhookURL: getRequiredString("PAYMENT_QRIS_SIMULATOR_WEBHOOK_URL"),
+		},
+		Refund: Refund{
+			ApprovalThreshold: int64(getRequiredInt("REFUND_APPROVAL_THRESHOLD")),
+		},
+		Token: Token{
+			Issuer:          getRequiredString("TOKEN_ISSUER"),
+			SigningKeyID:    getRequiredString("TOKEN_SIGNING_KEY_ID"),
+			SigningKey:      getRequiredString("TOKEN_SIGNING_KEY"),
+			AccessTokenTTL:  getRequiredDuration("TOKEN_ACCESS_TTL"),
+			RefreshTokenTTL: getRequiredDuration("TOKEN_REFRESH_TTL"),
+		},
+		Lockout: Lockout{
+			MaxFailedAttempts: getRequiredInt("LOCKOUT_MAX_FAILED_ATTEMPTS"),
+			BaseLockDuration:  getRequiredDuration("LOCKOUT_BASE_DURATION"),
+			MaxLockDuration:   getRequiredDuration("LOCKOUT_MAX_DURATION"),
+		},
+		Authorization: Authorization{
+			PermissionCacheTTL: getRequiredDuration("AUTHORIZATION_PERMISSION_CACHE_TTL"),
+		},
+		Verification: Verification{
+			Store:           getRequiredString("VERIFICATION_STORE"),
+			CodeSecret:      getRequiredString("VERIFICATION_CODE_SECRET"),
+			MaxAttempts:     getRequiredInt("VERIFICATION_MAX_ATTEMPTS"),
+			ResendCooldown:  getRequiredDuration("VERIFICATION_RESEND_COOLDOWN"),
+			DailyLimit:      getRequiredInt("VERIFICATION_DAILY_LIMIT"),
+			IPDailyLimit:    getRequiredInt("VERIFICATION_IP_DAILY_LIMIT"),
+			MinResponseTime: getRequiredDuration("VERIFICATION_MIN_RESPONSE_TIME"),
+		},
 		Databases: []db.Config{
 			{
 				Name:        db.KIJUNDB,
@@ -81,6 +200,9 @@ func LoadConfig() error {
 				MaxOpenConn: getRequiredInt("KIJUNDB_MAX_OPEN_CONNECTIONS"),
 			},
 		},
+		Redis: redis.Config{
+			URL: getRequiredString("REDIS_URL"),
+		},
 	}
 
 	return nil
@@ -92,7 +214,7 @@ func GetConfig() *Config {
 
 // ConfigValue adalah interface constraint untuk tipe nilai yang didukung
 type ConfigValue interface {
-	string | int | bool
+	string | int | bool | time.Duration
 }
 
 // getRequired adalah fungsi generic untuk mengambil nilai konfigurasi yang required
@@ -115,3 +237,7 @@ func getRequiredInt(key string) int {
 func getRequiredBool(key string) bool {
 	return getRequired(key, viper.GetBool)
 }
+
+func getRequiredDuration(key string) time.Duration {
+	return getRequired(key, viper.GetDuration)
+}
@@ -0,0 +1,29 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// Config holds the configuration for the Redis connection
+type Config struct {
+	URL string
+}
+
+// NewClient connects to Redis and verifies the connection
+func NewClient(ctx context.Context, config Config) (*goredis.Client, error) {
+	options, err := goredis.ParseURL(config.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse redis url: %w", err)
+	}
+
+	client := goredis.NewClient(options)
+	if err := client.Ping(ctx).Err(); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("failed ping connection to redis: %w", err)
+	}
+
+	return client, nil
+}
@@ -0,0 +1,2187 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: proto/inventory/inventory.proto
+
+package inventory
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _inventory_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on InventoryResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *InventoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InventoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InventoryResponseMultiError, or nil if none found.
+func (m *InventoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InventoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return InventoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InventoryResponseMultiError is an error wrapping multiple validation errors
+// returned by InventoryResponse.ValidateAll() if the designated constraints
+// aren't met.
+type InventoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InventoryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InventoryResponseMultiError) AllErrors() []error { return m }
+
+// InventoryResponseValidationError is the validation error returned by
+// InventoryResponse.Validate if the designated constraints aren't met.
+type InventoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InventoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InventoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InventoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InventoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InventoryResponseValidationError) ErrorName() string {
+	return "InventoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InventoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInventoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InventoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InventoryResponseValidationError{}
+
+// Validate checks the field values on StockLevelData with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StockLevelData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StockLevelData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StockLevelDataMultiError,
+// or nil if none found.
+func (m *StockLevelData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StockLevelData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for VariantId
+
+	// no validation rules for Sku
+
+	// no validation rules for VariantName
+
+	// no validation rules for ProductName
+
+	// no validation rules for OnHand
+
+	// no validation rules for LowStock
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StockLevelDataValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StockLevelDataValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StockLevelDataValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.LowStockThreshold != nil {
+		// no validation rules for LowStockThreshold
+	}
+
+	if len(errors) > 0 {
+		return StockLevelDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// StockLevelDataMultiError is an error wrapping multiple validation errors
+// returned by StockLevelData.ValidateAll() if the designated constraints
+// aren't met.
+type StockLevelDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockLevelDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockLevelDataMultiError) AllErrors() []error { return m }
+
+// StockLevelDataValidationError is the validation error returned by
+// StockLevelData.Validate if the designated constraints aren't met.
+type StockLevelDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockLevelDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockLevelDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockLevelDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockLevelDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockLevelDataValidationError) ErrorName() string { return "StockLevelDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StockLevelDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStockLevelData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockLevelDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockLevelDataValidationError{}
+
+// Validate checks the field values on StockMovementData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StockMovementData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StockMovementData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StockMovementDataMultiError, or nil if none found.
+func (m *StockMovementData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StockMovementData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OutletId
+
+	// no validation rules for VariantId
+
+	// no validation rules for Type
+
+	// no validation rules for Quantity
+
+	// no validation rules for BalanceAfter
+
+	// no validation rules for ReferenceId
+
+	// no validation rules for Note
+
+	// no validation rules for CreatedBy
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StockMovementDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StockMovementDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StockMovementDataValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StockMovementDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// StockMovementDataMultiError is an error wrapping multiple validation errors
+// returned by StockMovementData.ValidateAll() if the designated constraints
+// aren't met.
+type StockMovementDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockMovementDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockMovementDataMultiError) AllErrors() []error { return m }
+
+// StockMovementDataValidationError is the validation error returned by
+// StockMovementData.Validate if the designated constraints aren't met.
+type StockMovementDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockMovementDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockMovementDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockMovementDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockMovementDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockMovementDataValidationError) ErrorName() string {
+	return "StockMovementDataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StockMovementDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStockMovementData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockMovementDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockMovementDataValidationError{}
+
+// Validate checks the field values on StockItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StockItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StockItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StockItemMultiError, or nil
+// if none found.
+func (m *StockItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StockItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetVariantId()); err != nil {
+		err = StockItemValidationError{
+			field:  "VariantId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Quantity
+
+	if len(errors) > 0 {
+		return StockItemMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *StockItem) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// StockItemMultiError is an error wrapping multiple validation errors returned
+// by StockItem.ValidateAll() if the designated constraints aren't met.
+type StockItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockItemMultiError) AllErrors() []error { return m }
+
+// StockItemValidationError is the validation error returned by
+// StockItem.Validate if the designated constraints aren't met.
+type StockItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockItemValidationError) ErrorName() string { return "StockItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StockItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStockItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockItemValidationError{}
+
+// Validate checks the field values on ListStockRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListStockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStockRequestMultiError, or nil if none found.
+func (m *ListStockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetVariantIds()) > 100 {
+		err := ListStockRequestValidationError{
+			field:  "VariantIds",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetVariantIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = ListStockRequestValidationError{
+				field:  fmt.Sprintf("VariantIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for LowStockOnly
+
+	if len(errors) > 0 {
+		return ListStockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListStockRequest) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListStockRequestMultiError is an error wrapping multiple validation errors
+// returned by ListStockRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListStockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStockRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStockRequestMultiError) AllErrors() []error { return m }
+
+// ListStockRequestValidationError is the validation error returned by
+// ListStockRequest.Validate if the designated constraints aren't met.
+type ListStockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStockRequestValidationError) ErrorName() string { return "ListStockRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListStockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStockRequestValidationError{}
+
+// Validate checks the field values on ListStockResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListStockResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListStockResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStockResponseMultiError, or nil if none found.
+func (m *ListStockResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListStockResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetStock() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListStockResponseValidationError{
+						field:  fmt.Sprintf("Stock[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListStockResponseValidationError{
+						field:  fmt.Sprintf("Stock[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStockResponseValidationError{
+					field:  fmt.Sprintf("Stock[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListStockResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStockResponseMultiError is an error wrapping multiple validation errors
+// returned by ListStockResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListStockResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStockResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStockResponseMultiError) AllErrors() []error { return m }
+
+// ListStockResponseValidationError is the validation error returned by
+// ListStockResponse.Validate if the designated constraints aren't met.
+type ListStockResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListStockResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListStockResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListStockResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListStockResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListStockResponseValidationError) ErrorName() string {
+	return "ListStockResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListStockResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListStockResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListStockResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListStockResponseValidationError{}
+
+// Validate checks the field values on AdjustStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdjustStockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdjustStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdjustStockRequestMultiError, or nil if none found.
+func (m *AdjustStockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdjustStockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetVariantId()); err != nil {
+		err = AdjustStockRequestValidationError{
+			field:  "VariantId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AdjustStockRequest_Quantity_NotInLookup[m.GetQuantity()]; ok {
+		err := AdjustStockRequestValidationError{
+			field:  "Quantity",
+			reason: "value must not be in list [0]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetNote()); l < 1 || l > 255 {
+		err := AdjustStockRequestValidationError{
+			field:  "Note",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AdjustStockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AdjustStockRequest) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AdjustStockRequestMultiError is an error wrapping multiple validation errors
+// returned by AdjustStockRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AdjustStockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdjustStockRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdjustStockRequestMultiError) AllErrors() []error { return m }
+
+// AdjustStockRequestValidationError is the validation error returned by
+// AdjustStockRequest.Validate if the designated constraints aren't met.
+type AdjustStockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdjustStockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdjustStockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdjustStockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdjustStockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdjustStockRequestValidationError) ErrorName() string {
+	return "AdjustStockRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdjustStockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdjustStockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdjustStockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdjustStockRequestValidationError{}
+
+var _AdjustStockRequest_Quantity_NotInLookup = map[int64]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ReceiveStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReceiveStockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReceiveStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReceiveStockRequestMultiError, or nil if none found.
+func (m *ReceiveStockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReceiveStockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetItems()); l < 1 || l > 200 {
+		err := ReceiveStockRequestValidationError{
+			field:  "Items",
+			reason: "value must contain between 1 and 200 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReceiveStockRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReceiveStockRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReceiveStockRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetNote()) > 255 {
+		err := ReceiveStockRequestValidationError{
+			field:  "Note",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReceiveStockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReceiveStockRequestMultiError is an error wrapping multiple validation
+// errors returned by ReceiveStockRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReceiveStockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReceiveStockRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReceiveStockRequestMultiError) AllErrors() []error { return m }
+
+// ReceiveStockRequestValidationError is the validation error returned by
+// ReceiveStockRequest.Validate if the designated constraints aren't met.
+type ReceiveStockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReceiveStockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReceiveStockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReceiveStockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReceiveStockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReceiveStockRequestValidationError) ErrorName() string {
+	return "ReceiveStockRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReceiveStockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReceiveStockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReceiveStockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReceiveStockRequestValidationError{}
+
+// Validate checks the field values on StockTakeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StockTakeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StockTakeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StockTakeRequestMultiError, or nil if none found.
+func (m *StockTakeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StockTakeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetItems()); l < 1 || l > 500 {
+		err := StockTakeRequestValidationError{
+			field:  "Items",
+			reason: "value must contain between 1 and 500 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StockTakeRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StockTakeRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StockTakeRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetNote()) > 255 {
+		err := StockTakeRequestValidationError{
+			field:  "Note",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StockTakeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StockTakeRequestMultiError is an error wrapping multiple validation errors
+// returned by StockTakeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StockTakeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockTakeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockTakeRequestMultiError) AllErrors() []error { return m }
+
+// StockTakeRequestValidationError is the validation error returned by
+// StockTakeRequest.Validate if the designated constraints aren't met.
+type StockTakeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockTakeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockTakeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockTakeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockTakeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockTakeRequestValidationError) ErrorName() string { return "StockTakeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StockTakeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStockTakeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockTakeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockTakeRequestValidationError{}
+
+// Validate checks the field values on StockMovementsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StockMovementsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StockMovementsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StockMovementsResponseMultiError, or nil if none found.
+func (m *StockMovementsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StockMovementsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetMovements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StockMovementsResponseValidationError{
+						field:  fmt.Sprintf("Movements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StockMovementsResponseValidationError{
+						field:  fmt.Sprintf("Movements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StockMovementsResponseValidationError{
+					field:  fmt.Sprintf("Movements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return StockMovementsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StockMovementsResponseMultiError is an error wrapping multiple validation
+// errors returned by StockMovementsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StockMovementsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockMovementsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockMovementsResponseMultiError) AllErrors() []error { return m }
+
+// StockMovementsResponseValidationError is the validation error returned by
+// StockMovementsResponse.Validate if the designated constraints aren't met.
+type StockMovementsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockMovementsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockMovementsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockMovementsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockMovementsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockMovementsResponseValidationError) ErrorName() string {
+	return "StockMovementsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StockMovementsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStockMovementsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockMovementsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockMovementsResponseValidationError{}
+
+// Validate checks the field values on TransferStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransferStockRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransferStockRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransferStockRequestMultiError, or nil if none found.
+func (m *TransferStockRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransferStockRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetToOutletId()); err != nil {
+		err = TransferStockRequestValidationError{
+			field:  "ToOutletId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetItems()); l < 1 || l > 200 {
+		err := TransferStockRequestValidationError{
+			field:  "Items",
+			reason: "value must contain between 1 and 200 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TransferStockRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TransferStockRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TransferStockRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetNote()) > 255 {
+		err := TransferStockRequestValidationError{
+			field:  "Note",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TransferStockRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *TransferStockRequest) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// TransferStockRequestMultiError is an error wrapping multiple validation
+// errors returned by TransferStockRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TransferStockRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransferStockRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransferStockRequestMultiError) AllErrors() []error { return m }
+
+// TransferStockRequestValidationError is the validation error returned by
+// TransferStockRequest.Validate if the designated constraints aren't met.
+type TransferStockRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransferStockRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransferStockRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransferStockRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransferStockRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransferStockRequestValidationError) ErrorName() string {
+	return "TransferStockRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransferStockRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransferStockRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransferStockRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransferStockRequestValidationError{}
+
+// Validate checks the field values on TransferStockResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransferStockResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransferStockResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransferStockResponseMultiError, or nil if none found.
+func (m *TransferStockResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransferStockResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	// no validation rules for TransferId
+
+	// no validation rules for FromOutletId
+
+	// no validation rules for ToOutletId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransferStockResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransferStockResponseValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransferStockResponseValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TransferStockResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransferStockResponseMultiError is an error wrapping multiple validation
+// errors returned by TransferStockResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TransferStockResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransferStockResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransferStockResponseMultiError) AllErrors() []error { return m }
+
+// TransferStockResponseValidationError is the validation error returned by
+// TransferStockResponse.Validate if the designated constraints aren't met.
+type TransferStockResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransferStockResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransferStockResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransferStockResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransferStockResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransferStockResponseValidationError) ErrorName() string {
+	return "TransferStockResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransferStockResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransferStockResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransferStockResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransferStockResponseValidationError{}
+
+// Validate checks the field values on SetLowStockThresholdRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetLowStockThresholdRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetLowStockThresholdRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetLowStockThresholdRequestMultiError, or nil if none found.
+func (m *SetLowStockThresholdRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetLowStockThresholdRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetVariantId()); err != nil {
+		err = SetLowStockThresholdRequestValidationError{
+			field:  "VariantId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Threshold != nil {
+
+		if m.GetThreshold() < 0 {
+			err := SetLowStockThresholdRequestValidationError{
+				field:  "Threshold",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SetLowStockThresholdRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SetLowStockThresholdRequest) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SetLowStockThresholdRequestMultiError is an error wrapping multiple
+// validation errors returned by SetLowStockThresholdRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetLowStockThresholdRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetLowStockThresholdRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetLowStockThresholdRequestMultiError) AllErrors() []error { return m }
+
+// SetLowStockThresholdRequestValidationError is the validation error returned
+// by SetLowStockThresholdRequest.Validate if the designated constraints
+// aren't met.
+type SetLowStockThresholdRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetLowStockThresholdRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetLowStockThresholdRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetLowStockThresholdRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetLowStockThresholdRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetLowStockThresholdRequestValidationError) ErrorName() string {
+	return "SetLowStockThresholdRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetLowStockThresholdRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetLowStockThresholdRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetLowStockThresholdRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetLowStockThresholdRequestValidationError{}
+
+// Validate checks the field values on ListMovementsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMovementsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMovementsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMovementsRequestMultiError, or nil if none found.
+func (m *ListMovementsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMovementsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetVariantId() != "" {
+
+		if err := m._validateUuid(m.GetVariantId()); err != nil {
+			err = ListMovementsRequestValidationError{
+				field:  "VariantId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListMovementsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCursor()) > 200 {
+		err := ListMovementsRequestValidationError{
+			field:  "Cursor",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListMovementsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListMovementsRequest) _validateUuid(uuid string) error {
+	if matched := _inventory_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListMovementsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMovementsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMovementsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMovementsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMovementsRequestMultiError) AllErrors() []error { return m }
+
+// ListMovementsRequestValidationError is the validation error returned by
+// ListMovementsRequest.Validate if the designated constraints aren't met.
+type ListMovementsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMovementsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMovementsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMovementsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMovementsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMovementsRequestValidationError) ErrorName() string {
+	return "ListMovementsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMovementsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMovementsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMovementsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMovementsRequestValidationError{}
+
+// Validate checks the field values on ListMovementsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMovementsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMovementsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMovementsResponseMultiError, or nil if none found.
+func (m *ListMovementsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMovementsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetMovements() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMovementsResponseValidationError{
+						field:  fmt.Sprintf("Movements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMovementsResponseValidationError{
+						field:  fmt.Sprintf("Movements[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMovementsResponseValidationError{
+					field:  fmt.Sprintf("Movements[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListMovementsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMovementsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListMovementsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListMovementsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMovementsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMovementsResponseMultiError) AllErrors() []error { return m }
+
+// ListMovementsResponseValidationError is the validation error returned by
+// ListMovementsResponse.Validate if the designated constraints aren't met.
+type ListMovementsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMovementsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMovementsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMovementsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMovementsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMovementsResponseValidationError) ErrorName() string {
+	return "ListMovementsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMovementsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMovementsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMovementsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMovementsResponseValidationError{}
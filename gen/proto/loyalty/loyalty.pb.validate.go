@@ -0,0 +1,4053 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: proto/loyalty/loyalty.proto
+
+package loyalty
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _loyalty_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on TierData with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TierData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TierData with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TierDataMultiError, or nil
+// if none found.
+func (m *TierData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TierData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for MinSpend
+
+	// no validation rules for Multiplier
+
+	if len(errors) > 0 {
+		return TierDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// TierDataMultiError is an error wrapping multiple validation errors returned
+// by TierData.ValidateAll() if the designated constraints aren't met.
+type TierDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TierDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TierDataMultiError) AllErrors() []error { return m }
+
+// TierDataValidationError is the validation error returned by
+// TierData.Validate if the designated constraints aren't met.
+type TierDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TierDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TierDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TierDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TierDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TierDataValidationError) ErrorName() string { return "TierDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TierDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTierData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TierDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TierDataValidationError{}
+
+// Validate checks the field values on ProgramData with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProgramData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProgramData with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProgramDataMultiError, or
+// nil if none found.
+func (m *ProgramData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProgramData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IsActive
+
+	// no validation rules for EarnSpend
+
+	// no validation rules for EarnPoints
+
+	// no validation rules for PointValue
+
+	// no validation rules for MinRedeemPoints
+
+	// no validation rules for MaxRedeemPercent
+
+	// no validation rules for ExpiryDays
+
+	for idx, item := range m.GetTiers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProgramDataValidationError{
+						field:  fmt.Sprintf("Tiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProgramDataValidationError{
+						field:  fmt.Sprintf("Tiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProgramDataValidationError{
+					field:  fmt.Sprintf("Tiers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProgramDataValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProgramDataValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProgramDataValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ProgramDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProgramDataMultiError is an error wrapping multiple validation errors
+// returned by ProgramData.ValidateAll() if the designated constraints aren't met.
+type ProgramDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProgramDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProgramDataMultiError) AllErrors() []error { return m }
+
+// ProgramDataValidationError is the validation error returned by
+// ProgramData.Validate if the designated constraints aren't met.
+type ProgramDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProgramDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProgramDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProgramDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProgramDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProgramDataValidationError) ErrorName() string { return "ProgramDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProgramDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProgramData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProgramDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProgramDataValidationError{}
+
+// Validate checks the field values on CustomerData with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CustomerData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CustomerData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CustomerDataMultiError, or
+// nil if none found.
+func (m *CustomerData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CustomerData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for MerchantId
+
+	// no validation rules for UserId
+
+	// no validation rules for Name
+
+	// no validation rules for WhatsappNumber
+
+	// no validation rules for Email
+
+	// no validation rules for PointsBalance
+
+	// no validation rules for TotalSpend
+
+	// no validation rules for VisitCount
+
+	if all {
+		switch v := interface{}(m.GetLastPurchaseAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CustomerDataValidationError{
+					field:  "LastPurchaseAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CustomerDataValidationError{
+					field:  "LastPurchaseAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastPurchaseAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CustomerDataValidationError{
+				field:  "LastPurchaseAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Tier
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CustomerDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CustomerDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CustomerDataValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CustomerDataValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CustomerDataValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CustomerDataValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CustomerDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// CustomerDataMultiError is an error wrapping multiple validation errors
+// returned by CustomerData.ValidateAll() if the designated constraints aren't met.
+type CustomerDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CustomerDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CustomerDataMultiError) AllErrors() []error { return m }
+
+// CustomerDataValidationError is the validation error returned by
+// CustomerData.Validate if the designated constraints aren't met.
+type CustomerDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CustomerDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CustomerDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CustomerDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CustomerDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CustomerDataValidationError) ErrorName() string { return "CustomerDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CustomerDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCustomerData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CustomerDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CustomerDataValidationError{}
+
+// Validate checks the field values on PointEntryData with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PointEntryData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PointEntryData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PointEntryDataMultiError,
+// or nil if none found.
+func (m *PointEntryData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PointEntryData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	// no validation rules for Points
+
+	// no validation rules for BalanceAfter
+
+	// no validation rules for OrderId
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PointEntryDataValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PointEntryDataValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PointEntryDataValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Note
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PointEntryDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PointEntryDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PointEntryDataValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PointEntryDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// PointEntryDataMultiError is an error wrapping multiple validation errors
+// returned by PointEntryData.ValidateAll() if the designated constraints
+// aren't met.
+type PointEntryDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PointEntryDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PointEntryDataMultiError) AllErrors() []error { return m }
+
+// PointEntryDataValidationError is the validation error returned by
+// PointEntryData.Validate if the designated constraints aren't met.
+type PointEntryDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PointEntryDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PointEntryDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PointEntryDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PointEntryDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PointEntryDataValidationError) ErrorName() string { return "PointEntryDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PointEntryDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPointEntryData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PointEntryDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PointEntryDataValidationError{}
+
+// Validate checks the field values on OrderItemSummaryData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrderItemSummaryData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderItemSummaryData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderItemSummaryDataMultiError, or nil if none found.
+func (m *OrderItemSummaryData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderItemSummaryData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Quantity
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return OrderItemSummaryDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderItemSummaryDataMultiError is an error wrapping multiple validation
+// errors returned by OrderItemSummaryData.ValidateAll() if the designated
+// constraints aren't met.
+type OrderItemSummaryDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderItemSummaryDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderItemSummaryDataMultiError) AllErrors() []error { return m }
+
+// OrderItemSummaryDataValidationError is the validation error returned by
+// OrderItemSummaryData.Validate if the designated constraints aren't met.
+type OrderItemSummaryDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderItemSummaryDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderItemSummaryDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderItemSummaryDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderItemSummaryDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderItemSummaryDataValidationError) ErrorName() string {
+	return "OrderItemSummaryDataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderItemSummaryDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderItemSummaryData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderItemSummaryDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderItemSummaryDataValidationError{}
+
+// Validate checks the field values on OrderSummaryData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrderSummaryData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderSummaryData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderSummaryDataMultiError, or nil if none found.
+func (m *OrderSummaryData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderSummaryData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OutletId
+
+	// no validation rules for Number
+
+	// no validation rules for Status
+
+	// no validation rules for CustomerId
+
+	// no validation rules for Subtotal
+
+	// no validation rules for DiscountTotal
+
+	// no validation rules for Total
+
+	// no validation rules for RefundedTotal
+
+	// no validation rules for PointsRedeemed
+
+	// no validation rules for PointsDiscount
+
+	// no validation rules for PointsEarned
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderSummaryDataValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderSummaryDataValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderSummaryDataValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderSummaryDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderSummaryDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderSummaryDataValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCompletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderSummaryDataValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderSummaryDataValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCompletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderSummaryDataValidationError{
+				field:  "CompletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetVoidedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderSummaryDataValidationError{
+					field:  "VoidedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderSummaryDataValidationError{
+					field:  "VoidedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVoidedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderSummaryDataValidationError{
+				field:  "VoidedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrderSummaryDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderSummaryDataMultiError is an error wrapping multiple validation errors
+// returned by OrderSummaryData.ValidateAll() if the designated constraints
+// aren't met.
+type OrderSummaryDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderSummaryDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderSummaryDataMultiError) AllErrors() []error { return m }
+
+// OrderSummaryDataValidationError is the validation error returned by
+// OrderSummaryData.Validate if the designated constraints aren't met.
+type OrderSummaryDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderSummaryDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderSummaryDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderSummaryDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderSummaryDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderSummaryDataValidationError) ErrorName() string { return "OrderSummaryDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderSummaryDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderSummaryData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderSummaryDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderSummaryDataValidationError{}
+
+// Validate checks the field values on ProgramResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ProgramResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProgramResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProgramResponseMultiError, or nil if none found.
+func (m *ProgramResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProgramResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetProgram()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProgramResponseValidationError{
+					field:  "Program",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProgramResponseValidationError{
+					field:  "Program",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProgram()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProgramResponseValidationError{
+				field:  "Program",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ProgramResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProgramResponseMultiError is an error wrapping multiple validation errors
+// returned by ProgramResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ProgramResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProgramResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProgramResponseMultiError) AllErrors() []error { return m }
+
+// ProgramResponseValidationError is the validation error returned by
+// ProgramResponse.Validate if the designated constraints aren't met.
+type ProgramResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProgramResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProgramResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProgramResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProgramResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProgramResponseValidationError) ErrorName() string { return "ProgramResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProgramResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProgramResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProgramResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProgramResponseValidationError{}
+
+// Validate checks the field values on CustomerResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CustomerResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CustomerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CustomerResponseMultiError, or nil if none found.
+func (m *CustomerResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CustomerResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetCustomer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CustomerResponseValidationError{
+					field:  "Customer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CustomerResponseValidationError{
+					field:  "Customer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCustomer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CustomerResponseValidationError{
+				field:  "Customer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CustomerResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CustomerResponseMultiError is an error wrapping multiple validation errors
+// returned by CustomerResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CustomerResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CustomerResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CustomerResponseMultiError) AllErrors() []error { return m }
+
+// CustomerResponseValidationError is the validation error returned by
+// CustomerResponse.Validate if the designated constraints aren't met.
+type CustomerResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CustomerResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CustomerResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CustomerResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CustomerResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CustomerResponseValidationError) ErrorName() string { return "CustomerResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CustomerResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCustomerResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CustomerResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CustomerResponseValidationError{}
+
+// Validate checks the field values on OrderResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderResponseMultiError, or
+// nil if none found.
+func (m *OrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderResponseValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderResponseValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderResponseValidationError{
+				field:  "Order",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderResponseMultiError is an error wrapping multiple validation errors
+// returned by OrderResponse.ValidateAll() if the designated constraints
+// aren't met.
+type OrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderResponseMultiError) AllErrors() []error { return m }
+
+// OrderResponseValidationError is the validation error returned by
+// OrderResponse.Validate if the designated constraints aren't met.
+type OrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderResponseValidationError) ErrorName() string { return "OrderResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderResponseValidationError{}
+
+// Validate checks the field values on GetProgramRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetProgramRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetProgramRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetProgramRequestMultiError, or nil if none found.
+func (m *GetProgramRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetProgramRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetProgramRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetProgramRequestMultiError is an error wrapping multiple validation errors
+// returned by GetProgramRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetProgramRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetProgramRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetProgramRequestMultiError) AllErrors() []error { return m }
+
+// GetProgramRequestValidationError is the validation error returned by
+// GetProgramRequest.Validate if the designated constraints aren't met.
+type GetProgramRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetProgramRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetProgramRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetProgramRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetProgramRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetProgramRequestValidationError) ErrorName() string {
+	return "GetProgramRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetProgramRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetProgramRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetProgramRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetProgramRequestValidationError{}
+
+// Validate checks the field values on UpdateProgramRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProgramRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateProgramRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateProgramRequestMultiError, or nil if none found.
+func (m *UpdateProgramRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateProgramRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IsActive
+
+	if m.GetEarnSpend() <= 0 {
+		err := UpdateProgramRequestValidationError{
+			field:  "EarnSpend",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEarnPoints() < 0 {
+		err := UpdateProgramRequestValidationError{
+			field:  "EarnPoints",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPointValue() <= 0 {
+		err := UpdateProgramRequestValidationError{
+			field:  "PointValue",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMinRedeemPoints() < 0 {
+		err := UpdateProgramRequestValidationError{
+			field:  "MinRedeemPoints",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UpdateProgramRequest_MaxRedeemPercent_Pattern.MatchString(m.GetMaxRedeemPercent()) {
+		err := UpdateProgramRequestValidationError{
+			field:  "MaxRedeemPercent",
+			reason: "value does not match regex pattern \"^[0-9]{1,3}(\\\\.[0-9]{1,2})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetExpiryDays(); val < 0 || val > 3650 {
+		err := UpdateProgramRequestValidationError{
+			field:  "ExpiryDays",
+			reason: "value must be inside range [0, 3650]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTiers()) > 20 {
+		err := UpdateProgramRequestValidationError{
+			field:  "Tiers",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTiers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateProgramRequestValidationError{
+						field:  fmt.Sprintf("Tiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateProgramRequestValidationError{
+						field:  fmt.Sprintf("Tiers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateProgramRequestValidationError{
+					field:  fmt.Sprintf("Tiers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateProgramRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateProgramRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateProgramRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateProgramRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProgramRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateProgramRequestMultiError) AllErrors() []error { return m }
+
+// UpdateProgramRequestValidationError is the validation error returned by
+// UpdateProgramRequest.Validate if the designated constraints aren't met.
+type UpdateProgramRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateProgramRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateProgramRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateProgramRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateProgramRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateProgramRequestValidationError) ErrorName() string {
+	return "UpdateProgramRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateProgramRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateProgramRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateProgramRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateProgramRequestValidationError{}
+
+var _UpdateProgramRequest_MaxRedeemPercent_Pattern = regexp.MustCompile("^[0-9]{1,3}(\\.[0-9]{1,2})?$")
+
+// Validate checks the field values on TierRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TierRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TierRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TierRequestMultiError, or
+// nil if none found.
+func (m *TierRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TierRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 50 {
+		err := TierRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMinSpend() < 0 {
+		err := TierRequestValidationError{
+			field:  "MinSpend",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_TierRequest_Multiplier_Pattern.MatchString(m.GetMultiplier()) {
+		err := TierRequestValidationError{
+			field:  "Multiplier",
+			reason: "value does not match regex pattern \"^[0-9]{1,3}(\\\\.[0-9]{1,2})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TierRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TierRequestMultiError is an error wrapping multiple validation errors
+// returned by TierRequest.ValidateAll() if the designated constraints aren't met.
+type TierRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TierRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TierRequestMultiError) AllErrors() []error { return m }
+
+// TierRequestValidationError is the validation error returned by
+// TierRequest.Validate if the designated constraints aren't met.
+type TierRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TierRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TierRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TierRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TierRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TierRequestValidationError) ErrorName() string { return "TierRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TierRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTierRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TierRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TierRequestValidationError{}
+
+var _TierRequest_Multiplier_Pattern = regexp.MustCompile("^[0-9]{1,3}(\\.[0-9]{1,2})?$")
+
+// Validate checks the field values on CreateCustomerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCustomerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCustomerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCustomerRequestMultiError, or nil if none found.
+func (m *CreateCustomerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCustomerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := CreateCustomerRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateCustomerRequest_WhatsappNumber_Pattern.MatchString(m.GetWhatsappNumber()) {
+		err := CreateCustomerRequestValidationError{
+			field:  "WhatsappNumber",
+			reason: "value does not match regex pattern \"^\\\\+[1-9][0-9]{7,14}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEmail() != "" {
+
+		if err := m._validateEmail(m.GetEmail()); err != nil {
+			err = CreateCustomerRequestValidationError{
+				field:  "Email",
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateCustomerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateCustomerRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *CreateCustomerRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// CreateCustomerRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateCustomerRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateCustomerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCustomerRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCustomerRequestMultiError) AllErrors() []error { return m }
+
+// CreateCustomerRequestValidationError is the validation error returned by
+// CreateCustomerRequest.Validate if the designated constraints aren't met.
+type CreateCustomerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCustomerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCustomerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCustomerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCustomerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCustomerRequestValidationError) ErrorName() string {
+	return "CreateCustomerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCustomerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCustomerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCustomerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCustomerRequestValidationError{}
+
+var _CreateCustomerRequest_WhatsappNumber_Pattern = regexp.MustCompile("^\\+[1-9][0-9]{7,14}$")
+
+// Validate checks the field values on GetCustomerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCustomerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCustomerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCustomerRequestMultiError, or nil if none found.
+func (m *GetCustomerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCustomerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = GetCustomerRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetCustomerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetCustomerRequest) _validateUuid(uuid string) error {
+	if matched := _loyalty_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetCustomerRequestMultiError is an error wrapping multiple validation errors
+// returned by GetCustomerRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetCustomerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCustomerRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCustomerRequestMultiError) AllErrors() []error { return m }
+
+// GetCustomerRequestValidationError is the validation error returned by
+// GetCustomerRequest.Validate if the designated constraints aren't met.
+type GetCustomerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCustomerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCustomerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCustomerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCustomerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCustomerRequestValidationError) ErrorName() string {
+	return "GetCustomerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCustomerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCustomerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCustomerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCustomerRequestValidationError{}
+
+// Validate checks the field values on FindCustomerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FindCustomerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FindCustomerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FindCustomerRequestMultiError, or nil if none found.
+func (m *FindCustomerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FindCustomerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_FindCustomerRequest_WhatsappNumber_Pattern.MatchString(m.GetWhatsappNumber()) {
+		err := FindCustomerRequestValidationError{
+			field:  "WhatsappNumber",
+			reason: "value does not match regex pattern \"^\\\\+[1-9][0-9]{7,14}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FindCustomerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FindCustomerRequestMultiError is an error wrapping multiple validation
+// errors returned by FindCustomerRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FindCustomerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FindCustomerRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FindCustomerRequestMultiError) AllErrors() []error { return m }
+
+// FindCustomerRequestValidationError is the validation error returned by
+// FindCustomerRequest.Validate if the designated constraints aren't met.
+type FindCustomerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindCustomerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindCustomerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindCustomerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindCustomerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindCustomerRequestValidationError) ErrorName() string {
+	return "FindCustomerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindCustomerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindCustomerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindCustomerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindCustomerRequestValidationError{}
+
+var _FindCustomerRequest_WhatsappNumber_Pattern = regexp.MustCompile("^\\+[1-9][0-9]{7,14}$")
+
+// Validate checks the field values on ListCustomersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCustomersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCustomersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCustomersRequestMultiError, or nil if none found.
+func (m *ListCustomersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCustomersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSearch()) > 100 {
+		err := ListCustomersRequestValidationError{
+			field:  "Search",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListCustomersRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCursor()) > 200 {
+		err := ListCustomersRequestValidationError{
+			field:  "Cursor",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListCustomersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCustomersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCustomersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCustomersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCustomersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCustomersRequestMultiError) AllErrors() []error { return m }
+
+// ListCustomersRequestValidationError is the validation error returned by
+// ListCustomersRequest.Validate if the designated constraints aren't met.
+type ListCustomersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCustomersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCustomersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCustomersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCustomersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCustomersRequestValidationError) ErrorName() string {
+	return "ListCustomersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCustomersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCustomersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCustomersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCustomersRequestValidationError{}
+
+// Validate checks the field values on ListCustomersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCustomersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCustomersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCustomersResponseMultiError, or nil if none found.
+func (m *ListCustomersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCustomersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetCustomers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCustomersResponseValidationError{
+						field:  fmt.Sprintf("Customers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCustomersResponseValidationError{
+						field:  fmt.Sprintf("Customers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCustomersResponseValidationError{
+					field:  fmt.Sprintf("Customers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListCustomersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCustomersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListCustomersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListCustomersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCustomersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCustomersResponseMultiError) AllErrors() []error { return m }
+
+// ListCustomersResponseValidationError is the validation error returned by
+// ListCustomersResponse.Validate if the designated constraints aren't met.
+type ListCustomersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCustomersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCustomersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCustomersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCustomersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCustomersResponseValidationError) ErrorName() string {
+	return "ListCustomersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCustomersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCustomersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCustomersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCustomersResponseValidationError{}
+
+// Validate checks the field values on UpdateCustomerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCustomerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCustomerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCustomerRequestMultiError, or nil if none found.
+func (m *UpdateCustomerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCustomerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = UpdateCustomerRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := UpdateCustomerRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UpdateCustomerRequest_WhatsappNumber_Pattern.MatchString(m.GetWhatsappNumber()) {
+		err := UpdateCustomerRequestValidationError{
+			field:  "WhatsappNumber",
+			reason: "value does not match regex pattern \"^\\\\+[1-9][0-9]{7,14}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEmail() != "" {
+
+		if err := m._validateEmail(m.GetEmail()); err != nil {
+			err = UpdateCustomerRequestValidationError{
+				field:  "Email",
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateCustomerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateCustomerRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *UpdateCustomerRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+func (m *UpdateCustomerRequest) _validateUuid(uuid string) error {
+	if matched := _loyalty_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateCustomerRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateCustomerRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateCustomerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCustomerRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCustomerRequestMultiError) AllErrors() []error { return m }
+
+// UpdateCustomerRequestValidationError is the validation error returned by
+// UpdateCustomerRequest.Validate if the designated constraints aren't met.
+type UpdateCustomerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCustomerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCustomerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCustomerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCustomerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCustomerRequestValidationError) ErrorName() string {
+	return "UpdateCustomerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCustomerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCustomerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCustomerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCustomerRequestValidationError{}
+
+var _UpdateCustomerRequest_WhatsappNumber_Pattern = regexp.MustCompile("^\\+[1-9][0-9]{7,14}$")
+
+// Validate checks the field values on ListPurchasesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPurchasesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPurchasesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPurchasesRequestMultiError, or nil if none found.
+func (m *ListPurchasesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPurchasesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetCustomerId()); err != nil {
+		err = ListPurchasesRequestValidationError{
+			field:  "CustomerId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListPurchasesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCursor()) > 200 {
+		err := ListPurchasesRequestValidationError{
+			field:  "Cursor",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListPurchasesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListPurchasesRequest) _validateUuid(uuid string) error {
+	if matched := _loyalty_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListPurchasesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPurchasesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPurchasesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPurchasesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPurchasesRequestMultiError) AllErrors() []error { return m }
+
+// ListPurchasesRequestValidationError is the validation error returned by
+// ListPurchasesRequest.Validate if the designated constraints aren't met.
+type ListPurchasesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPurchasesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPurchasesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPurchasesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPurchasesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPurchasesRequestValidationError) ErrorName() string {
+	return "ListPurchasesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPurchasesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPurchasesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPurchasesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPurchasesRequestValidationError{}
+
+// Validate checks the field values on ListPurchasesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPurchasesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPurchasesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPurchasesResponseMultiError, or nil if none found.
+func (m *ListPurchasesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPurchasesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPurchasesResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPurchasesResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPurchasesResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListPurchasesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPurchasesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListPurchasesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListPurchasesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPurchasesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPurchasesResponseMultiError) AllErrors() []error { return m }
+
+// ListPurchasesResponseValidationError is the validation error returned by
+// ListPurchasesResponse.Validate if the designated constraints aren't met.
+type ListPurchasesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPurchasesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPurchasesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPurchasesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPurchasesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPurchasesResponseValidationError) ErrorName() string {
+	return "ListPurchasesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPurchasesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPurchasesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPurchasesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPurchasesResponseValidationError{}
+
+// Validate checks the field values on ListPointEntriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPointEntriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPointEntriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPointEntriesRequestMultiError, or nil if none found.
+func (m *ListPointEntriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPointEntriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetCustomerId()); err != nil {
+		err = ListPointEntriesRequestValidationError{
+			field:  "CustomerId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListPointEntriesRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCursor()) > 200 {
+		err := ListPointEntriesRequestValidationError{
+			field:  "Cursor",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListPointEntriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListPointEntriesRequest) _validateUuid(uuid string) error {
+	if matched := _loyalty_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListPointEntriesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPointEntriesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPointEntriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPointEntriesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPointEntriesRequestMultiError) AllErrors() []error { return m }
+
+// ListPointEntriesRequestValidationError is the validation error returned by
+// ListPointEntriesRequest.Validate if the designated constraints aren't met.
+type ListPointEntriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPointEntriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPointEntriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPointEntriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPointEntriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPointEntriesRequestValidationError) ErrorName() string {
+	return "ListPointEntriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPointEntriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPointEntriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPointEntriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPointEntriesRequestValidationError{}
+
+// Validate checks the field values on ListPointEntriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPointEntriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPointEntriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPointEntriesResponseMultiError, or nil if none found.
+func (m *ListPointEntriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPointEntriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPointEntriesResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPointEntriesResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPointEntriesResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListPointEntriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPointEntriesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListPointEntriesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListPointEntriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPointEntriesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPointEntriesResponseMultiError) AllErrors() []error { return m }
+
+// ListPointEntriesResponseValidationError is the validation error returned by
+// ListPointEntriesResponse.Validate if the designated constraints aren't met.
+type ListPointEntriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPointEntriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPointEntriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPointEntriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPointEntriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPointEntriesResponseValidationError) ErrorName() string {
+	return "ListPointEntriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPointEntriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPointEntriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPointEntriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPointEntriesResponseValidationError{}
+
+// Validate checks the field values on AdjustPointsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AdjustPointsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdjustPointsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdjustPointsRequestMultiError, or nil if none found.
+func (m *AdjustPointsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdjustPointsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetCustomerId()); err != nil {
+		err = AdjustPointsRequestValidationError{
+			field:  "CustomerId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AdjustPointsRequest_Points_NotInLookup[m.GetPoints()]; ok {
+		err := AdjustPointsRequestValidationError{
+			field:  "Points",
+			reason: "value must not be in list [0]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetNote()); l < 1 || l > 255 {
+		err := AdjustPointsRequestValidationError{
+			field:  "Note",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AdjustPointsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AdjustPointsRequest) _validateUuid(uuid string) error {
+	if matched := _loyalty_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AdjustPointsRequestMultiError is an error wrapping multiple validation
+// errors returned by AdjustPointsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AdjustPointsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdjustPointsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdjustPointsRequestMultiError) AllErrors() []error { return m }
+
+// AdjustPointsRequestValidationError is the validation error returned by
+// AdjustPointsRequest.Validate if the designated constraints aren't met.
+type AdjustPointsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdjustPointsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdjustPointsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdjustPointsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdjustPointsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdjustPointsRequestValidationError) ErrorName() string {
+	return "AdjustPointsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AdjustPointsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdjustPointsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdjustPointsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdjustPointsRequestValidationError{}
+
+var _AdjustPointsRequest_Points_NotInLookup = map[int64]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on AttachCustomerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttachCustomerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttachCustomerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttachCustomerRequestMultiError, or nil if none found.
+func (m *AttachCustomerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttachCustomerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderId()); err != nil {
+		err = AttachCustomerRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWhatsappNumber() != "" {
+
+		if !_AttachCustomerRequest_WhatsappNumber_Pattern.MatchString(m.GetWhatsappNumber()) {
+			err := AttachCustomerRequestValidationError{
+				field:  "WhatsappNumber",
+				reason: "value does not match regex pattern \"^\\\\+[1-9][0-9]{7,14}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AttachCustomerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AttachCustomerRequest) _validateUuid(uuid string) error {
+	if matched := _loyalty_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AttachCustomerRequestMultiError is an error wrapping multiple validation
+// errors returned by AttachCustomerRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AttachCustomerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttachCustomerRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttachCustomerRequestMultiError) AllErrors() []error { return m }
+
+// AttachCustomerRequestValidationError is the validation error returned by
+// AttachCustomerRequest.Validate if the designated constraints aren't met.
+type AttachCustomerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttachCustomerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttachCustomerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttachCustomerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttachCustomerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttachCustomerRequestValidationError) ErrorName() string {
+	return "AttachCustomerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttachCustomerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttachCustomerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttachCustomerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttachCustomerRequestValidationError{}
+
+var _AttachCustomerRequest_WhatsappNumber_Pattern = regexp.MustCompile("^\\+[1-9][0-9]{7,14}$")
+
+// Validate checks the field values on RedeemPointsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RedeemPointsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RedeemPointsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RedeemPointsRequestMultiError, or nil if none found.
+func (m *RedeemPointsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RedeemPointsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderId()); err != nil {
+		err = RedeemPointsRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPoints() < 0 {
+		err := RedeemPointsRequestValidationError{
+			field:  "Points",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RedeemPointsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RedeemPointsRequest) _validateUuid(uuid string) error {
+	if matched := _loyalty_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RedeemPointsRequestMultiError is an error wrapping multiple validation
+// errors returned by RedeemPointsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RedeemPointsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RedeemPointsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RedeemPointsRequestMultiError) AllErrors() []error { return m }
+
+// RedeemPointsRequestValidationError is the validation error returned by
+// RedeemPointsRequest.Validate if the designated constraints aren't met.
+type RedeemPointsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RedeemPointsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RedeemPointsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RedeemPointsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RedeemPointsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RedeemPointsRequestValidationError) ErrorName() string {
+	return "RedeemPointsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RedeemPointsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRedeemPointsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RedeemPointsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RedeemPointsRequestValidationError{}
+
+// Validate checks the field values on ListMembershipsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMembershipsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMembershipsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMembershipsRequestMultiError, or nil if none found.
+func (m *ListMembershipsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMembershipsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListMembershipsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMembershipsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMembershipsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMembershipsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMembershipsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMembershipsRequestMultiError) AllErrors() []error { return m }
+
+// ListMembershipsRequestValidationError is the validation error returned by
+// ListMembershipsRequest.Validate if the designated constraints aren't met.
+type ListMembershipsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMembershipsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMembershipsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMembershipsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMembershipsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMembershipsRequestValidationError) ErrorName() string {
+	return "ListMembershipsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMembershipsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMembershipsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMembershipsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMembershipsRequestValidationError{}
+
+// Validate checks the field values on ListMembershipsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMembershipsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMembershipsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMembershipsResponseMultiError, or nil if none found.
+func (m *ListMembershipsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMembershipsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetCustomers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMembershipsResponseValidationError{
+						field:  fmt.Sprintf("Customers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMembershipsResponseValidationError{
+						field:  fmt.Sprintf("Customers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMembershipsResponseValidationError{
+					field:  fmt.Sprintf("Customers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListMembershipsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMembershipsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListMembershipsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListMembershipsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMembershipsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMembershipsResponseMultiError) AllErrors() []error { return m }
+
+// ListMembershipsResponseValidationError is the validation error returned by
+// ListMembershipsResponse.Validate if the designated constraints aren't met.
+type ListMembershipsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMembershipsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMembershipsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMembershipsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMembershipsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMembershipsResponseValidationError) ErrorName() string {
+	return "ListMembershipsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMembershipsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMembershipsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMembershipsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMembershipsResponseValidationError{}
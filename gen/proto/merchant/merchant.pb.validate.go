@@ -0,0 +1,1693 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: proto/merchant/merchant.proto
+
+package merchant
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _merchant_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on MerchantData with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MerchantData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MerchantData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MerchantDataMultiError, or
+// nil if none found.
+func (m *MerchantData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MerchantData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for OwnerUserId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MerchantDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MerchantDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MerchantDataValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MerchantDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// MerchantDataMultiError is an error wrapping multiple validation errors
+// returned by MerchantData.ValidateAll() if the designated constraints aren't met.
+type MerchantDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MerchantDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MerchantDataMultiError) AllErrors() []error { return m }
+
+// MerchantDataValidationError is the validation error returned by
+// MerchantData.Validate if the designated constraints aren't met.
+type MerchantDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MerchantDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MerchantDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MerchantDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MerchantDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MerchantDataValidationError) ErrorName() string { return "MerchantDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MerchantDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMerchantData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MerchantDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MerchantDataValidationError{}
+
+// Validate checks the field values on OutletData with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OutletData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OutletData with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OutletDataMultiError, or
+// nil if none found.
+func (m *OutletData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OutletData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for MerchantId
+
+	// no validation rules for Name
+
+	// no validation rules for Address
+
+	// no validation rules for PhoneNumber
+
+	// no validation rules for Active
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OutletDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OutletDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OutletDataValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AllowNegativeStock
+
+	// no validation rules for Timezone
+
+	if len(errors) > 0 {
+		return OutletDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// OutletDataMultiError is an error wrapping multiple validation errors
+// returned by OutletData.ValidateAll() if the designated constraints aren't met.
+type OutletDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OutletDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OutletDataMultiError) AllErrors() []error { return m }
+
+// OutletDataValidationError is the validation error returned by
+// OutletData.Validate if the designated constraints aren't met.
+type OutletDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OutletDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OutletDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OutletDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OutletDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OutletDataValidationError) ErrorName() string { return "OutletDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OutletDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOutletData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OutletDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OutletDataValidationError{}
+
+// Validate checks the field values on CreateMerchantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateMerchantRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateMerchantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateMerchantRequestMultiError, or nil if none found.
+func (m *CreateMerchantRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateMerchantRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := CreateMerchantRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateMerchantRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateMerchantRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateMerchantRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateMerchantRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateMerchantRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateMerchantRequestMultiError) AllErrors() []error { return m }
+
+// CreateMerchantRequestValidationError is the validation error returned by
+// CreateMerchantRequest.Validate if the designated constraints aren't met.
+type CreateMerchantRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateMerchantRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateMerchantRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateMerchantRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateMerchantRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateMerchantRequestValidationError) ErrorName() string {
+	return "CreateMerchantRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateMerchantRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateMerchantRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateMerchantRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateMerchantRequestValidationError{}
+
+// Validate checks the field values on MerchantResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MerchantResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MerchantResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MerchantResponseMultiError, or nil if none found.
+func (m *MerchantResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MerchantResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetMerchant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MerchantResponseValidationError{
+					field:  "Merchant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MerchantResponseValidationError{
+					field:  "Merchant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMerchant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MerchantResponseValidationError{
+				field:  "Merchant",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MerchantResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MerchantResponseMultiError is an error wrapping multiple validation errors
+// returned by MerchantResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MerchantResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MerchantResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MerchantResponseMultiError) AllErrors() []error { return m }
+
+// MerchantResponseValidationError is the validation error returned by
+// MerchantResponse.Validate if the designated constraints aren't met.
+type MerchantResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MerchantResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MerchantResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MerchantResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MerchantResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MerchantResponseValidationError) ErrorName() string { return "MerchantResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MerchantResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMerchantResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MerchantResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MerchantResponseValidationError{}
+
+// Validate checks the field values on ListMyMerchantsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMyMerchantsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMyMerchantsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMyMerchantsRequestMultiError, or nil if none found.
+func (m *ListMyMerchantsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMyMerchantsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListMyMerchantsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMyMerchantsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMyMerchantsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMyMerchantsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMyMerchantsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMyMerchantsRequestMultiError) AllErrors() []error { return m }
+
+// ListMyMerchantsRequestValidationError is the validation error returned by
+// ListMyMerchantsRequest.Validate if the designated constraints aren't met.
+type ListMyMerchantsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMyMerchantsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMyMerchantsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMyMerchantsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMyMerchantsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMyMerchantsRequestValidationError) ErrorName() string {
+	return "ListMyMerchantsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMyMerchantsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMyMerchantsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMyMerchantsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMyMerchantsRequestValidationError{}
+
+// Validate checks the field values on ListMerchantsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMerchantsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMerchantsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMerchantsResponseMultiError, or nil if none found.
+func (m *ListMerchantsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMerchantsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetMerchants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMerchantsResponseValidationError{
+						field:  fmt.Sprintf("Merchants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMerchantsResponseValidationError{
+						field:  fmt.Sprintf("Merchants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMerchantsResponseValidationError{
+					field:  fmt.Sprintf("Merchants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListMerchantsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMerchantsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListMerchantsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListMerchantsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMerchantsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMerchantsResponseMultiError) AllErrors() []error { return m }
+
+// ListMerchantsResponseValidationError is the validation error returned by
+// ListMerchantsResponse.Validate if the designated constraints aren't met.
+type ListMerchantsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMerchantsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMerchantsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMerchantsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMerchantsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMerchantsResponseValidationError) ErrorName() string {
+	return "ListMerchantsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMerchantsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMerchantsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMerchantsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMerchantsResponseValidationError{}
+
+// Validate checks the field values on GetMerchantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMerchantRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMerchantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMerchantRequestMultiError, or nil if none found.
+func (m *GetMerchantRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMerchantRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetMerchantRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMerchantRequestMultiError is an error wrapping multiple validation errors
+// returned by GetMerchantRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetMerchantRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMerchantRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMerchantRequestMultiError) AllErrors() []error { return m }
+
+// GetMerchantRequestValidationError is the validation error returned by
+// GetMerchantRequest.Validate if the designated constraints aren't met.
+type GetMerchantRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMerchantRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMerchantRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMerchantRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMerchantRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMerchantRequestValidationError) ErrorName() string {
+	return "GetMerchantRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMerchantRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMerchantRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMerchantRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMerchantRequestValidationError{}
+
+// Validate checks the field values on AddOutletRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddOutletRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddOutletRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddOutletRequestMultiError, or nil if none found.
+func (m *AddOutletRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddOutletRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := AddOutletRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAddress()) > 255 {
+		err := AddOutletRequestValidationError{
+			field:  "Address",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPhoneNumber() != "" {
+
+		if !_AddOutletRequest_PhoneNumber_Pattern.MatchString(m.GetPhoneNumber()) {
+			err := AddOutletRequestValidationError{
+				field:  "PhoneNumber",
+				reason: "value does not match regex pattern \"^\\\\+[1-9][0-9]{7,14}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for AllowNegativeStock
+
+	if utf8.RuneCountInString(m.GetTimezone()) > 50 {
+		err := AddOutletRequestValidationError{
+			field:  "Timezone",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddOutletRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddOutletRequestMultiError is an error wrapping multiple validation errors
+// returned by AddOutletRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddOutletRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddOutletRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddOutletRequestMultiError) AllErrors() []error { return m }
+
+// AddOutletRequestValidationError is the validation error returned by
+// AddOutletRequest.Validate if the designated constraints aren't met.
+type AddOutletRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddOutletRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddOutletRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddOutletRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddOutletRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddOutletRequestValidationError) ErrorName() string { return "AddOutletRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddOutletRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddOutletRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddOutletRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddOutletRequestValidationError{}
+
+var _AddOutletRequest_PhoneNumber_Pattern = regexp.MustCompile("^\\+[1-9][0-9]{7,14}$")
+
+// Validate checks the field values on OutletResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OutletResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OutletResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OutletResponseMultiError,
+// or nil if none found.
+func (m *OutletResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OutletResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetOutlet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OutletResponseValidationError{
+					field:  "Outlet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OutletResponseValidationError{
+					field:  "Outlet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOutlet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OutletResponseValidationError{
+				field:  "Outlet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OutletResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// OutletResponseMultiError is an error wrapping multiple validation errors
+// returned by OutletResponse.ValidateAll() if the designated constraints
+// aren't met.
+type OutletResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OutletResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OutletResponseMultiError) AllErrors() []error { return m }
+
+// OutletResponseValidationError is the validation error returned by
+// OutletResponse.Validate if the designated constraints aren't met.
+type OutletResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OutletResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OutletResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OutletResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OutletResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OutletResponseValidationError) ErrorName() string { return "OutletResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OutletResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOutletResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OutletResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OutletResponseValidationError{}
+
+// Validate checks the field values on ListOutletsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOutletsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOutletsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOutletsRequestMultiError, or nil if none found.
+func (m *ListOutletsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOutletsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListOutletsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOutletsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListOutletsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListOutletsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOutletsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOutletsRequestMultiError) AllErrors() []error { return m }
+
+// ListOutletsRequestValidationError is the validation error returned by
+// ListOutletsRequest.Validate if the designated constraints aren't met.
+type ListOutletsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOutletsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOutletsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOutletsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOutletsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOutletsRequestValidationError) ErrorName() string {
+	return "ListOutletsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOutletsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOutletsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOutletsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOutletsRequestValidationError{}
+
+// Validate checks the field values on ListOutletsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOutletsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOutletsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOutletsResponseMultiError, or nil if none found.
+func (m *ListOutletsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOutletsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetOutlets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOutletsResponseValidationError{
+						field:  fmt.Sprintf("Outlets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOutletsResponseValidationError{
+						field:  fmt.Sprintf("Outlets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOutletsResponseValidationError{
+					field:  fmt.Sprintf("Outlets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListOutletsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOutletsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListOutletsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListOutletsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOutletsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOutletsResponseMultiError) AllErrors() []error { return m }
+
+// ListOutletsResponseValidationError is the validation error returned by
+// ListOutletsResponse.Validate if the designated constraints aren't met.
+type ListOutletsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOutletsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOutletsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOutletsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOutletsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOutletsResponseValidationError) ErrorName() string {
+	return "ListOutletsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOutletsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOutletsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOutletsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOutletsResponseValidationError{}
+
+// Validate checks the field values on InviteStaffRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InviteStaffRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InviteStaffRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InviteStaffRequestMultiError, or nil if none found.
+func (m *InviteStaffRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InviteStaffRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOutletId()); err != nil {
+		err = InviteStaffRequestValidationError{
+			field:  "OutletId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetIdentifier()); l < 1 || l > 100 {
+		err := InviteStaffRequestValidationError{
+			field:  "Identifier",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _InviteStaffRequest_Role_InLookup[m.GetRole()]; !ok {
+		err := InviteStaffRequestValidationError{
+			field:  "Role",
+			reason: "value must be in list [manager cashier]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return InviteStaffRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *InviteStaffRequest) _validateUuid(uuid string) error {
+	if matched := _merchant_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// InviteStaffRequestMultiError is an error wrapping multiple validation errors
+// returned by InviteStaffRequest.ValidateAll() if the designated constraints
+// aren't met.
+type InviteStaffRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InviteStaffRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InviteStaffRequestMultiError) AllErrors() []error { return m }
+
+// InviteStaffRequestValidationError is the validation error returned by
+// InviteStaffRequest.Validate if the designated constraints aren't met.
+type InviteStaffRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteStaffRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteStaffRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteStaffRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteStaffRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteStaffRequestValidationError) ErrorName() string {
+	return "InviteStaffRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InviteStaffRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInviteStaffRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteStaffRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteStaffRequestValidationError{}
+
+var _InviteStaffRequest_Role_InLookup = map[string]struct{}{
+	"manager": {},
+	"cashier": {},
+}
+
+// Validate checks the field values on InviteStaffResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InviteStaffResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InviteStaffResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InviteStaffResponseMultiError, or nil if none found.
+func (m *InviteStaffResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InviteStaffResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	// no validation rules for UserId
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return InviteStaffResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InviteStaffResponseMultiError is an error wrapping multiple validation
+// errors returned by InviteStaffResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InviteStaffResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InviteStaffResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InviteStaffResponseMultiError) AllErrors() []error { return m }
+
+// InviteStaffResponseValidationError is the validation error returned by
+// InviteStaffResponse.Validate if the designated constraints aren't met.
+type InviteStaffResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InviteStaffResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InviteStaffResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InviteStaffResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InviteStaffResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InviteStaffResponseValidationError) ErrorName() string {
+	return "InviteStaffResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InviteStaffResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInviteStaffResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InviteStaffResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InviteStaffResponseValidationError{}
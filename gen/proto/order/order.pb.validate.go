@@ -0,0 +1,3393 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: proto/order/order.proto
+
+package order
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _order_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on Discount with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Discount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Discount with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DiscountMultiError, or nil
+// if none found.
+func (m *Discount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Discount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _Discount_Type_InLookup[m.GetType()]; !ok {
+		err := DiscountValidationError{
+			field:  "Type",
+			reason: "value must be in list [amount percent]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Discount_Value_Pattern.MatchString(m.GetValue()) {
+		err := DiscountValidationError{
+			field:  "Value",
+			reason: "value does not match regex pattern \"^[0-9]{1,12}(\\\\.[0-9]{1,2})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DiscountMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiscountMultiError is an error wrapping multiple validation errors returned
+// by Discount.ValidateAll() if the designated constraints aren't met.
+type DiscountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiscountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiscountMultiError) AllErrors() []error { return m }
+
+// DiscountValidationError is the validation error returned by
+// Discount.Validate if the designated constraints aren't met.
+type DiscountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiscountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiscountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiscountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiscountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiscountValidationError) ErrorName() string { return "DiscountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DiscountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiscount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiscountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiscountValidationError{}
+
+var _Discount_Type_InLookup = map[string]struct{}{
+	"amount":  {},
+	"percent": {},
+}
+
+var _Discount_Value_Pattern = regexp.MustCompile("^[0-9]{1,12}(\\.[0-9]{1,2})?$")
+
+// Validate checks the field values on OrderItemData with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderItemData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderItemData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderItemDataMultiError, or
+// nil if none found.
+func (m *OrderItemData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderItemData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ProductId
+
+	// no validation rules for VariantId
+
+	// no validation rules for Sku
+
+	// no validation rules for Name
+
+	// no validation rules for Unit
+
+	// no validation rules for Quantity
+
+	// no validation rules for UnitPrice
+
+	if all {
+		switch v := interface{}(m.GetDiscount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderItemDataValidationError{
+					field:  "Discount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderItemDataValidationError{
+					field:  "Discount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDiscount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderItemDataValidationError{
+				field:  "Discount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Subtotal
+
+	// no validation rules for DiscountAmount
+
+	// no validation rules for Total
+
+	// no validation rules for RefundedQuantity
+
+	// no validation rules for TaxExempt
+
+	// no validation rules for OrderDiscount
+
+	// no validation rules for ServiceCharge
+
+	// no validation rules for TaxBase
+
+	// no validation rules for TaxAmount
+
+	// no validation rules for GrandTotal
+
+	// no validation rules for CategoryId
+
+	// no validation rules for PromotionDiscount
+
+	if len(errors) > 0 {
+		return OrderItemDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderItemDataMultiError is an error wrapping multiple validation errors
+// returned by OrderItemData.ValidateAll() if the designated constraints
+// aren't met.
+type OrderItemDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderItemDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderItemDataMultiError) AllErrors() []error { return m }
+
+// OrderItemDataValidationError is the validation error returned by
+// OrderItemData.Validate if the designated constraints aren't met.
+type OrderItemDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderItemDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderItemDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderItemDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderItemDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderItemDataValidationError) ErrorName() string { return "OrderItemDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderItemDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderItemData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderItemDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderItemDataValidationError{}
+
+// Validate checks the field values on OrderPromotionData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrderPromotionData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderPromotionData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderPromotionDataMultiError, or nil if none found.
+func (m *OrderPromotionData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderPromotionData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PromotionId
+
+	// no validation rules for ItemId
+
+	// no validation rules for Name
+
+	// no validation rules for Amount
+
+	if len(errors) > 0 {
+		return OrderPromotionDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderPromotionDataMultiError is an error wrapping multiple validation errors
+// returned by OrderPromotionData.ValidateAll() if the designated constraints
+// aren't met.
+type OrderPromotionDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderPromotionDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderPromotionDataMultiError) AllErrors() []error { return m }
+
+// OrderPromotionDataValidationError is the validation error returned by
+// OrderPromotionData.Validate if the designated constraints aren't met.
+type OrderPromotionDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderPromotionDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderPromotionDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderPromotionDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderPromotionDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderPromotionDataValidationError) ErrorName() string {
+	return "OrderPromotionDataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderPromotionDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderPromotionData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderPromotionDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderPromotionDataValidationError{}
+
+// Validate checks the field values on OrderTaxData with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderTaxData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderTaxData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderTaxDataMultiError, or
+// nil if none found.
+func (m *OrderTaxData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderTaxData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Name
+
+	// no validation rules for Rate
+
+	// no validation rules for Inclusive
+
+	// no validation rules for Base
+
+	// no validation rules for Amount
+
+	if len(errors) > 0 {
+		return OrderTaxDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderTaxDataMultiError is an error wrapping multiple validation errors
+// returned by OrderTaxData.ValidateAll() if the designated constraints aren't met.
+type OrderTaxDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderTaxDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderTaxDataMultiError) AllErrors() []error { return m }
+
+// OrderTaxDataValidationError is the validation error returned by
+// OrderTaxData.Validate if the designated constraints aren't met.
+type OrderTaxDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderTaxDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderTaxDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderTaxDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderTaxDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderTaxDataValidationError) ErrorName() string { return "OrderTaxDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderTaxDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderTaxData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderTaxDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderTaxDataValidationError{}
+
+// Validate checks the field values on PaymentData with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PaymentData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PaymentData with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PaymentDataMultiError, or
+// nil if none found.
+func (m *PaymentData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PaymentData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Method
+
+	// no validation rules for Status
+
+	// no validation rules for Amount
+
+	// no validation rules for Tendered
+
+	// no validation rules for Change
+
+	// no validation rules for Reference
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PaymentDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PaymentDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PaymentDataValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Provider
+
+	// no validation rules for RefundedAmount
+
+	if len(errors) > 0 {
+		return PaymentDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// PaymentDataMultiError is an error wrapping multiple validation errors
+// returned by PaymentData.ValidateAll() if the designated constraints aren't met.
+type PaymentDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PaymentDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PaymentDataMultiError) AllErrors() []error { return m }
+
+// PaymentDataValidationError is the validation error returned by
+// PaymentData.Validate if the designated constraints aren't met.
+type PaymentDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PaymentDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PaymentDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PaymentDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PaymentDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PaymentDataValidationError) ErrorName() string { return "PaymentDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PaymentDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPaymentData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PaymentDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PaymentDataValidationError{}
+
+// Validate checks the field values on OrderData with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderData with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderDataMultiError, or nil
+// if none found.
+func (m *OrderData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OutletId
+
+	// no validation rules for CashierId
+
+	// no validation rules for Status
+
+	// no validation rules for Number
+
+	// no validation rules for Note
+
+	if all {
+		switch v := interface{}(m.GetDiscount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderDataValidationError{
+					field:  "Discount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderDataValidationError{
+					field:  "Discount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDiscount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderDataValidationError{
+				field:  "Discount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Subtotal
+
+	// no validation rules for DiscountTotal
+
+	// no validation rules for TaxTotal
+
+	// no validation rules for Total
+
+	// no validation rules for VoidReason
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderDataValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderDataValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderDataValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPayments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderDataValidationError{
+						field:  fmt.Sprintf("Payments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderDataValidationError{
+						field:  fmt.Sprintf("Payments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderDataValidationError{
+					field:  fmt.Sprintf("Payments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderDataValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderDataValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderDataValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderDataValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCompletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderDataValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderDataValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCompletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderDataValidationError{
+				field:  "CompletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetVoidedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderDataValidationError{
+					field:  "VoidedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderDataValidationError{
+					field:  "VoidedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetVoidedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderDataValidationError{
+				field:  "VoidedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RefundedTotal
+
+	// no validation rules for ShiftId
+
+	// no validation rules for ServiceChargeTotal
+
+	for idx, item := range m.GetTaxes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderDataValidationError{
+						field:  fmt.Sprintf("Taxes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderDataValidationError{
+						field:  fmt.Sprintf("Taxes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderDataValidationError{
+					field:  fmt.Sprintf("Taxes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PromotionTotal
+
+	// no validation rules for VoucherCode
+
+	for idx, item := range m.GetPromotions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderDataValidationError{
+						field:  fmt.Sprintf("Promotions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderDataValidationError{
+						field:  fmt.Sprintf("Promotions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderDataValidationError{
+					field:  fmt.Sprintf("Promotions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for CustomerId
+
+	// no validation rules for PointsRedeemed
+
+	// no validation rules for PointsDiscount
+
+	// no validation rules for PointsEarned
+
+	if len(errors) > 0 {
+		return OrderDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderDataMultiError is an error wrapping multiple validation errors returned
+// by OrderData.ValidateAll() if the designated constraints aren't met.
+type OrderDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderDataMultiError) AllErrors() []error { return m }
+
+// OrderDataValidationError is the validation error returned by
+// OrderData.Validate if the designated constraints aren't met.
+type OrderDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderDataValidationError) ErrorName() string { return "OrderDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderDataValidationError{}
+
+// Validate checks the field values on OrderResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderResponseMultiError, or
+// nil if none found.
+func (m *OrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderResponseMultiError is an error wrapping multiple validation errors
+// returned by OrderResponse.ValidateAll() if the designated constraints
+// aren't met.
+type OrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderResponseMultiError) AllErrors() []error { return m }
+
+// OrderResponseValidationError is the validation error returned by
+// OrderResponse.Validate if the designated constraints aren't met.
+type OrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderResponseValidationError) ErrorName() string { return "OrderResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderResponseValidationError{}
+
+// Validate checks the field values on CreateOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateOrderRequestMultiError, or nil if none found.
+func (m *CreateOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetNote()) > 255 {
+		err := CreateOrderRequestValidationError{
+			field:  "Note",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateOrderRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateOrderRequestMultiError) AllErrors() []error { return m }
+
+// CreateOrderRequestValidationError is the validation error returned by
+// CreateOrderRequest.Validate if the designated constraints aren't met.
+type CreateOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateOrderRequestValidationError) ErrorName() string {
+	return "CreateOrderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateOrderRequestValidationError{}
+
+// Validate checks the field values on GetOrderRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrderRequestMultiError, or nil if none found.
+func (m *GetOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderId()); err != nil {
+		err = GetOrderRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetOrderRequest) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by GetOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrderRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrderRequestMultiError) AllErrors() []error { return m }
+
+// GetOrderRequestValidationError is the validation error returned by
+// GetOrderRequest.Validate if the designated constraints aren't met.
+type GetOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrderRequestValidationError) ErrorName() string { return "GetOrderRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrderRequestValidationError{}
+
+// Validate checks the field values on ListOrdersRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOrdersRequestMultiError, or nil if none found.
+func (m *ListOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ListOrdersRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListOrdersRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ open held completed voided]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCashierId() != "" {
+
+		if err := m._validateUuid(m.GetCashierId()); err != nil {
+			err = ListOrdersRequestValidationError{
+				field:  "CashierId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListOrdersRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCursor()) > 200 {
+		err := ListOrdersRequestValidationError{
+			field:  "Cursor",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListOrdersRequest) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListOrdersRequestMultiError is an error wrapping multiple validation errors
+// returned by ListOrdersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOrdersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOrdersRequestMultiError) AllErrors() []error { return m }
+
+// ListOrdersRequestValidationError is the validation error returned by
+// ListOrdersRequest.Validate if the designated constraints aren't met.
+type ListOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOrdersRequestValidationError) ErrorName() string {
+	return "ListOrdersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOrdersRequestValidationError{}
+
+var _ListOrdersRequest_Status_InLookup = map[string]struct{}{
+	"":          {},
+	"open":      {},
+	"held":      {},
+	"completed": {},
+	"voided":    {},
+}
+
+// Validate checks the field values on ListOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOrdersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOrdersResponseMultiError, or nil if none found.
+func (m *ListOrdersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrdersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrdersResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListOrdersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOrdersResponseMultiError is an error wrapping multiple validation errors
+// returned by ListOrdersResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListOrdersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOrdersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOrdersResponseMultiError) AllErrors() []error { return m }
+
+// ListOrdersResponseValidationError is the validation error returned by
+// ListOrdersResponse.Validate if the designated constraints aren't met.
+type ListOrdersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOrdersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOrdersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOrdersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOrdersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOrdersResponseValidationError) ErrorName() string {
+	return "ListOrdersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOrdersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOrdersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOrdersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOrdersResponseValidationError{}
+
+// Validate checks the field values on AddItemRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AddItemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddItemRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AddItemRequestMultiError,
+// or nil if none found.
+func (m *AddItemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddItemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderId()); err != nil {
+		err = AddItemRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetVariantId()); err != nil {
+		err = AddItemRequestValidationError{
+			field:  "VariantId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuantity() <= 0 {
+		err := AddItemRequestValidationError{
+			field:  "Quantity",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDiscount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddItemRequestValidationError{
+					field:  "Discount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddItemRequestValidationError{
+					field:  "Discount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDiscount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddItemRequestValidationError{
+				field:  "Discount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AddItemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AddItemRequest) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AddItemRequestMultiError is an error wrapping multiple validation errors
+// returned by AddItemRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddItemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddItemRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddItemRequestMultiError) AllErrors() []error { return m }
+
+// AddItemRequestValidationError is the validation error returned by
+// AddItemRequest.Validate if the designated constraints aren't met.
+type AddItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddItemRequestValidationError) ErrorName() string { return "AddItemRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddItemRequestValidationError{}
+
+// Validate checks the field values on UpdateItemRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateItemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateItemRequestMultiError, or nil if none found.
+func (m *UpdateItemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateItemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderId()); err != nil {
+		err = UpdateItemRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetItemId()); err != nil {
+		err = UpdateItemRequestValidationError{
+			field:  "ItemId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuantity() <= 0 {
+		err := UpdateItemRequestValidationError{
+			field:  "Quantity",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDiscount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateItemRequestValidationError{
+					field:  "Discount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateItemRequestValidationError{
+					field:  "Discount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDiscount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateItemRequestValidationError{
+				field:  "Discount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateItemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateItemRequest) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateItemRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateItemRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateItemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateItemRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateItemRequestMultiError) AllErrors() []error { return m }
+
+// UpdateItemRequestValidationError is the validation error returned by
+// UpdateItemRequest.Validate if the designated constraints aren't met.
+type UpdateItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateItemRequestValidationError) ErrorName() string {
+	return "UpdateItemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateItemRequestValidationError{}
+
+// Validate checks the field values on RemoveItemRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RemoveItemRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveItemRequestMultiError, or nil if none found.
+func (m *RemoveItemRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveItemRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderId()); err != nil {
+		err = RemoveItemRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateUuid(m.GetItemId()); err != nil {
+		err = RemoveItemRequestValidationError{
+			field:  "ItemId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveItemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RemoveItemRequest) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RemoveItemRequestMultiError is an error wrapping multiple validation errors
+// returned by RemoveItemRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RemoveItemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveItemRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveItemRequestMultiError) AllErrors() []error { return m }
+
+// RemoveItemRequestValidationError is the validation error returned by
+// RemoveItemRequest.Validate if the designated constraints aren't met.
+type RemoveItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveItemRequestValidationError) ErrorName() string {
+	return "RemoveItemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveItemRequestValidationError{}
+
+// Validate checks the field values on SetDiscountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetDiscountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetDiscountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetDiscountRequestMultiError, or nil if none found.
+func (m *SetDiscountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetDiscountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderId()); err != nil {
+		err = SetDiscountRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDiscount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetDiscountRequestValidationError{
+					field:  "Discount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetDiscountRequestValidationError{
+					field:  "Discount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDiscount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetDiscountRequestValidationError{
+				field:  "Discount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetDiscountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SetDiscountRequest) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SetDiscountRequestMultiError is an error wrapping multiple validation errors
+// returned by SetDiscountRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SetDiscountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetDiscountRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetDiscountRequestMultiError) AllErrors() []error { return m }
+
+// SetDiscountRequestValidationError is the validation error returned by
+// SetDiscountRequest.Validate if the designated constraints aren't met.
+type SetDiscountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetDiscountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetDiscountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetDiscountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetDiscountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetDiscountRequestValidationError) ErrorName() string {
+	return "SetDiscountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetDiscountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetDiscountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetDiscountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetDiscountRequestValidationError{}
+
+// Validate checks the field values on ApplyVoucherRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApplyVoucherRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApplyVoucherRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApplyVoucherRequestMultiError, or nil if none found.
+func (m *ApplyVoucherRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApplyVoucherRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderId()); err != nil {
+		err = ApplyVoucherRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 50 {
+		err := ApplyVoucherRequestValidationError{
+			field:  "Code",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ApplyVoucherRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ApplyVoucherRequest) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ApplyVoucherRequestMultiError is an error wrapping multiple validation
+// errors returned by ApplyVoucherRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApplyVoucherRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApplyVoucherRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApplyVoucherRequestMultiError) AllErrors() []error { return m }
+
+// ApplyVoucherRequestValidationError is the validation error returned by
+// ApplyVoucherRequest.Validate if the designated constraints aren't met.
+type ApplyVoucherRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplyVoucherRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplyVoucherRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplyVoucherRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplyVoucherRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplyVoucherRequestValidationError) ErrorName() string {
+	return "ApplyVoucherRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplyVoucherRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplyVoucherRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplyVoucherRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplyVoucherRequestValidationError{}
+
+// Validate checks the field values on RemoveVoucherRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveVoucherRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveVoucherRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveVoucherRequestMultiError, or nil if none found.
+func (m *RemoveVoucherRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveVoucherRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderId()); err != nil {
+		err = RemoveVoucherRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveVoucherRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RemoveVoucherRequest) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RemoveVoucherRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveVoucherRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveVoucherRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveVoucherRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveVoucherRequestMultiError) AllErrors() []error { return m }
+
+// RemoveVoucherRequestValidationError is the validation error returned by
+// RemoveVoucherRequest.Validate if the designated constraints aren't met.
+type RemoveVoucherRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveVoucherRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveVoucherRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveVoucherRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveVoucherRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveVoucherRequestValidationError) ErrorName() string {
+	return "RemoveVoucherRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveVoucherRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveVoucherRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveVoucherRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveVoucherRequestValidationError{}
+
+// Validate checks the field values on HoldOrderRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HoldOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HoldOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HoldOrderRequestMultiError, or nil if none found.
+func (m *HoldOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HoldOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderId()); err != nil {
+		err = HoldOrderRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNote()) > 255 {
+		err := HoldOrderRequestValidationError{
+			field:  "Note",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return HoldOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *HoldOrderRequest) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// HoldOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by HoldOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type HoldOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HoldOrderRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HoldOrderRequestMultiError) AllErrors() []error { return m }
+
+// HoldOrderRequestValidationError is the validation error returned by
+// HoldOrderRequest.Validate if the designated constraints aren't met.
+type HoldOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HoldOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HoldOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HoldOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HoldOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HoldOrderRequestValidationError) ErrorName() string { return "HoldOrderRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HoldOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHoldOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HoldOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HoldOrderRequestValidationError{}
+
+// Validate checks the field values on ResumeOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeOrderRequestMultiError, or nil if none found.
+func (m *ResumeOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderId()); err != nil {
+		err = ResumeOrderRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResumeOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ResumeOrderRequest) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ResumeOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by ResumeOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ResumeOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeOrderRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeOrderRequestMultiError) AllErrors() []error { return m }
+
+// ResumeOrderRequestValidationError is the validation error returned by
+// ResumeOrderRequest.Validate if the designated constraints aren't met.
+type ResumeOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeOrderRequestValidationError) ErrorName() string {
+	return "ResumeOrderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeOrderRequestValidationError{}
+
+// Validate checks the field values on PaymentInput with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PaymentInput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PaymentInput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PaymentInputMultiError, or
+// nil if none found.
+func (m *PaymentInput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PaymentInput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _PaymentInput_Method_InLookup[m.GetMethod()]; !ok {
+		err := PaymentInputValidationError{
+			field:  "Method",
+			reason: "value must be in list [cash card store_credit]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmount() <= 0 {
+		err := PaymentInputValidationError{
+			field:  "Amount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTendered() < 0 {
+		err := PaymentInputValidationError{
+			field:  "Tendered",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReference()) > 100 {
+		err := PaymentInputValidationError{
+			field:  "Reference",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PaymentInputMultiError(errors)
+	}
+
+	return nil
+}
+
+// PaymentInputMultiError is an error wrapping multiple validation errors
+// returned by PaymentInput.ValidateAll() if the designated constraints aren't met.
+type PaymentInputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PaymentInputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PaymentInputMultiError) AllErrors() []error { return m }
+
+// PaymentInputValidationError is the validation error returned by
+// PaymentInput.Validate if the designated constraints aren't met.
+type PaymentInputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PaymentInputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PaymentInputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PaymentInputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PaymentInputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PaymentInputValidationError) ErrorName() string { return "PaymentInputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PaymentInputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPaymentInput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PaymentInputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PaymentInputValidationError{}
+
+var _PaymentInput_Method_InLookup = map[string]struct{}{
+	"cash":         {},
+	"card":         {},
+	"store_credit": {},
+}
+
+// Validate checks the field values on CheckoutRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CheckoutRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckoutRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckoutRequestMultiError, or nil if none found.
+func (m *CheckoutRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckoutRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderId()); err != nil {
+		err = CheckoutRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetPayments()) > 10 {
+		err := CheckoutRequestValidationError{
+			field:  "Payments",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetPayments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckoutRequestValidationError{
+						field:  fmt.Sprintf("Payments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckoutRequestValidationError{
+						field:  fmt.Sprintf("Payments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckoutRequestValidationError{
+					field:  fmt.Sprintf("Payments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if l := utf8.RuneCountInString(m.GetIdempotencyKey()); l < 1 || l > 100 {
+		err := CheckoutRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CheckoutRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CheckoutRequest) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CheckoutRequestMultiError is an error wrapping multiple validation errors
+// returned by CheckoutRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CheckoutRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckoutRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckoutRequestMultiError) AllErrors() []error { return m }
+
+// CheckoutRequestValidationError is the validation error returned by
+// CheckoutRequest.Validate if the designated constraints aren't met.
+type CheckoutRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckoutRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckoutRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckoutRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckoutRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckoutRequestValidationError) ErrorName() string { return "CheckoutRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CheckoutRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckoutRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckoutRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckoutRequestValidationError{}
+
+// Validate checks the field values on VoidOrderRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VoidOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VoidOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VoidOrderRequestMultiError, or nil if none found.
+func (m *VoidOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VoidOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderId()); err != nil {
+		err = VoidOrderRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 255 {
+		err := VoidOrderRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return VoidOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *VoidOrderRequest) _validateUuid(uuid string) error {
+	if matched := _order_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// VoidOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by VoidOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type VoidOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VoidOrderRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VoidOrderRequestMultiError) AllErrors() []error { return m }
+
+// VoidOrderRequestValidationError is the validation error returned by
+// VoidOrderRequest.Validate if the designated constraints aren't met.
+type VoidOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VoidOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VoidOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VoidOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VoidOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VoidOrderRequestValidationError) ErrorName() string { return "VoidOrderRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VoidOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVoidOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VoidOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VoidOrderRequestValidationError{}
@@ -0,0 +1,797 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: proto/payment/payment.proto
+
+package payment
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _payment_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on PaymentData with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PaymentData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PaymentData with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PaymentDataMultiError, or
+// nil if none found.
+func (m *PaymentData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PaymentData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OrderId
+
+	// no validation rules for Method
+
+	// no validation rules for Status
+
+	// no validation rules for Amount
+
+	// no validation rules for Tendered
+
+	// no validation rules for Change
+
+	// no validation rules for Reference
+
+	// no validation rules for Provider
+
+	// no validation rules for QrString
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PaymentDataValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PaymentDataValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PaymentDataValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PaymentDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PaymentDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PaymentDataValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PaymentDataValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PaymentDataValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PaymentDataValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RefundedAmount
+
+	if len(errors) > 0 {
+		return PaymentDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// PaymentDataMultiError is an error wrapping multiple validation errors
+// returned by PaymentData.ValidateAll() if the designated constraints aren't met.
+type PaymentDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PaymentDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PaymentDataMultiError) AllErrors() []error { return m }
+
+// PaymentDataValidationError is the validation error returned by
+// PaymentData.Validate if the designated constraints aren't met.
+type PaymentDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PaymentDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PaymentDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PaymentDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PaymentDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PaymentDataValidationError) ErrorName() string { return "PaymentDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PaymentDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPaymentData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PaymentDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PaymentDataValidationError{}
+
+// Validate checks the field values on PaymentResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PaymentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PaymentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PaymentResponseMultiError, or nil if none found.
+func (m *PaymentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PaymentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PaymentResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PaymentResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PaymentResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PaymentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PaymentResponseMultiError is an error wrapping multiple validation errors
+// returned by PaymentResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PaymentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PaymentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PaymentResponseMultiError) AllErrors() []error { return m }
+
+// PaymentResponseValidationError is the validation error returned by
+// PaymentResponse.Validate if the designated constraints aren't met.
+type PaymentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PaymentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PaymentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PaymentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PaymentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PaymentResponseValidationError) ErrorName() string { return "PaymentResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PaymentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPaymentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PaymentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PaymentResponseValidationError{}
+
+// Validate checks the field values on AddPaymentRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddPaymentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddPaymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddPaymentRequestMultiError, or nil if none found.
+func (m *AddPaymentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddPaymentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderId()); err != nil {
+		err = AddPaymentRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AddPaymentRequest_Method_InLookup[m.GetMethod()]; !ok {
+		err := AddPaymentRequestValidationError{
+			field:  "Method",
+			reason: "value must be in list [cash card qris ewallet]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmount() <= 0 {
+		err := AddPaymentRequestValidationError{
+			field:  "Amount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTendered() < 0 {
+		err := AddPaymentRequestValidationError{
+			field:  "Tendered",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReference()) > 100 {
+		err := AddPaymentRequestValidationError{
+			field:  "Reference",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddPaymentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AddPaymentRequest) _validateUuid(uuid string) error {
+	if matched := _payment_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// AddPaymentRequestMultiError is an error wrapping multiple validation errors
+// returned by AddPaymentRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddPaymentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddPaymentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddPaymentRequestMultiError) AllErrors() []error { return m }
+
+// AddPaymentRequestValidationError is the validation error returned by
+// AddPaymentRequest.Validate if the designated constraints aren't met.
+type AddPaymentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddPaymentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddPaymentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddPaymentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddPaymentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddPaymentRequestValidationError) ErrorName() string {
+	return "AddPaymentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddPaymentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddPaymentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddPaymentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddPaymentRequestValidationError{}
+
+var _AddPaymentRequest_Method_InLookup = map[string]struct{}{
+	"cash":    {},
+	"card":    {},
+	"qris":    {},
+	"ewallet": {},
+}
+
+// Validate checks the field values on GetPaymentRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetPaymentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPaymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPaymentRequestMultiError, or nil if none found.
+func (m *GetPaymentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPaymentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPaymentId()); err != nil {
+		err = GetPaymentRequestValidationError{
+			field:  "PaymentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetPaymentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetPaymentRequest) _validateUuid(uuid string) error {
+	if matched := _payment_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetPaymentRequestMultiError is an error wrapping multiple validation errors
+// returned by GetPaymentRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetPaymentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPaymentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPaymentRequestMultiError) AllErrors() []error { return m }
+
+// GetPaymentRequestValidationError is the validation error returned by
+// GetPaymentRequest.Validate if the designated constraints aren't met.
+type GetPaymentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPaymentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPaymentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPaymentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPaymentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPaymentRequestValidationError) ErrorName() string {
+	return "GetPaymentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPaymentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPaymentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPaymentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPaymentRequestValidationError{}
+
+// Validate checks the field values on CancelPaymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelPaymentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelPaymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelPaymentRequestMultiError, or nil if none found.
+func (m *CancelPaymentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelPaymentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPaymentId()); err != nil {
+		err = CancelPaymentRequestValidationError{
+			field:  "PaymentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CancelPaymentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CancelPaymentRequest) _validateUuid(uuid string) error {
+	if matched := _payment_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CancelPaymentRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelPaymentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelPaymentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelPaymentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelPaymentRequestMultiError) AllErrors() []error { return m }
+
+// CancelPaymentRequestValidationError is the validation error returned by
+// CancelPaymentRequest.Validate if the designated constraints aren't met.
+type CancelPaymentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelPaymentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelPaymentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelPaymentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelPaymentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelPaymentRequestValidationError) ErrorName() string {
+	return "CancelPaymentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelPaymentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelPaymentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelPaymentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelPaymentRequestValidationError{}
@@ -0,0 +1,2275 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: proto/promotion/promotion.proto
+
+package promotion
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _promotion_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on PromotionData with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PromotionData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PromotionData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PromotionDataMultiError, or
+// nil if none found.
+func (m *PromotionData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PromotionData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OutletId
+
+	// no validation rules for Name
+
+	// no validation rules for Type
+
+	// no validation rules for CategoryId
+
+	// no validation rules for ProductId
+
+	// no validation rules for DiscountType
+
+	// no validation rules for DiscountValue
+
+	// no validation rules for BuyQuantity
+
+	// no validation rules for GetQuantity
+
+	// no validation rules for MinSpend
+
+	if all {
+		switch v := interface{}(m.GetStartsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PromotionDataValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PromotionDataValidationError{
+					field:  "StartsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PromotionDataValidationError{
+				field:  "StartsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PromotionDataValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PromotionDataValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PromotionDataValidationError{
+				field:  "EndsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DailyFrom
+
+	// no validation rules for DailyUntil
+
+	// no validation rules for Priority
+
+	// no validation rules for Stackable
+
+	// no validation rules for RequiresVoucher
+
+	// no validation rules for UsageLimit
+
+	// no validation rules for UsageCount
+
+	// no validation rules for IsActive
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PromotionDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PromotionDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PromotionDataValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PromotionDataValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PromotionDataValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PromotionDataValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PromotionDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// PromotionDataMultiError is an error wrapping multiple validation errors
+// returned by PromotionData.ValidateAll() if the designated constraints
+// aren't met.
+type PromotionDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PromotionDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PromotionDataMultiError) AllErrors() []error { return m }
+
+// PromotionDataValidationError is the validation error returned by
+// PromotionData.Validate if the designated constraints aren't met.
+type PromotionDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PromotionDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PromotionDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PromotionDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PromotionDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PromotionDataValidationError) ErrorName() string { return "PromotionDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PromotionDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPromotionData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PromotionDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PromotionDataValidationError{}
+
+// Validate checks the field values on VoucherData with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VoucherData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VoucherData with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VoucherDataMultiError, or
+// nil if none found.
+func (m *VoucherData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VoucherData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for PromotionId
+
+	// no validation rules for Code
+
+	// no validation rules for OrderId
+
+	if all {
+		switch v := interface{}(m.GetRedeemedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VoucherDataValidationError{
+					field:  "RedeemedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VoucherDataValidationError{
+					field:  "RedeemedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRedeemedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VoucherDataValidationError{
+				field:  "RedeemedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VoucherDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VoucherDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VoucherDataValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return VoucherDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// VoucherDataMultiError is an error wrapping multiple validation errors
+// returned by VoucherData.ValidateAll() if the designated constraints aren't met.
+type VoucherDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VoucherDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VoucherDataMultiError) AllErrors() []error { return m }
+
+// VoucherDataValidationError is the validation error returned by
+// VoucherData.Validate if the designated constraints aren't met.
+type VoucherDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VoucherDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VoucherDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VoucherDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VoucherDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VoucherDataValidationError) ErrorName() string { return "VoucherDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VoucherDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVoucherData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VoucherDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VoucherDataValidationError{}
+
+// Validate checks the field values on PromotionResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PromotionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PromotionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PromotionResponseMultiError, or nil if none found.
+func (m *PromotionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PromotionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetPromotion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PromotionResponseValidationError{
+					field:  "Promotion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PromotionResponseValidationError{
+					field:  "Promotion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPromotion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PromotionResponseValidationError{
+				field:  "Promotion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PromotionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PromotionResponseMultiError is an error wrapping multiple validation errors
+// returned by PromotionResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PromotionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PromotionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PromotionResponseMultiError) AllErrors() []error { return m }
+
+// PromotionResponseValidationError is the validation error returned by
+// PromotionResponse.Validate if the designated constraints aren't met.
+type PromotionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PromotionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PromotionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PromotionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PromotionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PromotionResponseValidationError) ErrorName() string {
+	return "PromotionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PromotionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPromotionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PromotionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PromotionResponseValidationError{}
+
+// Validate checks the field values on ListPromotionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPromotionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPromotionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPromotionsRequestMultiError, or nil if none found.
+func (m *ListPromotionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPromotionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListPromotionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPromotionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPromotionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPromotionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPromotionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPromotionsRequestMultiError) AllErrors() []error { return m }
+
+// ListPromotionsRequestValidationError is the validation error returned by
+// ListPromotionsRequest.Validate if the designated constraints aren't met.
+type ListPromotionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPromotionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPromotionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPromotionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPromotionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPromotionsRequestValidationError) ErrorName() string {
+	return "ListPromotionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPromotionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPromotionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPromotionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPromotionsRequestValidationError{}
+
+// Validate checks the field values on ListPromotionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPromotionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPromotionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPromotionsResponseMultiError, or nil if none found.
+func (m *ListPromotionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPromotionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetPromotions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPromotionsResponseValidationError{
+						field:  fmt.Sprintf("Promotions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPromotionsResponseValidationError{
+						field:  fmt.Sprintf("Promotions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPromotionsResponseValidationError{
+					field:  fmt.Sprintf("Promotions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPromotionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPromotionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListPromotionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListPromotionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPromotionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPromotionsResponseMultiError) AllErrors() []error { return m }
+
+// ListPromotionsResponseValidationError is the validation error returned by
+// ListPromotionsResponse.Validate if the designated constraints aren't met.
+type ListPromotionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPromotionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPromotionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPromotionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPromotionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPromotionsResponseValidationError) ErrorName() string {
+	return "ListPromotionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPromotionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPromotionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPromotionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPromotionsResponseValidationError{}
+
+// Validate checks the field values on GetPromotionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPromotionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPromotionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPromotionRequestMultiError, or nil if none found.
+func (m *GetPromotionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPromotionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = GetPromotionRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetPromotionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetPromotionRequest) _validateUuid(uuid string) error {
+	if matched := _promotion_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetPromotionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPromotionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPromotionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPromotionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPromotionRequestMultiError) AllErrors() []error { return m }
+
+// GetPromotionRequestValidationError is the validation error returned by
+// GetPromotionRequest.Validate if the designated constraints aren't met.
+type GetPromotionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPromotionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPromotionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPromotionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPromotionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPromotionRequestValidationError) ErrorName() string {
+	return "GetPromotionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPromotionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPromotionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPromotionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPromotionRequestValidationError{}
+
+// Validate checks the field values on CreatePromotionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePromotionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePromotionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePromotionRequestMultiError, or nil if none found.
+func (m *CreatePromotionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePromotionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOutletId() != "" {
+
+		if err := m._validateUuid(m.GetOutletId()); err != nil {
+			err = CreatePromotionRequestValidationError{
+				field:  "OutletId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := CreatePromotionRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreatePromotionRequest_Type_InLookup[m.GetType()]; !ok {
+		err := CreatePromotionRequestValidationError{
+			field:  "Type",
+			reason: "value must be in list [item_discount buy_x_get_y order_discount]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCategoryId() != "" {
+
+		if err := m._validateUuid(m.GetCategoryId()); err != nil {
+			err = CreatePromotionRequestValidationError{
+				field:  "CategoryId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetProductId() != "" {
+
+		if err := m._validateUuid(m.GetProductId()); err != nil {
+			err = CreatePromotionRequestValidationError{
+				field:  "ProductId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := _CreatePromotionRequest_DiscountType_InLookup[m.GetDiscountType()]; !ok {
+		err := CreatePromotionRequestValidationError{
+			field:  "DiscountType",
+			reason: "value must be in list [amount percent]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreatePromotionRequest_DiscountValue_Pattern.MatchString(m.GetDiscountValue()) {
+		err := CreatePromotionRequestValidationError{
+			field:  "DiscountValue",
+			reason: "value does not match regex pattern \"^[0-9]{1,12}(\\\\.[0-9]{1,2})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetBuyQuantity() < 0 {
+		err := CreatePromotionRequestValidationError{
+			field:  "BuyQuantity",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGetQuantity() < 0 {
+		err := CreatePromotionRequestValidationError{
+			field:  "GetQuantity",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMinSpend() < 0 {
+		err := CreatePromotionRequestValidationError{
+			field:  "MinSpend",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartsAt() == nil {
+		err := CreatePromotionRequestValidationError{
+			field:  "StartsAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePromotionRequestValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePromotionRequestValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePromotionRequestValidationError{
+				field:  "EndsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetWeekdays()) > 7 {
+		err := CreatePromotionRequestValidationError{
+			field:  "Weekdays",
+			reason: "value must contain no more than 7 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_CreatePromotionRequest_Weekdays_Unique := make(map[int32]struct{}, len(m.GetWeekdays()))
+
+	for idx, item := range m.GetWeekdays() {
+		_, _ = idx, item
+
+		if _, exists := _CreatePromotionRequest_Weekdays_Unique[item]; exists {
+			err := CreatePromotionRequestValidationError{
+				field:  fmt.Sprintf("Weekdays[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CreatePromotionRequest_Weekdays_Unique[item] = struct{}{}
+		}
+
+		if val := item; val < 0 || val > 6 {
+			err := CreatePromotionRequestValidationError{
+				field:  fmt.Sprintf("Weekdays[%v]", idx),
+				reason: "value must be inside range [0, 6]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetDailyFrom() != "" {
+
+		if !_CreatePromotionRequest_DailyFrom_Pattern.MatchString(m.GetDailyFrom()) {
+			err := CreatePromotionRequestValidationError{
+				field:  "DailyFrom",
+				reason: "value does not match regex pattern \"^[0-2]?[0-9]:[0-5][0-9]$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetDailyUntil() != "" {
+
+		if !_CreatePromotionRequest_DailyUntil_Pattern.MatchString(m.GetDailyUntil()) {
+			err := CreatePromotionRequestValidationError{
+				field:  "DailyUntil",
+				reason: "value does not match regex pattern \"^[0-2]?[0-9]:[0-5][0-9]$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Priority
+
+	// no validation rules for Stackable
+
+	// no validation rules for RequiresVoucher
+
+	if m.GetUsageLimit() < 0 {
+		err := CreatePromotionRequestValidationError{
+			field:  "UsageLimit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreatePromotionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreatePromotionRequest) _validateUuid(uuid string) error {
+	if matched := _promotion_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreatePromotionRequestMultiError is an error wrapping multiple validation
+// errors returned by CreatePromotionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePromotionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePromotionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePromotionRequestMultiError) AllErrors() []error { return m }
+
+// CreatePromotionRequestValidationError is the validation error returned by
+// CreatePromotionRequest.Validate if the designated constraints aren't met.
+type CreatePromotionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePromotionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePromotionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePromotionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePromotionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePromotionRequestValidationError) ErrorName() string {
+	return "CreatePromotionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePromotionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePromotionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePromotionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePromotionRequestValidationError{}
+
+var _CreatePromotionRequest_Type_InLookup = map[string]struct{}{
+	"item_discount":  {},
+	"buy_x_get_y":    {},
+	"order_discount": {},
+}
+
+var _CreatePromotionRequest_DiscountType_InLookup = map[string]struct{}{
+	"amount":  {},
+	"percent": {},
+}
+
+var _CreatePromotionRequest_DiscountValue_Pattern = regexp.MustCompile("^[0-9]{1,12}(\\.[0-9]{1,2})?$")
+
+var _CreatePromotionRequest_DailyFrom_Pattern = regexp.MustCompile("^[0-2]?[0-9]:[0-5][0-9]$")
+
+var _CreatePromotionRequest_DailyUntil_Pattern = regexp.MustCompile("^[0-2]?[0-9]:[0-5][0-9]$")
+
+// Validate checks the field values on UpdatePromotionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePromotionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePromotionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePromotionRequestMultiError, or nil if none found.
+func (m *UpdatePromotionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePromotionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = UpdatePromotionRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOutletId() != "" {
+
+		if err := m._validateUuid(m.GetOutletId()); err != nil {
+			err = UpdatePromotionRequestValidationError{
+				field:  "OutletId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := UpdatePromotionRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCategoryId() != "" {
+
+		if err := m._validateUuid(m.GetCategoryId()); err != nil {
+			err = UpdatePromotionRequestValidationError{
+				field:  "CategoryId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetProductId() != "" {
+
+		if err := m._validateUuid(m.GetProductId()); err != nil {
+			err = UpdatePromotionRequestValidationError{
+				field:  "ProductId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := _UpdatePromotionRequest_DiscountType_InLookup[m.GetDiscountType()]; !ok {
+		err := UpdatePromotionRequestValidationError{
+			field:  "DiscountType",
+			reason: "value must be in list [amount percent]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UpdatePromotionRequest_DiscountValue_Pattern.MatchString(m.GetDiscountValue()) {
+		err := UpdatePromotionRequestValidationError{
+			field:  "DiscountValue",
+			reason: "value does not match regex pattern \"^[0-9]{1,12}(\\\\.[0-9]{1,2})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetBuyQuantity() < 0 {
+		err := UpdatePromotionRequestValidationError{
+			field:  "BuyQuantity",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGetQuantity() < 0 {
+		err := UpdatePromotionRequestValidationError{
+			field:  "GetQuantity",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMinSpend() < 0 {
+		err := UpdatePromotionRequestValidationError{
+			field:  "MinSpend",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetStartsAt() == nil {
+		err := UpdatePromotionRequestValidationError{
+			field:  "StartsAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndsAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePromotionRequestValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePromotionRequestValidationError{
+					field:  "EndsAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndsAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePromotionRequestValidationError{
+				field:  "EndsAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetWeekdays()) > 7 {
+		err := UpdatePromotionRequestValidationError{
+			field:  "Weekdays",
+			reason: "value must contain no more than 7 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_UpdatePromotionRequest_Weekdays_Unique := make(map[int32]struct{}, len(m.GetWeekdays()))
+
+	for idx, item := range m.GetWeekdays() {
+		_, _ = idx, item
+
+		if _, exists := _UpdatePromotionRequest_Weekdays_Unique[item]; exists {
+			err := UpdatePromotionRequestValidationError{
+				field:  fmt.Sprintf("Weekdays[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_UpdatePromotionRequest_Weekdays_Unique[item] = struct{}{}
+		}
+
+		if val := item; val < 0 || val > 6 {
+			err := UpdatePromotionRequestValidationError{
+				field:  fmt.Sprintf("Weekdays[%v]", idx),
+				reason: "value must be inside range [0, 6]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetDailyFrom() != "" {
+
+		if !_UpdatePromotionRequest_DailyFrom_Pattern.MatchString(m.GetDailyFrom()) {
+			err := UpdatePromotionRequestValidationError{
+				field:  "DailyFrom",
+				reason: "value does not match regex pattern \"^[0-2]?[0-9]:[0-5][0-9]$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetDailyUntil() != "" {
+
+		if !_UpdatePromotionRequest_DailyUntil_Pattern.MatchString(m.GetDailyUntil()) {
+			err := UpdatePromotionRequestValidationError{
+				field:  "DailyUntil",
+				reason: "value does not match regex pattern \"^[0-2]?[0-9]:[0-5][0-9]$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Priority
+
+	// no validation rules for Stackable
+
+	// no validation rules for RequiresVoucher
+
+	if m.GetUsageLimit() < 0 {
+		err := UpdatePromotionRequestValidationError{
+			field:  "UsageLimit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IsActive
+
+	if len(errors) > 0 {
+		return UpdatePromotionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdatePromotionRequest) _validateUuid(uuid string) error {
+	if matched := _promotion_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdatePromotionRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdatePromotionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePromotionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePromotionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePromotionRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePromotionRequestValidationError is the validation error returned by
+// UpdatePromotionRequest.Validate if the designated constraints aren't met.
+type UpdatePromotionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePromotionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePromotionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePromotionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePromotionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePromotionRequestValidationError) ErrorName() string {
+	return "UpdatePromotionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePromotionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePromotionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePromotionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePromotionRequestValidationError{}
+
+var _UpdatePromotionRequest_DiscountType_InLookup = map[string]struct{}{
+	"amount":  {},
+	"percent": {},
+}
+
+var _UpdatePromotionRequest_DiscountValue_Pattern = regexp.MustCompile("^[0-9]{1,12}(\\.[0-9]{1,2})?$")
+
+var _UpdatePromotionRequest_DailyFrom_Pattern = regexp.MustCompile("^[0-2]?[0-9]:[0-5][0-9]$")
+
+var _UpdatePromotionRequest_DailyUntil_Pattern = regexp.MustCompile("^[0-2]?[0-9]:[0-5][0-9]$")
+
+// Validate checks the field values on GenerateVouchersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateVouchersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateVouchersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateVouchersRequestMultiError, or nil if none found.
+func (m *GenerateVouchersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateVouchersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPromotionId()); err != nil {
+		err = GenerateVouchersRequestValidationError{
+			field:  "PromotionId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetCount(); val < 1 || val > 1000 {
+		err := GenerateVouchersRequestValidationError{
+			field:  "Count",
+			reason: "value must be inside range [1, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GenerateVouchersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GenerateVouchersRequest) _validateUuid(uuid string) error {
+	if matched := _promotion_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GenerateVouchersRequestMultiError is an error wrapping multiple validation
+// errors returned by GenerateVouchersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateVouchersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateVouchersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateVouchersRequestMultiError) AllErrors() []error { return m }
+
+// GenerateVouchersRequestValidationError is the validation error returned by
+// GenerateVouchersRequest.Validate if the designated constraints aren't met.
+type GenerateVouchersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateVouchersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateVouchersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateVouchersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateVouchersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateVouchersRequestValidationError) ErrorName() string {
+	return "GenerateVouchersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateVouchersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateVouchersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateVouchersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateVouchersRequestValidationError{}
+
+// Validate checks the field values on GenerateVouchersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateVouchersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateVouchersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GenerateVouchersResponseMultiError, or nil if none found.
+func (m *GenerateVouchersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateVouchersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetVouchers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GenerateVouchersResponseValidationError{
+						field:  fmt.Sprintf("Vouchers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GenerateVouchersResponseValidationError{
+						field:  fmt.Sprintf("Vouchers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GenerateVouchersResponseValidationError{
+					field:  fmt.Sprintf("Vouchers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GenerateVouchersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateVouchersResponseMultiError is an error wrapping multiple validation
+// errors returned by GenerateVouchersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateVouchersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateVouchersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateVouchersResponseMultiError) AllErrors() []error { return m }
+
+// GenerateVouchersResponseValidationError is the validation error returned by
+// GenerateVouchersResponse.Validate if the designated constraints aren't met.
+type GenerateVouchersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateVouchersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateVouchersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateVouchersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateVouchersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateVouchersResponseValidationError) ErrorName() string {
+	return "GenerateVouchersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateVouchersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateVouchersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateVouchersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateVouchersResponseValidationError{}
+
+// Validate checks the field values on ListVouchersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListVouchersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListVouchersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListVouchersRequestMultiError, or nil if none found.
+func (m *ListVouchersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListVouchersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetPromotionId()); err != nil {
+		err = ListVouchersRequestValidationError{
+			field:  "PromotionId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListVouchersRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCursor()) > 200 {
+		err := ListVouchersRequestValidationError{
+			field:  "Cursor",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListVouchersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListVouchersRequest) _validateUuid(uuid string) error {
+	if matched := _promotion_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListVouchersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListVouchersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListVouchersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListVouchersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListVouchersRequestMultiError) AllErrors() []error { return m }
+
+// ListVouchersRequestValidationError is the validation error returned by
+// ListVouchersRequest.Validate if the designated constraints aren't met.
+type ListVouchersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListVouchersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListVouchersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListVouchersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListVouchersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListVouchersRequestValidationError) ErrorName() string {
+	return "ListVouchersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListVouchersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListVouchersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListVouchersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListVouchersRequestValidationError{}
+
+// Validate checks the field values on ListVouchersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListVouchersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListVouchersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListVouchersResponseMultiError, or nil if none found.
+func (m *ListVouchersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListVouchersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetVouchers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListVouchersResponseValidationError{
+						field:  fmt.Sprintf("Vouchers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListVouchersResponseValidationError{
+						field:  fmt.Sprintf("Vouchers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListVouchersResponseValidationError{
+					field:  fmt.Sprintf("Vouchers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListVouchersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListVouchersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListVouchersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListVouchersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListVouchersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListVouchersResponseMultiError) AllErrors() []error { return m }
+
+// ListVouchersResponseValidationError is the validation error returned by
+// ListVouchersResponse.Validate if the designated constraints aren't met.
+type ListVouchersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListVouchersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListVouchersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListVouchersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListVouchersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListVouchersResponseValidationError) ErrorName() string {
+	return "ListVouchersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListVouchersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListVouchersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListVouchersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListVouchersResponseValidationError{}
@@ -0,0 +1,1279 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: proto/receipt/receipt.proto
+
+package receipt
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _receipt_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on ReceiptData with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReceiptData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReceiptData with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReceiptDataMultiError, or
+// nil if none found.
+func (m *ReceiptData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReceiptData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Format
+
+	// no validation rules for ContentType
+
+	// no validation rules for FileName
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return ReceiptDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReceiptDataMultiError is an error wrapping multiple validation errors
+// returned by ReceiptData.ValidateAll() if the designated constraints aren't met.
+type ReceiptDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReceiptDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReceiptDataMultiError) AllErrors() []error { return m }
+
+// ReceiptDataValidationError is the validation error returned by
+// ReceiptData.Validate if the designated constraints aren't met.
+type ReceiptDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReceiptDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReceiptDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReceiptDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReceiptDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReceiptDataValidationError) ErrorName() string { return "ReceiptDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReceiptDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReceiptData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReceiptDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReceiptDataValidationError{}
+
+// Validate checks the field values on ReceiptSettingsData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReceiptSettingsData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReceiptSettingsData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReceiptSettingsDataMultiError, or nil if none found.
+func (m *ReceiptSettingsData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReceiptSettingsData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Header
+
+	// no validation rules for Footer
+
+	// no validation rules for TaxId
+
+	// no validation rules for Logo
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReceiptSettingsDataValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReceiptSettingsDataValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReceiptSettingsDataValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReceiptSettingsDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReceiptSettingsDataMultiError is an error wrapping multiple validation
+// errors returned by ReceiptSettingsData.ValidateAll() if the designated
+// constraints aren't met.
+type ReceiptSettingsDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReceiptSettingsDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReceiptSettingsDataMultiError) AllErrors() []error { return m }
+
+// ReceiptSettingsDataValidationError is the validation error returned by
+// ReceiptSettingsData.Validate if the designated constraints aren't met.
+type ReceiptSettingsDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReceiptSettingsDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReceiptSettingsDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReceiptSettingsDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReceiptSettingsDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReceiptSettingsDataValidationError) ErrorName() string {
+	return "ReceiptSettingsDataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReceiptSettingsDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReceiptSettingsData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReceiptSettingsDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReceiptSettingsDataValidationError{}
+
+// Validate checks the field values on ReceiptResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReceiptResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReceiptResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReceiptResponseMultiError, or nil if none found.
+func (m *ReceiptResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReceiptResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReceiptResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReceiptResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReceiptResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReceiptResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReceiptResponseMultiError is an error wrapping multiple validation errors
+// returned by ReceiptResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ReceiptResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReceiptResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReceiptResponseMultiError) AllErrors() []error { return m }
+
+// ReceiptResponseValidationError is the validation error returned by
+// ReceiptResponse.Validate if the designated constraints aren't met.
+type ReceiptResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReceiptResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReceiptResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReceiptResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReceiptResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReceiptResponseValidationError) ErrorName() string { return "ReceiptResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReceiptResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReceiptResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReceiptResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReceiptResponseValidationError{}
+
+// Validate checks the field values on SendReceiptResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendReceiptResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendReceiptResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendReceiptResponseMultiError, or nil if none found.
+func (m *SendReceiptResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendReceiptResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return SendReceiptResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendReceiptResponseMultiError is an error wrapping multiple validation
+// errors returned by SendReceiptResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SendReceiptResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendReceiptResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendReceiptResponseMultiError) AllErrors() []error { return m }
+
+// SendReceiptResponseValidationError is the validation error returned by
+// SendReceiptResponse.Validate if the designated constraints aren't met.
+type SendReceiptResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendReceiptResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendReceiptResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendReceiptResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendReceiptResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendReceiptResponseValidationError) ErrorName() string {
+	return "SendReceiptResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendReceiptResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendReceiptResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendReceiptResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendReceiptResponseValidationError{}
+
+// Validate checks the field values on ReceiptSettingsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReceiptSettingsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReceiptSettingsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReceiptSettingsResponseMultiError, or nil if none found.
+func (m *ReceiptSettingsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReceiptSettingsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReceiptSettingsResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReceiptSettingsResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReceiptSettingsResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReceiptSettingsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReceiptSettingsResponseMultiError is an error wrapping multiple validation
+// errors returned by ReceiptSettingsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReceiptSettingsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReceiptSettingsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReceiptSettingsResponseMultiError) AllErrors() []error { return m }
+
+// ReceiptSettingsResponseValidationError is the validation error returned by
+// ReceiptSettingsResponse.Validate if the designated constraints aren't met.
+type ReceiptSettingsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReceiptSettingsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReceiptSettingsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReceiptSettingsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReceiptSettingsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReceiptSettingsResponseValidationError) ErrorName() string {
+	return "ReceiptSettingsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReceiptSettingsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReceiptSettingsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReceiptSettingsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReceiptSettingsResponseValidationError{}
+
+// Validate checks the field values on GetReceiptRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetReceiptRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReceiptRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReceiptRequestMultiError, or nil if none found.
+func (m *GetReceiptRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReceiptRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderId()); err != nil {
+		err = GetReceiptRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GetReceiptRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := GetReceiptRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [text escpos pdf]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _GetReceiptRequest_PaperWidth_InLookup[m.GetPaperWidth()]; !ok {
+		err := GetReceiptRequestValidationError{
+			field:  "PaperWidth",
+			reason: "value must be in list [58 80]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetReceiptRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetReceiptRequest) _validateUuid(uuid string) error {
+	if matched := _receipt_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetReceiptRequestMultiError is an error wrapping multiple validation errors
+// returned by GetReceiptRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetReceiptRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReceiptRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReceiptRequestMultiError) AllErrors() []error { return m }
+
+// GetReceiptRequestValidationError is the validation error returned by
+// GetReceiptRequest.Validate if the designated constraints aren't met.
+type GetReceiptRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReceiptRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReceiptRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReceiptRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReceiptRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReceiptRequestValidationError) ErrorName() string {
+	return "GetReceiptRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReceiptRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReceiptRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReceiptRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReceiptRequestValidationError{}
+
+var _GetReceiptRequest_Format_InLookup = map[string]struct{}{
+	"text":   {},
+	"escpos": {},
+	"pdf":    {},
+}
+
+var _GetReceiptRequest_PaperWidth_InLookup = map[int32]struct{}{
+	58: {},
+	80: {},
+}
+
+// Validate checks the field values on SendReceiptRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SendReceiptRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendReceiptRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendReceiptRequestMultiError, or nil if none found.
+func (m *SendReceiptRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendReceiptRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderId()); err != nil {
+		err = SendReceiptRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEmail() != "" {
+
+		if utf8.RuneCountInString(m.GetEmail()) > 100 {
+			err := SendReceiptRequestValidationError{
+				field:  "Email",
+				reason: "value length must be at most 100 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if err := m._validateEmail(m.GetEmail()); err != nil {
+			err = SendReceiptRequestValidationError{
+				field:  "Email",
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetWhatsappNumber() != "" {
+
+		if !_SendReceiptRequest_WhatsappNumber_Pattern.MatchString(m.GetWhatsappNumber()) {
+			err := SendReceiptRequestValidationError{
+				field:  "WhatsappNumber",
+				reason: "value does not match regex pattern \"^\\\\+[1-9][0-9]{7,14}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SendReceiptRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *SendReceiptRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *SendReceiptRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+func (m *SendReceiptRequest) _validateUuid(uuid string) error {
+	if matched := _receipt_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// SendReceiptRequestMultiError is an error wrapping multiple validation errors
+// returned by SendReceiptRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SendReceiptRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendReceiptRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendReceiptRequestMultiError) AllErrors() []error { return m }
+
+// SendReceiptRequestValidationError is the validation error returned by
+// SendReceiptRequest.Validate if the designated constraints aren't met.
+type SendReceiptRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendReceiptRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendReceiptRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendReceiptRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendReceiptRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendReceiptRequestValidationError) ErrorName() string {
+	return "SendReceiptRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendReceiptRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendReceiptRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendReceiptRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendReceiptRequestValidationError{}
+
+var _SendReceiptRequest_WhatsappNumber_Pattern = regexp.MustCompile("^\\+[1-9][0-9]{7,14}$")
+
+// Validate checks the field values on GetReceiptSettingsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReceiptSettingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReceiptSettingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReceiptSettingsRequestMultiError, or nil if none found.
+func (m *GetReceiptSettingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReceiptSettingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetReceiptSettingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReceiptSettingsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetReceiptSettingsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetReceiptSettingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReceiptSettingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReceiptSettingsRequestMultiError) AllErrors() []error { return m }
+
+// GetReceiptSettingsRequestValidationError is the validation error returned by
+// GetReceiptSettingsRequest.Validate if the designated constraints aren't met.
+type GetReceiptSettingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReceiptSettingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReceiptSettingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReceiptSettingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReceiptSettingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReceiptSettingsRequestValidationError) ErrorName() string {
+	return "GetReceiptSettingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReceiptSettingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReceiptSettingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReceiptSettingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReceiptSettingsRequestValidationError{}
+
+// Validate checks the field values on UpdateReceiptSettingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateReceiptSettingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateReceiptSettingsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateReceiptSettingsRequestMultiError, or nil if none found.
+func (m *UpdateReceiptSettingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateReceiptSettingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetHeader()) > 500 {
+		err := UpdateReceiptSettingsRequestValidationError{
+			field:  "Header",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetFooter()) > 500 {
+		err := UpdateReceiptSettingsRequestValidationError{
+			field:  "Footer",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTaxId()) > 50 {
+		err := UpdateReceiptSettingsRequestValidationError{
+			field:  "TaxId",
+			reason: "value length must be at most 50 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetLogo()) > 262144 {
+		err := UpdateReceiptSettingsRequestValidationError{
+			field:  "Logo",
+			reason: "value length must be at most 262144 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateReceiptSettingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateReceiptSettingsRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateReceiptSettingsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateReceiptSettingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateReceiptSettingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateReceiptSettingsRequestMultiError) AllErrors() []error { return m }
+
+// UpdateReceiptSettingsRequestValidationError is the validation error returned
+// by UpdateReceiptSettingsRequest.Validate if the designated constraints
+// aren't met.
+type UpdateReceiptSettingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateReceiptSettingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateReceiptSettingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateReceiptSettingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateReceiptSettingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateReceiptSettingsRequestValidationError) ErrorName() string {
+	return "UpdateReceiptSettingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateReceiptSettingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateReceiptSettingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateReceiptSettingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateReceiptSettingsRequestValidationError{}
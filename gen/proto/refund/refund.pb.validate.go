@@ -0,0 +1,1742 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: proto/refund/refund.proto
+
+package refund
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _refund_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on RefundItemData with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RefundItemData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefundItemData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RefundItemDataMultiError,
+// or nil if none found.
+func (m *RefundItemData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefundItemData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderItemId
+
+	// no validation rules for VariantId
+
+	// no validation rules for Quantity
+
+	// no validation rules for Amount
+
+	// no validation rules for Restock
+
+	if len(errors) > 0 {
+		return RefundItemDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefundItemDataMultiError is an error wrapping multiple validation errors
+// returned by RefundItemData.ValidateAll() if the designated constraints
+// aren't met.
+type RefundItemDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefundItemDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefundItemDataMultiError) AllErrors() []error { return m }
+
+// RefundItemDataValidationError is the validation error returned by
+// RefundItemData.Validate if the designated constraints aren't met.
+type RefundItemDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefundItemDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefundItemDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefundItemDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefundItemDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefundItemDataValidationError) ErrorName() string { return "RefundItemDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RefundItemDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefundItemData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefundItemDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefundItemDataValidationError{}
+
+// Validate checks the field values on RefundPaymentData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RefundPaymentData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefundPaymentData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefundPaymentDataMultiError, or nil if none found.
+func (m *RefundPaymentData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefundPaymentData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PaymentId
+
+	// no validation rules for Method
+
+	// no validation rules for Amount
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return RefundPaymentDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefundPaymentDataMultiError is an error wrapping multiple validation errors
+// returned by RefundPaymentData.ValidateAll() if the designated constraints
+// aren't met.
+type RefundPaymentDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefundPaymentDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefundPaymentDataMultiError) AllErrors() []error { return m }
+
+// RefundPaymentDataValidationError is the validation error returned by
+// RefundPaymentData.Validate if the designated constraints aren't met.
+type RefundPaymentDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefundPaymentDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefundPaymentDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefundPaymentDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefundPaymentDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefundPaymentDataValidationError) ErrorName() string {
+	return "RefundPaymentDataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RefundPaymentDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefundPaymentData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefundPaymentDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefundPaymentDataValidationError{}
+
+// Validate checks the field values on StoreCreditData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StoreCreditData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StoreCreditData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StoreCreditDataMultiError, or nil if none found.
+func (m *StoreCreditData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StoreCreditData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Code
+
+	// no validation rules for Amount
+
+	// no validation rules for Balance
+
+	if len(errors) > 0 {
+		return StoreCreditDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// StoreCreditDataMultiError is an error wrapping multiple validation errors
+// returned by StoreCreditData.ValidateAll() if the designated constraints
+// aren't met.
+type StoreCreditDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StoreCreditDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StoreCreditDataMultiError) AllErrors() []error { return m }
+
+// StoreCreditDataValidationError is the validation error returned by
+// StoreCreditData.Validate if the designated constraints aren't met.
+type StoreCreditDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StoreCreditDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StoreCreditDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StoreCreditDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StoreCreditDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StoreCreditDataValidationError) ErrorName() string { return "StoreCreditDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StoreCreditDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStoreCreditData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StoreCreditDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StoreCreditDataValidationError{}
+
+// Validate checks the field values on RefundData with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RefundData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefundData with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RefundDataMultiError, or
+// nil if none found.
+func (m *RefundData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefundData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OrderId
+
+	// no validation rules for Status
+
+	// no validation rules for Tender
+
+	// no validation rules for Reason
+
+	// no validation rules for Amount
+
+	// no validation rules for CreatedBy
+
+	// no validation rules for ApprovedBy
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RefundDataValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RefundDataValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RefundDataValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPayments() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RefundDataValidationError{
+						field:  fmt.Sprintf("Payments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RefundDataValidationError{
+						field:  fmt.Sprintf("Payments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RefundDataValidationError{
+					field:  fmt.Sprintf("Payments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetStoreCredit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefundDataValidationError{
+					field:  "StoreCredit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RefundDataValidationError{
+					field:  "StoreCredit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStoreCredit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefundDataValidationError{
+				field:  "StoreCredit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefundDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RefundDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefundDataValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCompletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefundDataValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RefundDataValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCompletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefundDataValidationError{
+				field:  "CompletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ShiftId
+
+	// no validation rules for PointsTaken
+
+	// no validation rules for PointsReturned
+
+	if len(errors) > 0 {
+		return RefundDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefundDataMultiError is an error wrapping multiple validation errors
+// returned by RefundData.ValidateAll() if the designated constraints aren't met.
+type RefundDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefundDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefundDataMultiError) AllErrors() []error { return m }
+
+// RefundDataValidationError is the validation error returned by
+// RefundData.Validate if the designated constraints aren't met.
+type RefundDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefundDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefundDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefundDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefundDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefundDataValidationError) ErrorName() string { return "RefundDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RefundDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefundData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefundDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefundDataValidationError{}
+
+// Validate checks the field values on StoreCreditResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StoreCreditResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StoreCreditResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StoreCreditResponseMultiError, or nil if none found.
+func (m *StoreCreditResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StoreCreditResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StoreCreditResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StoreCreditResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StoreCreditResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StoreCreditResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StoreCreditResponseMultiError is an error wrapping multiple validation
+// errors returned by StoreCreditResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StoreCreditResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StoreCreditResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StoreCreditResponseMultiError) AllErrors() []error { return m }
+
+// StoreCreditResponseValidationError is the validation error returned by
+// StoreCreditResponse.Validate if the designated constraints aren't met.
+type StoreCreditResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StoreCreditResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StoreCreditResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StoreCreditResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StoreCreditResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StoreCreditResponseValidationError) ErrorName() string {
+	return "StoreCreditResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StoreCreditResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStoreCreditResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StoreCreditResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StoreCreditResponseValidationError{}
+
+// Validate checks the field values on RefundResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RefundResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefundResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RefundResponseMultiError,
+// or nil if none found.
+func (m *RefundResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefundResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefundResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RefundResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefundResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RefundResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefundResponseMultiError is an error wrapping multiple validation errors
+// returned by RefundResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RefundResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefundResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefundResponseMultiError) AllErrors() []error { return m }
+
+// RefundResponseValidationError is the validation error returned by
+// RefundResponse.Validate if the designated constraints aren't met.
+type RefundResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefundResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefundResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefundResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefundResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefundResponseValidationError) ErrorName() string { return "RefundResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RefundResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefundResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefundResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefundResponseValidationError{}
+
+// Validate checks the field values on ListRefundsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRefundsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRefundsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRefundsResponseMultiError, or nil if none found.
+func (m *ListRefundsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRefundsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetRefunds() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRefundsResponseValidationError{
+						field:  fmt.Sprintf("Refunds[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRefundsResponseValidationError{
+						field:  fmt.Sprintf("Refunds[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRefundsResponseValidationError{
+					field:  fmt.Sprintf("Refunds[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRefundsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRefundsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListRefundsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListRefundsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRefundsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRefundsResponseMultiError) AllErrors() []error { return m }
+
+// ListRefundsResponseValidationError is the validation error returned by
+// ListRefundsResponse.Validate if the designated constraints aren't met.
+type ListRefundsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRefundsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRefundsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRefundsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRefundsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRefundsResponseValidationError) ErrorName() string {
+	return "ListRefundsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRefundsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRefundsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRefundsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRefundsResponseValidationError{}
+
+// Validate checks the field values on RefundItemInput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RefundItemInput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefundItemInput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RefundItemInputMultiError, or nil if none found.
+func (m *RefundItemInput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefundItemInput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderItemId()); err != nil {
+		err = RefundItemInputValidationError{
+			field:  "OrderItemId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetQuantity() <= 0 {
+		err := RefundItemInputValidationError{
+			field:  "Quantity",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Damaged
+
+	if len(errors) > 0 {
+		return RefundItemInputMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RefundItemInput) _validateUuid(uuid string) error {
+	if matched := _refund_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RefundItemInputMultiError is an error wrapping multiple validation errors
+// returned by RefundItemInput.ValidateAll() if the designated constraints
+// aren't met.
+type RefundItemInputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefundItemInputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefundItemInputMultiError) AllErrors() []error { return m }
+
+// RefundItemInputValidationError is the validation error returned by
+// RefundItemInput.Validate if the designated constraints aren't met.
+type RefundItemInputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefundItemInputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefundItemInputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefundItemInputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefundItemInputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefundItemInputValidationError) ErrorName() string { return "RefundItemInputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RefundItemInputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefundItemInput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefundItemInputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefundItemInputValidationError{}
+
+// Validate checks the field values on RefundRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RefundRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefundRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RefundRequestMultiError, or
+// nil if none found.
+func (m *RefundRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefundRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderId()); err != nil {
+		err = RefundRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetItems()) > 100 {
+		err := RefundRequestValidationError{
+			field:  "Items",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RefundRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RefundRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RefundRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if _, ok := _RefundRequest_Tender_InLookup[m.GetTender()]; !ok {
+		err := RefundRequestValidationError{
+			field:  "Tender",
+			reason: "value must be in list [original store_credit]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 255 {
+		err := RefundRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetIdempotencyKey()); l < 1 || l > 100 {
+		err := RefundRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RefundRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *RefundRequest) _validateUuid(uuid string) error {
+	if matched := _refund_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RefundRequestMultiError is an error wrapping multiple validation errors
+// returned by RefundRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RefundRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefundRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefundRequestMultiError) AllErrors() []error { return m }
+
+// RefundRequestValidationError is the validation error returned by
+// RefundRequest.Validate if the designated constraints aren't met.
+type RefundRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefundRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefundRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefundRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefundRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefundRequestValidationError) ErrorName() string { return "RefundRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RefundRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefundRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefundRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefundRequestValidationError{}
+
+var _RefundRequest_Tender_InLookup = map[string]struct{}{
+	"original":     {},
+	"store_credit": {},
+}
+
+// Validate checks the field values on GetRefundRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetRefundRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRefundRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRefundRequestMultiError, or nil if none found.
+func (m *GetRefundRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRefundRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetRefundId()); err != nil {
+		err = GetRefundRequestValidationError{
+			field:  "RefundId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRefundRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetRefundRequest) _validateUuid(uuid string) error {
+	if matched := _refund_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetRefundRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRefundRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetRefundRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRefundRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRefundRequestMultiError) AllErrors() []error { return m }
+
+// GetRefundRequestValidationError is the validation error returned by
+// GetRefundRequest.Validate if the designated constraints aren't met.
+type GetRefundRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRefundRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRefundRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRefundRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRefundRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRefundRequestValidationError) ErrorName() string { return "GetRefundRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRefundRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRefundRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRefundRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRefundRequestValidationError{}
+
+// Validate checks the field values on ListRefundsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRefundsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRefundsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRefundsRequestMultiError, or nil if none found.
+func (m *ListRefundsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRefundsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetOrderId()); err != nil {
+		err = ListRefundsRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListRefundsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListRefundsRequest) _validateUuid(uuid string) error {
+	if matched := _refund_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListRefundsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListRefundsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListRefundsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRefundsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRefundsRequestMultiError) AllErrors() []error { return m }
+
+// ListRefundsRequestValidationError is the validation error returned by
+// ListRefundsRequest.Validate if the designated constraints aren't met.
+type ListRefundsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRefundsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRefundsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRefundsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRefundsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRefundsRequestValidationError) ErrorName() string {
+	return "ListRefundsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRefundsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRefundsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRefundsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRefundsRequestValidationError{}
+
+// Validate checks the field values on GetStoreCreditRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetStoreCreditRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStoreCreditRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStoreCreditRequestMultiError, or nil if none found.
+func (m *GetStoreCreditRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStoreCreditRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 20 {
+		err := GetStoreCreditRequestValidationError{
+			field:  "Code",
+			reason: "value length must be between 1 and 20 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetStoreCreditRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStoreCreditRequestMultiError is an error wrapping multiple validation
+// errors returned by GetStoreCreditRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetStoreCreditRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStoreCreditRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStoreCreditRequestMultiError) AllErrors() []error { return m }
+
+// GetStoreCreditRequestValidationError is the validation error returned by
+// GetStoreCreditRequest.Validate if the designated constraints aren't met.
+type GetStoreCreditRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStoreCreditRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStoreCreditRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStoreCreditRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStoreCreditRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStoreCreditRequestValidationError) ErrorName() string {
+	return "GetStoreCreditRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStoreCreditRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStoreCreditRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStoreCreditRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStoreCreditRequestValidationError{}
@@ -0,0 +1,263 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: proto/refund/refund.proto
+
+package refund
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RefundService_Refund_FullMethodName         = "/refund.RefundService/Refund"
+	RefundService_GetRefund_FullMethodName      = "/refund.RefundService/GetRefund"
+	RefundService_ListRefunds_FullMethodName    = "/refund.RefundService/ListRefunds"
+	RefundService_GetStoreCredit_FullMethodName = "/refund.RefundService/GetStoreCredit"
+)
+
+// RefundServiceClient is the client API for RefundService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Refunds of the completed sales of the outlet selected with the
+// "x-merchant-id" and "x-outlet-id" headers. Amounts are in the smallest unit
+// of the currency.
+type RefundServiceClient interface {
+	// Gives back items of a completed sale, every item left when the request
+	// has none. Each item is refunded with its share of the order discount and
+	// tax and is returned to stock unless it is damaged. Refunds taking the
+	// refunded total of the sale above the approval threshold require the
+	// "order.refund_approve" permission.
+	// Retrying with the same idempotency key returns the first refund, finishing
+	// it when a payment gateway failed halfway.
+	Refund(ctx context.Context, in *RefundRequest, opts ...grpc.CallOption) (*RefundResponse, error)
+	GetRefund(ctx context.Context, in *GetRefundRequest, opts ...grpc.CallOption) (*RefundResponse, error)
+	// Lists the refunds of a sale, oldest first
+	ListRefunds(ctx context.Context, in *ListRefundsRequest, opts ...grpc.CallOption) (*ListRefundsResponse, error)
+	// Looks up a store credit of the merchant by its code, e.g. to check its
+	// balance before paying with it at checkout
+	GetStoreCredit(ctx context.Context, in *GetStoreCreditRequest, opts ...grpc.CallOption) (*StoreCreditResponse, error)
+}
+
+type refundServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRefundServiceClient(cc grpc.ClientConnInterface) RefundServiceClient {
+	return &refundServiceClient{cc}
+}
+
+func (c *refundServiceClient) Refund(ctx context.Context, in *RefundRequest, opts ...grpc.CallOption) (*RefundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundResponse)
+	err := c.cc.Invoke(ctx, RefundService_Refund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *refundServiceClient) GetRefund(ctx context.Context, in *GetRefundRequest, opts ...grpc.CallOption) (*RefundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundResponse)
+	err := c.cc.Invoke(ctx, RefundService_GetRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *refundServiceClient) ListRefunds(ctx context.Context, in *ListRefundsRequest, opts ...grpc.CallOption) (*ListRefundsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRefundsResponse)
+	err := c.cc.Invoke(ctx, RefundService_ListRefunds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *refundServiceClient) GetStoreCredit(ctx context.Context, in *GetStoreCreditRequest, opts ...grpc.CallOption) (*StoreCreditResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StoreCreditResponse)
+	err := c.cc.Invoke(ctx, RefundService_GetStoreCredit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RefundServiceServer is the server API for RefundService service.
+// All implementations must embed UnimplementedRefundServiceServer
+// for forward compatibility.
+//
+// Refunds of the completed sales of the outlet selected with the
+// "x-merchant-id" and "x-outlet-id" headers. Amounts are in the smallest unit
+// of the currency.
+type RefundServiceServer interface {
+	// Gives back items of a completed sale, every item left when the request
+	// has none. Each item is refunded with its share of the order discount and
+	// tax and is returned to stock unless it is damaged. Refunds taking the
+	// refunded total of the sale above the approval threshold require the
+	// "order.refund_approve" permission.
+	// Retrying with the same idempotency key returns the first refund, finishing
+	// it when a payment gateway failed halfway.
+	Refund(context.Context, *RefundRequest) (*RefundResponse, error)
+	GetRefund(context.Context, *GetRefundRequest) (*RefundResponse, error)
+	// Lists the refunds of a sale, oldest first
+	ListRefunds(context.Context, *ListRefundsRequest) (*ListRefundsResponse, error)
+	// Looks up a store credit of the merchant by its code, e.g. to check its
+	// balance before paying with it at checkout
+	GetStoreCredit(context.Context, *GetStoreCreditRequest) (*StoreCreditResponse, error)
+	mustEmbedUnimplementedRefundServiceServer()
+}
+
+// UnimplementedRefundServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRefundServiceServer struct{}
+
+func (UnimplementedRefundServiceServer) Refund(context.Context, *RefundRequest) (*RefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refund not implemented")
+}
+func (UnimplementedRefundServiceServer) GetRefund(context.Context, *GetRefundRequest) (*RefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRefund not implemented")
+}
+func (UnimplementedRefundServiceServer) ListRefunds(context.Context, *ListRefundsRequest) (*ListRefundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRefunds not implemented")
+}
+func (UnimplementedRefundServiceServer) GetStoreCredit(context.Context, *GetStoreCreditRequest) (*StoreCreditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStoreCredit not implemented")
+}
+func (UnimplementedRefundServiceServer) mustEmbedUnimplementedRefundServiceServer() {}
+func (UnimplementedRefundServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeRefundServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RefundServiceServer will
+// result in compilation errors.
+type UnsafeRefundServiceServer interface {
+	mustEmbedUnimplementedRefundServiceServer()
+}
+
+func RegisterRefundServiceServer(s grpc.ServiceRegistrar, srv RefundServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRefundServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RefundService_ServiceDesc, srv)
+}
+
+func _RefundService_Refund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RefundServiceServer).Refund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RefundService_Refund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RefundServiceServer).Refund(ctx, req.(*RefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RefundService_GetRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RefundServiceServer).GetRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RefundService_GetRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RefundServiceServer).GetRefund(ctx, req.(*GetRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RefundService_ListRefunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRefundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RefundServiceServer).ListRefunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RefundService_ListRefunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RefundServiceServer).ListRefunds(ctx, req.(*ListRefundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RefundService_GetStoreCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStoreCreditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RefundServiceServer).GetStoreCredit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RefundService_GetStoreCredit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RefundServiceServer).GetStoreCredit(ctx, req.(*GetStoreCreditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RefundService_ServiceDesc is the grpc.ServiceDesc for RefundService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RefundService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "refund.RefundService",
+	HandlerType: (*RefundServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Refund",
+			Handler:    _RefundService_Refund_Handler,
+		},
+		{
+			MethodName: "GetRefund",
+			Handler:    _RefundService_GetRefund_Handler,
+		},
+		{
+			MethodName: "ListRefunds",
+			Handler:    _RefundService_ListRefunds_Handler,
+		},
+		{
+			MethodName: "GetStoreCredit",
+			Handler:    _RefundService_GetStoreCredit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/refund/refund.proto",
+}
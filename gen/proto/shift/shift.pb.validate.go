@@ -0,0 +1,2002 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: proto/shift/shift.proto
+
+package shift
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _shift_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on ShiftData with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ShiftData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShiftData with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ShiftDataMultiError, or nil
+// if none found.
+func (m *ShiftData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShiftData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OutletId
+
+	// no validation rules for UserId
+
+	// no validation rules for Status
+
+	// no validation rules for OpeningFloat
+
+	// no validation rules for ExpectedCash
+
+	// no validation rules for CountedCash
+
+	// no validation rules for Variance
+
+	// no validation rules for OpeningNote
+
+	// no validation rules for ClosingNote
+
+	if all {
+		switch v := interface{}(m.GetOpenedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShiftDataValidationError{
+					field:  "OpenedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShiftDataValidationError{
+					field:  "OpenedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOpenedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShiftDataValidationError{
+				field:  "OpenedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetClosedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShiftDataValidationError{
+					field:  "ClosedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShiftDataValidationError{
+					field:  "ClosedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClosedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShiftDataValidationError{
+				field:  "ClosedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ClosedBy
+
+	if len(errors) > 0 {
+		return ShiftDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShiftDataMultiError is an error wrapping multiple validation errors returned
+// by ShiftData.ValidateAll() if the designated constraints aren't met.
+type ShiftDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShiftDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShiftDataMultiError) AllErrors() []error { return m }
+
+// ShiftDataValidationError is the validation error returned by
+// ShiftData.Validate if the designated constraints aren't met.
+type ShiftDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShiftDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShiftDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShiftDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShiftDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShiftDataValidationError) ErrorName() string { return "ShiftDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ShiftDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShiftData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShiftDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShiftDataValidationError{}
+
+// Validate checks the field values on CashMovementData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CashMovementData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CashMovementData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CashMovementDataMultiError, or nil if none found.
+func (m *CashMovementData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CashMovementData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for ShiftId
+
+	// no validation rules for Type
+
+	// no validation rules for Amount
+
+	// no validation rules for Reason
+
+	// no validation rules for CreatedBy
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CashMovementDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CashMovementDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CashMovementDataValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CashMovementDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// CashMovementDataMultiError is an error wrapping multiple validation errors
+// returned by CashMovementData.ValidateAll() if the designated constraints
+// aren't met.
+type CashMovementDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CashMovementDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CashMovementDataMultiError) AllErrors() []error { return m }
+
+// CashMovementDataValidationError is the validation error returned by
+// CashMovementData.Validate if the designated constraints aren't met.
+type CashMovementDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CashMovementDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CashMovementDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CashMovementDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CashMovementDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CashMovementDataValidationError) ErrorName() string { return "CashMovementDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CashMovementDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCashMovementData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CashMovementDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CashMovementDataValidationError{}
+
+// Validate checks the field values on TenderSummary with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TenderSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenderSummary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TenderSummaryMultiError, or
+// nil if none found.
+func (m *TenderSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenderSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Method
+
+	// no validation rules for Count
+
+	// no validation rules for Amount
+
+	if len(errors) > 0 {
+		return TenderSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenderSummaryMultiError is an error wrapping multiple validation errors
+// returned by TenderSummary.ValidateAll() if the designated constraints
+// aren't met.
+type TenderSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenderSummaryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenderSummaryMultiError) AllErrors() []error { return m }
+
+// TenderSummaryValidationError is the validation error returned by
+// TenderSummary.Validate if the designated constraints aren't met.
+type TenderSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenderSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenderSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenderSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenderSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenderSummaryValidationError) ErrorName() string { return "TenderSummaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TenderSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenderSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenderSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenderSummaryValidationError{}
+
+// Validate checks the field values on ShiftReportData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ShiftReportData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShiftReportData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShiftReportDataMultiError, or nil if none found.
+func (m *ShiftReportData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShiftReportData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetShift()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShiftReportDataValidationError{
+					field:  "Shift",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShiftReportDataValidationError{
+					field:  "Shift",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetShift()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShiftReportDataValidationError{
+				field:  "Shift",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Final
+
+	// no validation rules for SalesCount
+
+	// no validation rules for Subtotal
+
+	// no validation rules for DiscountTotal
+
+	// no validation rules for TaxTotal
+
+	// no validation rules for Total
+
+	// no validation rules for VoidedCount
+
+	// no validation rules for VoidedTotal
+
+	// no validation rules for RefundCount
+
+	// no validation rules for RefundTotal
+
+	for idx, item := range m.GetTenders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ShiftReportDataValidationError{
+						field:  fmt.Sprintf("Tenders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ShiftReportDataValidationError{
+						field:  fmt.Sprintf("Tenders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ShiftReportDataValidationError{
+					field:  fmt.Sprintf("Tenders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for CashSales
+
+	// no validation rules for CashRefunds
+
+	// no validation rules for CashVoids
+
+	// no validation rules for CashIn
+
+	// no validation rules for CashOut
+
+	// no validation rules for ExpectedCash
+
+	// no validation rules for ServiceChargeTotal
+
+	if len(errors) > 0 {
+		return ShiftReportDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShiftReportDataMultiError is an error wrapping multiple validation errors
+// returned by ShiftReportData.ValidateAll() if the designated constraints
+// aren't met.
+type ShiftReportDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShiftReportDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShiftReportDataMultiError) AllErrors() []error { return m }
+
+// ShiftReportDataValidationError is the validation error returned by
+// ShiftReportData.Validate if the designated constraints aren't met.
+type ShiftReportDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShiftReportDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShiftReportDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShiftReportDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShiftReportDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShiftReportDataValidationError) ErrorName() string { return "ShiftReportDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ShiftReportDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShiftReportData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShiftReportDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShiftReportDataValidationError{}
+
+// Validate checks the field values on ShiftResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ShiftResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShiftResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ShiftResponseMultiError, or
+// nil if none found.
+func (m *ShiftResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShiftResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShiftResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShiftResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShiftResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ShiftResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShiftResponseMultiError is an error wrapping multiple validation errors
+// returned by ShiftResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ShiftResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShiftResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShiftResponseMultiError) AllErrors() []error { return m }
+
+// ShiftResponseValidationError is the validation error returned by
+// ShiftResponse.Validate if the designated constraints aren't met.
+type ShiftResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShiftResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShiftResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShiftResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShiftResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShiftResponseValidationError) ErrorName() string { return "ShiftResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ShiftResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShiftResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShiftResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShiftResponseValidationError{}
+
+// Validate checks the field values on CashMovementResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CashMovementResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CashMovementResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CashMovementResponseMultiError, or nil if none found.
+func (m *CashMovementResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CashMovementResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CashMovementResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CashMovementResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CashMovementResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CashMovementResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CashMovementResponseMultiError is an error wrapping multiple validation
+// errors returned by CashMovementResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CashMovementResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CashMovementResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CashMovementResponseMultiError) AllErrors() []error { return m }
+
+// CashMovementResponseValidationError is the validation error returned by
+// CashMovementResponse.Validate if the designated constraints aren't met.
+type CashMovementResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CashMovementResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CashMovementResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CashMovementResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CashMovementResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CashMovementResponseValidationError) ErrorName() string {
+	return "CashMovementResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CashMovementResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCashMovementResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CashMovementResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CashMovementResponseValidationError{}
+
+// Validate checks the field values on ShiftReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ShiftReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShiftReportResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShiftReportResponseMultiError, or nil if none found.
+func (m *ShiftReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShiftReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShiftReportResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShiftReportResponseValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShiftReportResponseValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ShiftReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShiftReportResponseMultiError is an error wrapping multiple validation
+// errors returned by ShiftReportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ShiftReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShiftReportResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShiftReportResponseMultiError) AllErrors() []error { return m }
+
+// ShiftReportResponseValidationError is the validation error returned by
+// ShiftReportResponse.Validate if the designated constraints aren't met.
+type ShiftReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShiftReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShiftReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShiftReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShiftReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShiftReportResponseValidationError) ErrorName() string {
+	return "ShiftReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ShiftReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShiftReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShiftReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShiftReportResponseValidationError{}
+
+// Validate checks the field values on ListShiftsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListShiftsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListShiftsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListShiftsResponseMultiError, or nil if none found.
+func (m *ListShiftsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListShiftsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetShifts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListShiftsResponseValidationError{
+						field:  fmt.Sprintf("Shifts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListShiftsResponseValidationError{
+						field:  fmt.Sprintf("Shifts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListShiftsResponseValidationError{
+					field:  fmt.Sprintf("Shifts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return ListShiftsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListShiftsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListShiftsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListShiftsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListShiftsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListShiftsResponseMultiError) AllErrors() []error { return m }
+
+// ListShiftsResponseValidationError is the validation error returned by
+// ListShiftsResponse.Validate if the designated constraints aren't met.
+type ListShiftsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListShiftsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListShiftsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListShiftsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListShiftsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListShiftsResponseValidationError) ErrorName() string {
+	return "ListShiftsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListShiftsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListShiftsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListShiftsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListShiftsResponseValidationError{}
+
+// Validate checks the field values on OpenShiftRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OpenShiftRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenShiftRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OpenShiftRequestMultiError, or nil if none found.
+func (m *OpenShiftRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenShiftRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOpeningFloat() < 0 {
+		err := OpenShiftRequestValidationError{
+			field:  "OpeningFloat",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNote()) > 255 {
+		err := OpenShiftRequestValidationError{
+			field:  "Note",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return OpenShiftRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenShiftRequestMultiError is an error wrapping multiple validation errors
+// returned by OpenShiftRequest.ValidateAll() if the designated constraints
+// aren't met.
+type OpenShiftRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenShiftRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenShiftRequestMultiError) AllErrors() []error { return m }
+
+// OpenShiftRequestValidationError is the validation error returned by
+// OpenShiftRequest.Validate if the designated constraints aren't met.
+type OpenShiftRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenShiftRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenShiftRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenShiftRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenShiftRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenShiftRequestValidationError) ErrorName() string { return "OpenShiftRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OpenShiftRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenShiftRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenShiftRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenShiftRequestValidationError{}
+
+// Validate checks the field values on GetCurrentShiftRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCurrentShiftRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCurrentShiftRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCurrentShiftRequestMultiError, or nil if none found.
+func (m *GetCurrentShiftRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCurrentShiftRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetCurrentShiftRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCurrentShiftRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCurrentShiftRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCurrentShiftRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCurrentShiftRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCurrentShiftRequestMultiError) AllErrors() []error { return m }
+
+// GetCurrentShiftRequestValidationError is the validation error returned by
+// GetCurrentShiftRequest.Validate if the designated constraints aren't met.
+type GetCurrentShiftRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCurrentShiftRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCurrentShiftRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCurrentShiftRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCurrentShiftRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCurrentShiftRequestValidationError) ErrorName() string {
+	return "GetCurrentShiftRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCurrentShiftRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCurrentShiftRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCurrentShiftRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCurrentShiftRequestValidationError{}
+
+// Validate checks the field values on AddCashMovementRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddCashMovementRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddCashMovementRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddCashMovementRequestMultiError, or nil if none found.
+func (m *AddCashMovementRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddCashMovementRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _AddCashMovementRequest_Type_InLookup[m.GetType()]; !ok {
+		err := AddCashMovementRequestValidationError{
+			field:  "Type",
+			reason: "value must be in list [cash_in cash_out]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAmount() <= 0 {
+		err := AddCashMovementRequestValidationError{
+			field:  "Amount",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 255 {
+		err := AddCashMovementRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddCashMovementRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddCashMovementRequestMultiError is an error wrapping multiple validation
+// errors returned by AddCashMovementRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddCashMovementRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddCashMovementRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddCashMovementRequestMultiError) AllErrors() []error { return m }
+
+// AddCashMovementRequestValidationError is the validation error returned by
+// AddCashMovementRequest.Validate if the designated constraints aren't met.
+type AddCashMovementRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddCashMovementRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddCashMovementRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddCashMovementRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddCashMovementRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddCashMovementRequestValidationError) ErrorName() string {
+	return "AddCashMovementRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddCashMovementRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddCashMovementRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddCashMovementRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddCashMovementRequestValidationError{}
+
+var _AddCashMovementRequest_Type_InLookup = map[string]struct{}{
+	"cash_in":  {},
+	"cash_out": {},
+}
+
+// Validate checks the field values on CloseShiftRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CloseShiftRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloseShiftRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloseShiftRequestMultiError, or nil if none found.
+func (m *CloseShiftRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloseShiftRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetShiftId()); err != nil {
+		err = CloseShiftRequestValidationError{
+			field:  "ShiftId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCountedCash() < 0 {
+		err := CloseShiftRequestValidationError{
+			field:  "CountedCash",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNote()) > 255 {
+		err := CloseShiftRequestValidationError{
+			field:  "Note",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CloseShiftRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CloseShiftRequest) _validateUuid(uuid string) error {
+	if matched := _shift_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CloseShiftRequestMultiError is an error wrapping multiple validation errors
+// returned by CloseShiftRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CloseShiftRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloseShiftRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloseShiftRequestMultiError) AllErrors() []error { return m }
+
+// CloseShiftRequestValidationError is the validation error returned by
+// CloseShiftRequest.Validate if the designated constraints aren't met.
+type CloseShiftRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloseShiftRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloseShiftRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloseShiftRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloseShiftRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloseShiftRequestValidationError) ErrorName() string {
+	return "CloseShiftRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloseShiftRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloseShiftRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloseShiftRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloseShiftRequestValidationError{}
+
+// Validate checks the field values on GetShiftReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetShiftReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetShiftReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetShiftReportRequestMultiError, or nil if none found.
+func (m *GetShiftReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetShiftReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetShiftId()); err != nil {
+		err = GetShiftReportRequestValidationError{
+			field:  "ShiftId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetShiftReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetShiftReportRequest) _validateUuid(uuid string) error {
+	if matched := _shift_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetShiftReportRequestMultiError is an error wrapping multiple validation
+// errors returned by GetShiftReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetShiftReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetShiftReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetShiftReportRequestMultiError) AllErrors() []error { return m }
+
+// GetShiftReportRequestValidationError is the validation error returned by
+// GetShiftReportRequest.Validate if the designated constraints aren't met.
+type GetShiftReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetShiftReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetShiftReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetShiftReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetShiftReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetShiftReportRequestValidationError) ErrorName() string {
+	return "GetShiftReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetShiftReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetShiftReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetShiftReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetShiftReportRequestValidationError{}
+
+// Validate checks the field values on ListShiftsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListShiftsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListShiftsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListShiftsRequestMultiError, or nil if none found.
+func (m *ListShiftsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListShiftsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ListShiftsRequest_Status_InLookup[m.GetStatus()]; !ok {
+		err := ListShiftsRequestValidationError{
+			field:  "Status",
+			reason: "value must be in list [ open closed]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserId() != "" {
+
+		if err := m._validateUuid(m.GetUserId()); err != nil {
+			err = ListShiftsRequestValidationError{
+				field:  "UserId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 100 {
+		err := ListShiftsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCursor()) > 200 {
+		err := ListShiftsRequestValidationError{
+			field:  "Cursor",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListShiftsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ListShiftsRequest) _validateUuid(uuid string) error {
+	if matched := _shift_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ListShiftsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListShiftsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListShiftsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListShiftsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListShiftsRequestMultiError) AllErrors() []error { return m }
+
+// ListShiftsRequestValidationError is the validation error returned by
+// ListShiftsRequest.Validate if the designated constraints aren't met.
+type ListShiftsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListShiftsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListShiftsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListShiftsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListShiftsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListShiftsRequestValidationError) ErrorName() string {
+	return "ListShiftsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListShiftsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListShiftsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListShiftsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListShiftsRequestValidationError{}
+
+var _ListShiftsRequest_Status_InLookup = map[string]struct{}{
+	"":       {},
+	"open":   {},
+	"closed": {},
+}
@@ -0,0 +1,343 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: proto/shift/shift.proto
+
+package shift
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ShiftService_OpenShift_FullMethodName       = "/shift.ShiftService/OpenShift"
+	ShiftService_GetCurrentShift_FullMethodName = "/shift.ShiftService/GetCurrentShift"
+	ShiftService_AddCashMovement_FullMethodName = "/shift.ShiftService/AddCashMovement"
+	ShiftService_CloseShift_FullMethodName      = "/shift.ShiftService/CloseShift"
+	ShiftService_GetShiftReport_FullMethodName  = "/shift.ShiftService/GetShiftReport"
+	ShiftService_ListShifts_FullMethodName      = "/shift.ShiftService/ListShifts"
+)
+
+// ShiftServiceClient is the client API for ShiftService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Cashier shifts and cash drawers of the outlet selected with the
+// "x-merchant-id" and "x-outlet-id" headers. A cashier checks out sales in
+// their open shift, the cash of sales, voids and refunds goes through its
+// drawer. Amounts are in the smallest unit of the currency.
+type ShiftServiceClient interface {
+	// Opens a shift for the caller with the cash put into the drawer, a
+	// cashier has at most one open shift per outlet
+	OpenShift(ctx context.Context, in *OpenShiftRequest, opts ...grpc.CallOption) (*ShiftResponse, error)
+	// Returns the open shift of the caller
+	GetCurrentShift(ctx context.Context, in *GetCurrentShiftRequest, opts ...grpc.CallOption) (*ShiftResponse, error)
+	// Records cash put into or taken out of the drawer of the open shift of the caller
+	AddCashMovement(ctx context.Context, in *AddCashMovementRequest, opts ...grpc.CallOption) (*CashMovementResponse, error)
+	// Closes a shift with the cash counted in the drawer and returns its Z
+	// report. Closing the shift of another cashier requires the
+	// "shift.manage" permission.
+	CloseShift(ctx context.Context, in *CloseShiftRequest, opts ...grpc.CallOption) (*ShiftReportResponse, error)
+	// Returns the X report of an open shift or the Z report of a closed one.
+	// Reports of other cashiers require the "shift.manage" permission.
+	GetShiftReport(ctx context.Context, in *GetShiftReportRequest, opts ...grpc.CallOption) (*ShiftReportResponse, error)
+	// Lists shifts newest first, only the shifts of the caller without the
+	// "shift.manage" permission
+	ListShifts(ctx context.Context, in *ListShiftsRequest, opts ...grpc.CallOption) (*ListShiftsResponse, error)
+}
+
+type shiftServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewShiftServiceClient(cc grpc.ClientConnInterface) ShiftServiceClient {
+	return &shiftServiceClient{cc}
+}
+
+func (c *shiftServiceClient) OpenShift(ctx context.Context, in *OpenShiftRequest, opts ...grpc.CallOption) (*ShiftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShiftResponse)
+	err := c.cc.Invoke(ctx, ShiftService_OpenShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftServiceClient) GetCurrentShift(ctx context.Context, in *GetCurrentShiftRequest, opts ...grpc.CallOption) (*ShiftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShiftResponse)
+	err := c.cc.Invoke(ctx, ShiftService_GetCurrentShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftServiceClient) AddCashMovement(ctx context.Context, in *AddCashMovementRequest, opts ...grpc.CallOption) (*CashMovementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CashMovementResponse)
+	err := c.cc.Invoke(ctx, ShiftService_AddCashMovement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftServiceClient) CloseShift(ctx context.Context, in *CloseShiftRequest, opts ...grpc.CallOption) (*ShiftReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShiftReportResponse)
+	err := c.cc.Invoke(ctx, ShiftService_CloseShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftServiceClient) GetShiftReport(ctx context.Context, in *GetShiftReportRequest, opts ...grpc.CallOption) (*ShiftReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShiftReportResponse)
+	err := c.cc.Invoke(ctx, ShiftService_GetShiftReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftServiceClient) ListShifts(ctx context.Context, in *ListShiftsRequest, opts ...grpc.CallOption) (*ListShiftsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShiftsResponse)
+	err := c.cc.Invoke(ctx, ShiftService_ListShifts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShiftServiceServer is the server API for ShiftService service.
+// All implementations must embed UnimplementedShiftServiceServer
+// for forward compatibility.
+//
+// Cashier shifts and cash drawers of the outlet selected with the
+// "x-merchant-id" and "x-outlet-id" headers. A cashier checks out sales in
+// their open shift, the cash of sales, voids and refunds goes through its
+// drawer. Amounts are in the smallest unit of the currency.
+type ShiftServiceServer interface {
+	// Opens a shift for the caller with the cash put into the drawer, a
+	// cashier has at most one open shift per outlet
+	OpenShift(context.Context, *OpenShiftRequest) (*ShiftResponse, error)
+	// Returns the open shift of the caller
+	GetCurrentShift(context.Context, *GetCurrentShiftRequest) (*ShiftResponse, error)
+	// Records cash put into or taken out of the drawer of the open shift of the caller
+	AddCashMovement(context.Context, *AddCashMovementRequest) (*CashMovementResponse, error)
+	// Closes a shift with the cash counted in the drawer and returns its Z
+	// report. Closing the shift of another cashier requires the
+	// "shift.manage" permission.
+	CloseShift(context.Context, *CloseShiftRequest) (*ShiftReportResponse, error)
+	// Returns the X report of an open shift or the Z report of a closed one.
+	// Reports of other cashiers require the "shift.manage" permission.
+	GetShiftReport(context.Context, *GetShiftReportRequest) (*ShiftReportResponse, error)
+	// Lists shifts newest first, only the shifts of the caller without the
+	// "shift.manage" permission
+	ListShifts(context.Context, *ListShiftsRequest) (*ListShiftsResponse, error)
+	mustEmbedUnimplementedShiftServiceServer()
+}
+
+// UnimplementedShiftServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedShiftServiceServer struct{}
+
+func (UnimplementedShiftServiceServer) OpenShift(context.Context, *OpenShiftRequest) (*ShiftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenShift not implemented")
+}
+func (UnimplementedShiftServiceServer) GetCurrentShift(context.Context, *GetCurrentShiftRequest) (*ShiftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentShift not implemented")
+}
+func (UnimplementedShiftServiceServer) AddCashMovement(context.Context, *AddCashMovementRequest) (*CashMovementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCashMovement not implemented")
+}
+func (UnimplementedShiftServiceServer) CloseShift(context.Context, *CloseShiftRequest) (*ShiftReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseShift not implemented")
+}
+func (UnimplementedShiftServiceServer) GetShiftReport(context.Context, *GetShiftReportRequest) (*ShiftReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShiftReport not implemented")
+}
+func (UnimplementedShiftServiceServer) ListShifts(context.Context, *ListShiftsRequest) (*ListShiftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShifts not implemented")
+}
+func (UnimplementedShiftServiceServer) mustEmbedUnimplementedShiftServiceServer() {}
+func (UnimplementedShiftServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeShiftServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ShiftServiceServer will
+// result in compilation errors.
+type UnsafeShiftServiceServer interface {
+	mustEmbedUnimplementedShiftServiceServer()
+}
+
+func RegisterShiftServiceServer(s grpc.ServiceRegistrar, srv ShiftServiceServer) {
+	// If the following call pancis, it indicates UnimplementedShiftServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ShiftService_ServiceDesc, srv)
+}
+
+func _ShiftService_OpenShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftServiceServer).OpenShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftService_OpenShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftServiceServer).OpenShift(ctx, req.(*OpenShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftService_GetCurrentShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrentShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftServiceServer).GetCurrentShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftService_GetCurrentShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftServiceServer).GetCurrentShift(ctx, req.(*GetCurrentShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftService_AddCashMovement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCashMovementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftServiceServer).AddCashMovement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftService_AddCashMovement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftServiceServer).AddCashMovement(ctx, req.(*AddCashMovementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftService_CloseShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftServiceServer).CloseShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftService_CloseShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftServiceServer).CloseShift(ctx, req.(*CloseShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftService_GetShiftReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShiftReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftServiceServer).GetShiftReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftService_GetShiftReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftServiceServer).GetShiftReport(ctx, req.(*GetShiftReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftService_ListShifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShiftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftServiceServer).ListShifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftService_ListShifts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftServiceServer).ListShifts(ctx, req.(*ListShiftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ShiftService_ServiceDesc is the grpc.ServiceDesc for ShiftService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ShiftService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "shift.ShiftService",
+	HandlerType: (*ShiftServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OpenShift",
+			Handler:    _ShiftService_OpenShift_Handler,
+		},
+		{
+			MethodName: "GetCurrentShift",
+			Handler:    _ShiftService_GetCurrentShift_Handler,
+		},
+		{
+			MethodName: "AddCashMovement",
+			Handler:    _ShiftService_AddCashMovement_Handler,
+		},
+		{
+			MethodName: "CloseShift",
+			Handler:    _ShiftService_CloseShift_Handler,
+		},
+		{
+			MethodName: "GetShiftReport",
+			Handler:    _ShiftService_GetShiftReport_Handler,
+		},
+		{
+			MethodName: "ListShifts",
+			Handler:    _ShiftService_ListShifts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/shift/shift.proto",
+}
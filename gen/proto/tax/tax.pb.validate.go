@@ -0,0 +1,1124 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: proto/tax/tax.proto
+
+package tax
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// define the regex for a UUID once up-front
+var _tax_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// Validate checks the field values on TaxRuleData with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TaxRuleData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaxRuleData with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TaxRuleDataMultiError, or
+// nil if none found.
+func (m *TaxRuleData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaxRuleData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OutletId
+
+	// no validation rules for Type
+
+	// no validation rules for Name
+
+	// no validation rules for Rate
+
+	// no validation rules for Inclusive
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaxRuleDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaxRuleDataValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaxRuleDataValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaxRuleDataValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaxRuleDataValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaxRuleDataValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TaxRuleDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaxRuleDataMultiError is an error wrapping multiple validation errors
+// returned by TaxRuleData.ValidateAll() if the designated constraints aren't met.
+type TaxRuleDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaxRuleDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaxRuleDataMultiError) AllErrors() []error { return m }
+
+// TaxRuleDataValidationError is the validation error returned by
+// TaxRuleData.Validate if the designated constraints aren't met.
+type TaxRuleDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaxRuleDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaxRuleDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaxRuleDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaxRuleDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaxRuleDataValidationError) ErrorName() string { return "TaxRuleDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaxRuleDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaxRuleData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaxRuleDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaxRuleDataValidationError{}
+
+// Validate checks the field values on TaxResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TaxResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaxResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TaxResponseMultiError, or
+// nil if none found.
+func (m *TaxResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaxResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return TaxResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaxResponseMultiError is an error wrapping multiple validation errors
+// returned by TaxResponse.ValidateAll() if the designated constraints aren't met.
+type TaxResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaxResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaxResponseMultiError) AllErrors() []error { return m }
+
+// TaxResponseValidationError is the validation error returned by
+// TaxResponse.Validate if the designated constraints aren't met.
+type TaxResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaxResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaxResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaxResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaxResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaxResponseValidationError) ErrorName() string { return "TaxResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaxResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaxResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaxResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaxResponseValidationError{}
+
+// Validate checks the field values on TaxRuleResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TaxRuleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaxRuleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TaxRuleResponseMultiError, or nil if none found.
+func (m *TaxRuleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaxRuleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetTaxRule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaxRuleResponseValidationError{
+					field:  "TaxRule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaxRuleResponseValidationError{
+					field:  "TaxRule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTaxRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaxRuleResponseValidationError{
+				field:  "TaxRule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TaxRuleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaxRuleResponseMultiError is an error wrapping multiple validation errors
+// returned by TaxRuleResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TaxRuleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaxRuleResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaxRuleResponseMultiError) AllErrors() []error { return m }
+
+// TaxRuleResponseValidationError is the validation error returned by
+// TaxRuleResponse.Validate if the designated constraints aren't met.
+type TaxRuleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaxRuleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaxRuleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaxRuleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaxRuleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaxRuleResponseValidationError) ErrorName() string { return "TaxRuleResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaxRuleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaxRuleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaxRuleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaxRuleResponseValidationError{}
+
+// Validate checks the field values on ListTaxRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTaxRulesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTaxRulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTaxRulesRequestMultiError, or nil if none found.
+func (m *ListTaxRulesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTaxRulesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListTaxRulesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTaxRulesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTaxRulesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTaxRulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTaxRulesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTaxRulesRequestMultiError) AllErrors() []error { return m }
+
+// ListTaxRulesRequestValidationError is the validation error returned by
+// ListTaxRulesRequest.Validate if the designated constraints aren't met.
+type ListTaxRulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTaxRulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTaxRulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTaxRulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTaxRulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTaxRulesRequestValidationError) ErrorName() string {
+	return "ListTaxRulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTaxRulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTaxRulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTaxRulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTaxRulesRequestValidationError{}
+
+// Validate checks the field values on ListTaxRulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTaxRulesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTaxRulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTaxRulesResponseMultiError, or nil if none found.
+func (m *ListTaxRulesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTaxRulesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetTaxRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTaxRulesResponseValidationError{
+						field:  fmt.Sprintf("TaxRules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTaxRulesResponseValidationError{
+						field:  fmt.Sprintf("TaxRules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTaxRulesResponseValidationError{
+					field:  fmt.Sprintf("TaxRules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTaxRulesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTaxRulesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTaxRulesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTaxRulesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTaxRulesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTaxRulesResponseMultiError) AllErrors() []error { return m }
+
+// ListTaxRulesResponseValidationError is the validation error returned by
+// ListTaxRulesResponse.Validate if the designated constraints aren't met.
+type ListTaxRulesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTaxRulesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTaxRulesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTaxRulesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTaxRulesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTaxRulesResponseValidationError) ErrorName() string {
+	return "ListTaxRulesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTaxRulesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTaxRulesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTaxRulesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTaxRulesResponseValidationError{}
+
+// Validate checks the field values on CreateTaxRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTaxRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTaxRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTaxRuleRequestMultiError, or nil if none found.
+func (m *CreateTaxRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTaxRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOutletId() != "" {
+
+		if err := m._validateUuid(m.GetOutletId()); err != nil {
+			err = CreateTaxRuleRequestValidationError{
+				field:  "OutletId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := _CreateTaxRuleRequest_Type_InLookup[m.GetType()]; !ok {
+		err := CreateTaxRuleRequestValidationError{
+			field:  "Type",
+			reason: "value must be in list [service_charge vat]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 50 {
+		err := CreateTaxRuleRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateTaxRuleRequest_Rate_Pattern.MatchString(m.GetRate()) {
+		err := CreateTaxRuleRequestValidationError{
+			field:  "Rate",
+			reason: "value does not match regex pattern \"^(100|[0-9]{1,2})(\\\\.[0-9]{1,2})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Inclusive
+
+	if len(errors) > 0 {
+		return CreateTaxRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateTaxRuleRequest) _validateUuid(uuid string) error {
+	if matched := _tax_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CreateTaxRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateTaxRuleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTaxRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTaxRuleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTaxRuleRequestMultiError) AllErrors() []error { return m }
+
+// CreateTaxRuleRequestValidationError is the validation error returned by
+// CreateTaxRuleRequest.Validate if the designated constraints aren't met.
+type CreateTaxRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTaxRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTaxRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTaxRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTaxRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTaxRuleRequestValidationError) ErrorName() string {
+	return "CreateTaxRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTaxRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTaxRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTaxRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTaxRuleRequestValidationError{}
+
+var _CreateTaxRuleRequest_Type_InLookup = map[string]struct{}{
+	"service_charge": {},
+	"vat":            {},
+}
+
+var _CreateTaxRuleRequest_Rate_Pattern = regexp.MustCompile("^(100|[0-9]{1,2})(\\.[0-9]{1,2})?$")
+
+// Validate checks the field values on UpdateTaxRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTaxRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTaxRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTaxRuleRequestMultiError, or nil if none found.
+func (m *UpdateTaxRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTaxRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = UpdateTaxRuleRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 50 {
+		err := UpdateTaxRuleRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 50 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UpdateTaxRuleRequest_Rate_Pattern.MatchString(m.GetRate()) {
+		err := UpdateTaxRuleRequestValidationError{
+			field:  "Rate",
+			reason: "value does not match regex pattern \"^(100|[0-9]{1,2})(\\\\.[0-9]{1,2})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Inclusive
+
+	if len(errors) > 0 {
+		return UpdateTaxRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateTaxRuleRequest) _validateUuid(uuid string) error {
+	if matched := _tax_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// UpdateTaxRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateTaxRuleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateTaxRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTaxRuleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTaxRuleRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTaxRuleRequestValidationError is the validation error returned by
+// UpdateTaxRuleRequest.Validate if the designated constraints aren't met.
+type UpdateTaxRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTaxRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTaxRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTaxRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTaxRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTaxRuleRequestValidationError) ErrorName() string {
+	return "UpdateTaxRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTaxRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTaxRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTaxRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTaxRuleRequestValidationError{}
+
+var _UpdateTaxRuleRequest_Rate_Pattern = regexp.MustCompile("^(100|[0-9]{1,2})(\\.[0-9]{1,2})?$")
+
+// Validate checks the field values on DeleteTaxRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTaxRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTaxRuleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTaxRuleRequestMultiError, or nil if none found.
+func (m *DeleteTaxRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTaxRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetId()); err != nil {
+		err = DeleteTaxRuleRequestValidationError{
+			field:  "Id",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteTaxRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DeleteTaxRuleRequest) _validateUuid(uuid string) error {
+	if matched := _tax_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DeleteTaxRuleRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteTaxRuleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteTaxRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTaxRuleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTaxRuleRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTaxRuleRequestValidationError is the validation error returned by
+// DeleteTaxRuleRequest.Validate if the designated constraints aren't met.
+type DeleteTaxRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTaxRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTaxRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTaxRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTaxRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTaxRuleRequestValidationError) ErrorName() string {
+	return "DeleteTaxRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTaxRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTaxRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTaxRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTaxRuleRequestValidationError{}
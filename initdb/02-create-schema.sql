@@ -1,13 +1,17 @@
 -- File ini akan otomatis dijalankan terhadap database kijundb
 CREATE TABLE IF NOT EXISTS users (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
-    username VARCHAR(50) UNIQUE NOT NULL,
+    username VARCHAR(50) NOT NULL,
     password_hash VARCHAR(255) NOT NULL,
-    email VARCHAR(100) UNIQUE,
-    whatsapp_number VARCHAR(20) UNIQUE,
-    otp_pin VARCHAR(6),
+    email VARCHAR(100),
+    email_verified_at TIMESTAMP,
+    whatsapp_number VARCHAR(20),
+    whatsapp_verified_at TIMESTAMP,
+    pin VARCHAR(255),
     is_active BOOLEAN NOT NULL DEFAULT true,
     failed_login_attempts INTEGER NOT NULL DEFAULT 0,
+    lockout_count INTEGER NOT NULL DEFAULT 0,
+    locked_until TIMESTAMP,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     last_login_at TIMESTAMP,
     password_changed_at TIMESTAMP,
@@ -16,13 +20,742 @@ CREATE TABLE IF NOT EXISTS users (
     CONSTRAINT chk_contact_info CHECK (email IS NOT NULL OR whatsapp_number IS NOT NULL)
 );
 
-CREATE INDEX IF NOT EXISTS idx_users_email ON users(email);
-CREATE INDEX IF NOT EXISTS idx_users_username ON users(username);
-CREATE INDEX IF NOT EXISTS idx_users_whatsapp ON users(whatsapp_number);
+-- Username, email dan nomor WhatsApp hanya unik untuk akun yang belum dihapus,
+-- sehingga bisa dipakai lagi setelah akun dihapus
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON users(email) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_username ON users(username) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_whatsapp ON users(whatsapp_number) WHERE deleted_at IS NULL;
 CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users(deleted_at);
+-- Urutan daftar user di back office, dari yang terbaru
+CREATE INDEX IF NOT EXISTS idx_users_created_at_id ON users(created_at DESC, id DESC);
+
+-- Role staf POS dan permission yang diberikan oleh setiap role
+CREATE TABLE IF NOT EXISTS roles (
+    name VARCHAR(50) PRIMARY KEY,
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS permissions (
+    name VARCHAR(100) PRIMARY KEY,
+    description VARCHAR(255) NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role VARCHAR(50) NOT NULL REFERENCES roles(name) ON DELETE CASCADE,
+    permission VARCHAR(100) NOT NULL REFERENCES permissions(name) ON DELETE CASCADE,
+    PRIMARY KEY (role, permission)
+);
+
+-- Role yang dimiliki user, dibawa ke dalam access token
+CREATE TABLE IF NOT EXISTS user_roles (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(50) NOT NULL REFERENCES roles(name) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, role)
+);
+
+-- Refresh token disimpan dalam bentuk hash, satu family untuk setiap sesi login
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    family_id UUID NOT NULL,
+    token_hash VARCHAR(64) UNIQUE NOT NULL,
+    auth_type VARCHAR(20) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens(user_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family_id ON refresh_tokens(family_id);
+
+-- Kode verifikasi disimpan sebagai hash, satu kode aktif per identifier dan tujuan
+CREATE TABLE IF NOT EXISTS verification_codes (
+    identifier VARCHAR(100) NOT NULL,
+    purpose VARCHAR(30) NOT NULL,
+    code_hash VARCHAR(64) NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP NOT NULL,
+    consumed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (identifier, purpose)
+);
+
+CREATE INDEX IF NOT EXISTS idx_verification_codes_expires_at ON verification_codes(expires_at);
+
+-- Penghitung rate limit dengan jendela waktu tetap, jendela dimulai pada hit pertama
+CREATE TABLE IF NOT EXISTS rate_limits (
+    key VARCHAR(255) PRIMARY KEY,
+    hits INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_rate_limits_expires_at ON rate_limits(expires_at);
+
+-- Merchant adalah tenant, semua data usaha selalu difilter berdasarkan merchant_id
+CREATE TABLE IF NOT EXISTS merchants (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(100) NOT NULL,
+    owner_user_id UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS outlets (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    merchant_id UUID NOT NULL REFERENCES merchants(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    address VARCHAR(255) NOT NULL DEFAULT '',
+    phone_number VARCHAR(20) NOT NULL DEFAULT '',
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    -- Izinkan penjualan tetap berjalan walaupun stok habis
+    allow_negative_stock BOOLEAN NOT NULL DEFAULT false,
+    -- Zona waktu IANA outlet, jadwal promo mengikuti jam lokal outlet
+    timezone VARCHAR(50) NOT NULL DEFAULT 'Asia/Jakarta',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_outlets_merchant_id ON outlets(merchant_id);
+
+-- Anggota merchant beserta role-nya di dalam merchant tersebut
+CREATE TABLE IF NOT EXISTS merchant_members (
+    merchant_id UUID NOT NULL REFERENCES merchants(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(50) NOT NULL REFERENCES roles(name),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (merchant_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_merchant_members_user_id ON merchant_members(user_id);
+
+-- Staf yang ditugaskan ke outlet
+CREATE TABLE IF NOT EXISTS outlet_staff (
+    outlet_id UUID NOT NULL REFERENCES outlets(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (outlet_id, user_id)
+);
+
+-- Katalog produk per merchant, harga disimpan dalam satuan terkecil mata uang
+CREATE TABLE IF NOT EXISTS categories (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    merchant_id UUID NOT NULL REFERENCES merchants(id) ON DELETE CASCADE,
+    parent_id UUID REFERENCES categories(id) ON DELETE SET NULL,
+    name VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_categories_merchant_id ON categories(merchant_id);
+
+CREATE TABLE IF NOT EXISTS products (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    merchant_id UUID NOT NULL REFERENCES merchants(id) ON DELETE CASCADE,
+    category_id UUID REFERENCES categories(id) ON DELETE SET NULL,
+    name VARCHAR(150) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    unit VARCHAR(20) NOT NULL DEFAULT 'pcs',
+    base_price BIGINT NOT NULL CHECK (base_price >= 0),
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    -- Produk bebas PPN, misalnya bahan pokok
+    tax_exempt BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_products_merchant_created_at ON products(merchant_id, created_at DESC, id DESC) WHERE deleted_at IS NULL;
+
+-- Setiap produk punya minimal satu varian yang membawa SKU dan barcode
+CREATE TABLE IF NOT EXISTS product_variants (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    merchant_id UUID NOT NULL REFERENCES merchants(id) ON DELETE CASCADE,
+    sku VARCHAR(64) NOT NULL,
+    barcode VARCHAR(64),
+    name VARCHAR(100) NOT NULL DEFAULT '',
+    attributes JSONB NOT NULL DEFAULT '{}',
+    price BIGINT CHECK (price >= 0),
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_product_variants_sku ON product_variants(merchant_id, sku) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_product_variants_barcode ON product_variants(merchant_id, barcode) WHERE barcode IS NOT NULL AND deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_product_variants_product_id ON product_variants(product_id);
+
+-- Harga varian yang berbeda di outlet tertentu
+CREATE TABLE IF NOT EXISTS outlet_prices (
+    variant_id UUID NOT NULL REFERENCES product_variants(id) ON DELETE CASCADE,
+    outlet_id UUID NOT NULL REFERENCES outlets(id) ON DELETE CASCADE,
+    price BIGINT NOT NULL CHECK (price >= 0),
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (variant_id, outlet_id)
+);
+
+-- Stok per outlet. stock_movements adalah buku besar yang hanya boleh ditambah,
+-- stock_levels menyimpan saldo akhirnya dan dikunci saat stok berubah
+CREATE TABLE IF NOT EXISTS stock_levels (
+    outlet_id UUID NOT NULL REFERENCES outlets(id) ON DELETE CASCADE,
+    variant_id UUID NOT NULL REFERENCES product_variants(id) ON DELETE CASCADE,
+    merchant_id UUID NOT NULL REFERENCES merchants(id) ON DELETE CASCADE,
+    on_hand BIGINT NOT NULL DEFAULT 0,
+    low_stock_threshold BIGINT CHECK (low_stock_threshold >= 0),
+    updated_at TIMESTAMP,
+    PRIMARY KEY (outlet_id, variant_id)
+);
+
+CREATE TABLE IF NOT EXISTS stock_movements (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    merchant_id UUID NOT NULL REFERENCES merchants(id),
+    outlet_id UUID NOT NULL REFERENCES outlets(id),
+    variant_id UUID NOT NULL REFERENCES product_variants(id),
+    type VARCHAR(20) NOT NULL CHECK (type IN ('sale', 'return', 'purchase_receipt', 'adjustment', 'transfer_in', 'transfer_out', 'stock_take')),
+    quantity BIGINT NOT NULL,
+    balance_after BIGINT NOT NULL,
+    reference_id UUID,
+    note TEXT NOT NULL DEFAULT '',
+    created_by UUID REFERENCES users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_movements_outlet_created_at ON stock_movements(outlet_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_stock_movements_variant_created_at ON stock_movements(outlet_id, variant_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_stock_movements_reference_id ON stock_movements(reference_id) WHERE reference_id IS NOT NULL;
+
+-- Tolak perubahan dan penghapusan baris buku besar stok
+CREATE OR REPLACE FUNCTION reject_stock_movement_change() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'stock_movements is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS stock_movements_append_only ON stock_movements;
+CREATE TRIGGER stock_movements_append_only
+    BEFORE UPDATE OR DELETE ON stock_movements
+    FOR EACH ROW EXECUTE FUNCTION reject_stock_movement_change();
+
+CREATE TABLE IF NOT EXISTS stock_transfers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    merchant_id UUID NOT NULL REFERENCES merchants(id),
+    from_outlet_id UUID NOT NULL REFERENCES outlets(id),
+    to_outlet_id UUID NOT NULL REFERENCES outlets(id),
+    note TEXT NOT NULL DEFAULT '',
+    created_by UUID REFERENCES users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (from_outlet_id <> to_outlet_id)
+);
+
+CREATE TABLE IF NOT EXISTS stock_transfer_items (
+    transfer_id UUID NOT NULL REFERENCES stock_transfers(id),
+    variant_id UUID NOT NULL REFERENCES product_variants(id),
+    quantity BIGINT NOT NULL CHECK (quantity > 0),
+    PRIMARY KEY (transfer_id, variant_id)
+);
+
+-- Nomor urut per outlet, misalnya nomor penjualan
+CREATE TABLE IF NOT EXISTS outlet_sequences (
+    outlet_id UUID NOT NULL REFERENCES outlets(id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    value BIGINT NOT NULL,
+    PRIMARY KEY (outlet_id, name)
+);
+
+-- Aturan pajak merchant: service charge dan PPN. Aturan tanpa outlet berlaku di
+-- semua outlet, aturan outlet menggantikan aturan merchant dengan tipe yang sama
+CREATE TABLE IF NOT EXISTS tax_rules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    merchant_id UUID NOT NULL REFERENCES merchants(id) ON DELETE CASCADE,
+    outlet_id UUID REFERENCES outlets(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('service_charge', 'vat')),
+    name VARCHAR(50) NOT NULL,
+    rate NUMERIC(5, 2) NOT NULL CHECK (rate >= 0 AND rate <= 100),
+    -- PPN sudah termasuk dalam harga, service charge selalu ditambahkan
+    inclusive BOOLEAN NOT NULL DEFAULT false CHECK (NOT inclusive OR type = 'vat'),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+-- Satu aturan per tipe untuk merchant dan satu per tipe untuk setiap outlet
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tax_rules_merchant_type ON tax_rules(merchant_id, type) WHERE outlet_id IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tax_rules_outlet_type ON tax_rules(outlet_id, type) WHERE outlet_id IS NOT NULL;
+
+-- Pengaturan struk per outlet: teks header dan footer, NPWP dan logo (PNG atau JPEG)
+CREATE TABLE IF NOT EXISTS receipt_settings (
+    outlet_id UUID PRIMARY KEY REFERENCES outlets(id) ON DELETE CASCADE,
+    merchant_id UUID NOT NULL REFERENCES merchants(id),
+    header TEXT NOT NULL DEFAULT '',
+    footer TEXT NOT NULL DEFAULT '',
+    tax_id VARCHAR(50) NOT NULL DEFAULT '',
+    logo BYTEA,
+    updated_at TIMESTAMP
+);
+
+-- Shift kasir di sebuah outlet, kas di laci dihitung saat shift ditutup
+CREATE TABLE IF NOT EXISTS shifts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    merchant_id UUID NOT NULL REFERENCES merchants(id),
+    outlet_id UUID NOT NULL REFERENCES outlets(id),
+    user_id UUID NOT NULL REFERENCES users(id),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('open', 'closed')),
+    opening_float BIGINT NOT NULL CHECK (opening_float >= 0),
+    expected_cash BIGINT,
+    counted_cash BIGINT CHECK (counted_cash >= 0),
+    variance BIGINT,
+    opening_note TEXT NOT NULL DEFAULT '',
+    closing_note TEXT NOT NULL DEFAULT '',
+    opened_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    closed_at TIMESTAMP,
+    closed_by UUID REFERENCES users(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_shifts_outlet_opened_at ON shifts(outlet_id, opened_at DESC, id DESC);
+-- Satu kasir hanya boleh punya satu shift terbuka per outlet
+CREATE UNIQUE INDEX IF NOT EXISTS idx_shifts_open_user ON shifts(outlet_id, user_id) WHERE status = 'open';
+
+-- Buku besar kas laci per shift, kas yang diharapkan adalah modal awal ditambah jumlah semua baris
+CREATE TABLE IF NOT EXISTS cash_movements (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    merchant_id UUID NOT NULL REFERENCES merchants(id),
+    shift_id UUID NOT NULL REFERENCES shifts(id),
+    type VARCHAR(20) NOT NULL CHECK (type IN ('sale', 'refund', 'void', 'cash_in', 'cash_out')),
+    amount BIGINT NOT NULL,
+    reference_id UUID,
+    reason TEXT NOT NULL DEFAULT '',
+    created_by UUID NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_cash_movements_shift_id ON cash_movements(shift_id);
+
+-- Tolak perubahan dan penghapusan baris buku besar kas
+CREATE OR REPLACE FUNCTION reject_cash_movement_change() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'cash_movements is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS cash_movements_append_only ON cash_movements;
+CREATE TRIGGER cash_movements_append_only
+    BEFORE UPDATE OR DELETE ON cash_movements
+    FOR EACH ROW EXECUTE FUNCTION reject_cash_movement_change();
+
+-- Promosi merchant: diskon item, beli X gratis Y dan diskon order dengan
+-- minimum belanja. Promosi berlaku otomatis, atau hanya dengan voucher bila
+-- requires_voucher diisi
+CREATE TABLE IF NOT EXISTS promotions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    merchant_id UUID NOT NULL REFERENCES merchants(id) ON DELETE CASCADE,
+    -- Kosong berarti berlaku di semua outlet
+    outlet_id UUID REFERENCES outlets(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('item_discount', 'buy_x_get_y', 'order_discount')),
+    category_id UUID REFERENCES categories(id) ON DELETE CASCADE,
+    product_id UUID REFERENCES products(id) ON DELETE CASCADE,
+    discount_type VARCHAR(10) NOT NULL CHECK (discount_type IN ('amount', 'percent')),
+    discount_value NUMERIC(14, 2) NOT NULL,
+    buy_quantity BIGINT NOT NULL DEFAULT 0,
+    get_quantity BIGINT NOT NULL DEFAULT 0,
+    min_spend BIGINT NOT NULL DEFAULT 0,
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP,
+    -- Bit n untuk hari ke-n (0 = Minggu), 0 berarti setiap hari
+    weekdays SMALLINT NOT NULL DEFAULT 0,
+    -- Jam berlaku setiap hari (HH:MM) di zona waktu outlet, misalnya happy hour,
+    -- kosong berarti sepanjang hari
+    daily_from VARCHAR(5) NOT NULL DEFAULT '',
+    daily_until VARCHAR(5) NOT NULL DEFAULT '',
+    priority INT NOT NULL DEFAULT 0,
+    stackable BOOLEAN NOT NULL DEFAULT false,
+    requires_voucher BOOLEAN NOT NULL DEFAULT false,
+    -- Jumlah penjualan maksimum yang boleh memakai promosi
+    usage_limit BIGINT CHECK (usage_limit > 0),
+    usage_count BIGINT NOT NULL DEFAULT 0,
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    CHECK (usage_limit IS NULL OR usage_count <= usage_limit)
+);
+
+CREATE INDEX IF NOT EXISTS idx_promotions_merchant_id ON promotions(merchant_id) WHERE is_active;
+
+-- Voucher sekali pakai untuk promosi yang memerlukan voucher
+CREATE TABLE IF NOT EXISTS vouchers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    merchant_id UUID NOT NULL REFERENCES merchants(id) ON DELETE CASCADE,
+    promotion_id UUID NOT NULL REFERENCES promotions(id) ON DELETE CASCADE,
+    code VARCHAR(32) NOT NULL,
+    -- Penjualan yang memakai voucher
+    order_id UUID,
+    redeemed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (merchant_id, code)
+);
+
+CREATE INDEX IF NOT EXISTS idx_vouchers_promotion_created_at ON vouchers(promotion_id, created_at DESC, id DESC);
+
+-- Pelanggan merchant, dikenali dari nomor WhatsApp. Terhubung ke akun user
+-- dengan nomor WhatsApp terverifikasi yang sama.
+CREATE TABLE IF NOT EXISTS customers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    merchant_id UUID NOT NULL REFERENCES merchants(id) ON DELETE CASCADE,
+    user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    name VARCHAR(100) NOT NULL,
+    whatsapp_number VARCHAR(20) NOT NULL,
+    email VARCHAR(255) NOT NULL DEFAULT '',
+    -- Saldo poin, sama dengan jumlah poin pada point_entries
+    points_balance BIGINT NOT NULL DEFAULT 0 CHECK (points_balance >= 0),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    UNIQUE (merchant_id, whatsapp_number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_customers_merchant_created_at ON customers(merchant_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_customers_user_id ON customers(user_id) WHERE user_id IS NOT NULL;
+
+-- Aturan poin loyalitas merchant
+CREATE TABLE IF NOT EXISTS loyalty_programs (
+    merchant_id UUID PRIMARY KEY REFERENCES merchants(id) ON DELETE CASCADE,
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    -- Setiap kelipatan earn_spend belanja mendapat earn_points poin
+    earn_spend BIGINT NOT NULL CHECK (earn_spend > 0),
+    earn_points BIGINT NOT NULL CHECK (earn_points >= 0),
+    -- Nilai satu poin saat ditukar
+    point_value BIGINT NOT NULL CHECK (point_value > 0),
+    min_redeem_points BIGINT NOT NULL DEFAULT 0 CHECK (min_redeem_points >= 0),
+    -- Persentase maksimum belanja yang boleh dibayar dengan poin
+    max_redeem_percent NUMERIC(5, 2) NOT NULL DEFAULT 100,
+    -- Masa berlaku poin dalam hari, 0 berarti poin tidak kedaluwarsa
+    expiry_days INT NOT NULL DEFAULT 0 CHECK (expiry_days >= 0),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+-- Tingkatan member berdasarkan total belanja, pengali poin yang didapat
+CREATE TABLE IF NOT EXISTS loyalty_tiers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    merchant_id UUID NOT NULL REFERENCES loyalty_programs(merchant_id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    min_spend BIGINT NOT NULL CHECK (min_spend >= 0),
+    multiplier NUMERIC(5, 2) NOT NULL CHECK (multiplier >= 1),
+    UNIQUE (merchant_id, min_spend)
+);
+
+-- Penjualan, dimulai sebagai keranjang (open) dan menjadi penjualan saat checkout
+CREATE TABLE IF NOT EXISTS orders (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    merchant_id UUID NOT NULL REFERENCES merchants(id),
+    outlet_id UUID NOT NULL REFERENCES outlets(id),
+    cashier_id UUID NOT NULL REFERENCES users(id),
+    -- Shift tempat penjualan di-checkout
+    shift_id UUID REFERENCES shifts(id),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('open', 'held', 'completed', 'voided')),
+    number BIGINT,
+    note TEXT NOT NULL DEFAULT '',
+    discount_type VARCHAR(10) NOT NULL DEFAULT '' CHECK (discount_type IN ('', 'amount', 'percent')),
+    discount_value NUMERIC(14, 2) NOT NULL DEFAULT 0,
+    -- Voucher yang dimasukkan ke keranjang, ditukarkan saat checkout
+    voucher_id UUID REFERENCES vouchers(id),
+    voucher_code VARCHAR(32) NOT NULL DEFAULT '',
+    -- Member yang berbelanja, poin yang ditukar dan poin yang didapat
+    customer_id UUID REFERENCES customers(id),
+    points_redeemed BIGINT NOT NULL DEFAULT 0 CHECK (points_redeemed >= 0),
+    points_discount BIGINT NOT NULL DEFAULT 0 CHECK (points_discount >= 0),
+    points_earned BIGINT NOT NULL DEFAULT 0 CHECK (points_earned >= 0),
+    subtotal BIGINT NOT NULL DEFAULT 0,
+    discount_total BIGINT NOT NULL DEFAULT 0,
+    promotion_total BIGINT NOT NULL DEFAULT 0,
+    service_charge_total BIGINT NOT NULL DEFAULT 0,
+    tax_total BIGINT NOT NULL DEFAULT 0,
+    total BIGINT NOT NULL DEFAULT 0,
+    refunded_total BIGINT NOT NULL DEFAULT 0 CHECK (refunded_total >= 0),
+    idempotency_key VARCHAR(100),
+    void_reason TEXT NOT NULL DEFAULT '',
+    version BIGINT NOT NULL DEFAULT 1,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    completed_at TIMESTAMP,
+    voided_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_orders_outlet_created_at ON orders(outlet_id, created_at DESC, id DESC);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_orders_outlet_number ON orders(outlet_id, number) WHERE number IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_orders_shift_id ON orders(shift_id) WHERE shift_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_orders_customer_created_at ON orders(customer_id, created_at DESC, id DESC) WHERE customer_id IS NOT NULL;
+-- Mencegah checkout ganda saat kasir menekan tombol bayar dua kali
+CREATE UNIQUE INDEX IF NOT EXISTS idx_orders_idempotency_key ON orders(merchant_id, idempotency_key) WHERE idempotency_key IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS order_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products(id),
+    variant_id UUID NOT NULL REFERENCES product_variants(id),
+    -- Kategori produk saat item ditambahkan, untuk promosi per kategori
+    category_id UUID,
+    sku VARCHAR(64) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    unit VARCHAR(20) NOT NULL,
+    quantity BIGINT NOT NULL CHECK (quantity > 0),
+    unit_price BIGINT NOT NULL CHECK (unit_price >= 0),
+    discount_type VARCHAR(10) NOT NULL DEFAULT '' CHECK (discount_type IN ('', 'amount', 'percent')),
+    discount_value NUMERIC(14, 2) NOT NULL DEFAULT 0,
+    -- Produk bebas PPN
+    tax_exempt BOOLEAN NOT NULL DEFAULT false,
+    subtotal BIGINT NOT NULL,
+    discount_amount BIGINT NOT NULL DEFAULT 0,
+    promotion_discount BIGINT NOT NULL DEFAULT 0,
+    total BIGINT NOT NULL,
+    -- Bagian diskon order yang dibebankan ke item
+    order_discount BIGINT NOT NULL DEFAULT 0,
+    service_charge BIGINT NOT NULL DEFAULT 0,
+    -- Dasar pengenaan pajak dan PPN item
+    tax_base BIGINT NOT NULL DEFAULT 0,
+    tax_amount BIGINT NOT NULL DEFAULT 0,
+    -- Total yang dibayar pelanggan untuk item termasuk service charge dan PPN
+    grand_total BIGINT NOT NULL DEFAULT 0,
+    refunded_quantity BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (refunded_quantity >= 0 AND refunded_quantity <= quantity)
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_items_order_id ON order_items(order_id);
+
+-- Pajak yang dikenakan ke order, disalin dari aturan pajak saat keranjang dibuat
+CREATE TABLE IF NOT EXISTS order_taxes (
+    order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('service_charge', 'vat')),
+    name VARCHAR(50) NOT NULL,
+    rate NUMERIC(5, 2) NOT NULL,
+    inclusive BOOLEAN NOT NULL DEFAULT false,
+    base BIGINT NOT NULL DEFAULT 0,
+    amount BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (order_id, type)
+);
+
+-- Diskon promosi yang diterapkan ke order, per item atau untuk seluruh order
+-- bila item_id kosong
+CREATE TABLE IF NOT EXISTS order_promotions (
+    order_id UUID NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    promotion_id UUID NOT NULL REFERENCES promotions(id),
+    item_id UUID,
+    name VARCHAR(100) NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    PRIMARY KEY (order_id, position)
+);
+
+-- Pembayaran sebuah order, satu order bisa dibayar dengan beberapa metode
+CREATE TABLE IF NOT EXISTS payments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    order_id UUID NOT NULL REFERENCES orders(id),
+    merchant_id UUID NOT NULL REFERENCES merchants(id),
+    method VARCHAR(20) NOT NULL CHECK (method IN ('cash', 'card', 'qris', 'ewallet', 'store_credit')),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('pending', 'authorized', 'captured', 'failed', 'voided', 'refunded')),
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    refunded_amount BIGINT NOT NULL DEFAULT 0,
+    tendered BIGINT NOT NULL DEFAULT 0,
+    change_amount BIGINT NOT NULL DEFAULT 0,
+    -- Untuk store credit berisi kode store credit yang dipakai
+    reference VARCHAR(100) NOT NULL DEFAULT '',
+    -- Payment gateway yang memproses pembayaran dan ID pembayaran di sana
+    provider VARCHAR(50) NOT NULL,
+    provider_reference VARCHAR(100) NOT NULL,
+    qr_string TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    CHECK (refunded_amount >= 0 AND refunded_amount <= amount)
+);
+
+CREATE INDEX IF NOT EXISTS idx_payments_order_id ON payments(order_id);
+-- Notifikasi dari payment gateway mencari pembayaran berdasarkan ID di gateway
+CREATE UNIQUE INDEX IF NOT EXISTS idx_payments_provider_reference ON payments(provider, provider_reference);
+
+-- Refund item dari penjualan yang sudah selesai, dikembalikan lewat pembayaran
+-- asal atau sebagai store credit
+CREATE TABLE IF NOT EXISTS refunds (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    merchant_id UUID NOT NULL REFERENCES merchants(id),
+    outlet_id UUID NOT NULL REFERENCES outlets(id),
+    order_id UUID NOT NULL REFERENCES orders(id),
+    -- Shift tempat refund diselesaikan
+    shift_id UUID REFERENCES shifts(id),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('pending', 'completed')),
+    tender VARCHAR(20) NOT NULL CHECK (tender IN ('original', 'store_credit')),
+    reason TEXT NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount >= 0),
+    -- Bagian poin yang didapat dari penjualan yang ditarik kembali dan bagian
+    -- poin yang ditukar yang dikembalikan, sebanding dengan nilai refund
+    points_taken BIGINT NOT NULL DEFAULT 0 CHECK (points_taken >= 0),
+    points_returned BIGINT NOT NULL DEFAULT 0 CHECK (points_returned >= 0),
+    idempotency_key VARCHAR(100) NOT NULL,
+    created_by UUID NOT NULL REFERENCES users(id),
+    -- Manajer yang menyetujui refund di atas batas persetujuan
+    approved_by UUID REFERENCES users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_refunds_order_id ON refunds(order_id);
+CREATE INDEX IF NOT EXISTS idx_refunds_shift_id ON refunds(shift_id) WHERE shift_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_refunds_outlet_created_at ON refunds(outlet_id, created_at DESC, id DESC);
+-- Mencegah refund ganda saat request diulang
+CREATE UNIQUE INDEX IF NOT EXISTS idx_refunds_idempotency_key ON refunds(merchant_id, idempotency_key);
+
+CREATE TABLE IF NOT EXISTS refund_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    refund_id UUID NOT NULL REFERENCES refunds(id),
+    order_item_id UUID NOT NULL REFERENCES order_items(id),
+    variant_id UUID NOT NULL REFERENCES product_variants(id),
+    quantity BIGINT NOT NULL CHECK (quantity > 0),
+    amount BIGINT NOT NULL CHECK (amount >= 0),
+    restock BOOLEAN NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_refund_items_refund_id ON refund_items(refund_id);
+
+-- Bagian refund yang dikembalikan lewat pembayaran asal
+CREATE TABLE IF NOT EXISTS refund_payments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    refund_id UUID NOT NULL REFERENCES refunds(id),
+    payment_id UUID NOT NULL REFERENCES payments(id),
+    method VARCHAR(20) NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('pending', 'refunded')),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_refund_payments_refund_id ON refund_payments(refund_id);
+
+-- Store credit dari refund, dipakai pelanggan untuk pembelian berikutnya
+CREATE TABLE IF NOT EXISTS store_credits (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    merchant_id UUID NOT NULL REFERENCES merchants(id),
+    refund_id UUID NOT NULL UNIQUE REFERENCES refunds(id),
+    code VARCHAR(20) NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    balance BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    CHECK (balance >= 0 AND balance <= amount),
+    UNIQUE (merchant_id, code)
+);
+
+-- Riwayat poin pelanggan. Poin yang didapat habis dipakai atau kedaluwarsa
+-- mulai dari yang paling dulu kedaluwarsa, remaining adalah sisanya.
+CREATE TABLE IF NOT EXISTS point_entries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    merchant_id UUID NOT NULL REFERENCES merchants(id) ON DELETE CASCADE,
+    customer_id UUID NOT NULL REFERENCES customers(id) ON DELETE CASCADE,
+    order_id UUID REFERENCES orders(id),
+    type VARCHAR(20) NOT NULL CHECK (type IN ('earn', 'redeem', 'expire', 'void', 'refund', 'adjust')),
+    points BIGINT NOT NULL CHECK (points <> 0),
+    balance_after BIGINT NOT NULL CHECK (balance_after >= 0),
+    remaining BIGINT NOT NULL DEFAULT 0 CHECK (remaining >= 0 AND (remaining = 0 OR remaining <= points)),
+    expires_at TIMESTAMP,
+    note TEXT NOT NULL DEFAULT '',
+    created_by UUID REFERENCES users(id),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_point_entries_customer_created_at ON point_entries(customer_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_point_entries_remaining ON point_entries(customer_id, expires_at) WHERE remaining > 0;
+
+-- Role dan permission bawaan
+INSERT INTO roles (name, description)
+VALUES
+    ('admin', 'Administrator back office'),
+    ('owner', 'Pemilik usaha'),
+    ('manager', 'Manajer outlet'),
+    ('cashier', 'Kasir'),
+    ('customer', 'Pelanggan')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO permissions (name, description)
+VALUES
+    ('user.read', 'Melihat akun user lain'),
+    ('user.manage', 'Mengubah, menonaktifkan dan memulihkan akun user lain'),
+    ('role.read', 'Melihat role dan permission'),
+    ('role.assign', 'Memberikan dan mencabut role user'),
+    ('merchant.manage', 'Mengubah data merchant'),
+    ('outlet.manage', 'Menambah dan mengubah outlet merchant'),
+    ('staff.manage', 'Mengundang staf ke outlet merchant'),
+    ('catalog.read', 'Melihat katalog produk'),
+    ('catalog.manage', 'Mengubah kategori, produk, varian dan harga'),
+    ('inventory.read', 'Melihat stok dan riwayat pergerakan stok outlet'),
+    ('inventory.manage', 'Menyesuaikan, menerima, menghitung dan memindahkan stok'),
+    ('order.read', 'Melihat penjualan outlet'),
+    ('order.create', 'Membuat keranjang dan melakukan checkout'),
+    ('order.discount', 'Memberikan diskon manual'),
+    ('order.void', 'Membatalkan penjualan'),
+    ('order.refund', 'Melakukan refund item penjualan'),
+    ('order.refund_approve', 'Menyetujui refund di atas batas persetujuan'),
+    ('shift.operate', 'Membuka dan menutup shift sendiri serta mencatat kas masuk dan keluar'),
+    ('shift.manage', 'Melihat dan menutup shift kasir lain'),
+    ('tax.manage', 'Mengatur PPN dan service charge merchant'),
+    ('promotion.manage', 'Mengatur promosi dan voucher merchant'),
+    ('customer.manage', 'Mendaftarkan pelanggan serta melihat poin dan riwayat belanjanya'),
+    ('loyalty.manage', 'Mengatur aturan poin dan tingkatan member serta menyesuaikan poin')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role, permission)
+VALUES
+    ('admin', 'user.read'),
+    ('admin', 'user.manage'),
+    ('admin', 'role.read'),
+    ('admin', 'role.assign'),
+    ('owner', 'merchant.manage'),
+    ('owner', 'outlet.manage'),
+    ('owner', 'staff.manage'),
+    ('owner', 'catalog.read'),
+    ('owner', 'catalog.manage'),
+    ('owner', 'inventory.read'),
+    ('owner', 'inventory.manage'),
+    ('owner', 'order.read'),
+    ('owner', 'order.create'),
+    ('owner', 'order.discount'),
+    ('owner', 'order.void'),
+    ('owner', 'order.refund'),
+    ('owner', 'order.refund_approve'),
+    ('owner', 'shift.operate'),
+    ('owner', 'shift.manage'),
+    ('owner', 'tax.manage'),
+    ('owner', 'promotion.manage'),
+    ('owner', 'customer.manage'),
+    ('owner', 'loyalty.manage'),
+    ('manager', 'staff.manage'),
+    ('manager', 'catalog.read'),
+    ('manager', 'catalog.manage'),
+    ('manager', 'inventory.read'),
+    ('manager', 'inventory.manage'),
+    ('manager', 'order.read'),
+    ('manager', 'order.create'),
+    ('manager', 'order.discount'),
+    ('manager', 'order.void'),
+    ('manager', 'order.refund'),
+    ('manager', 'order.refund_approve'),
+    ('manager', 'shift.operate'),
+    ('manager', 'shift.manage'),
+    ('manager', 'tax.manage'),
+    ('manager', 'promotion.manage'),
+    ('manager', 'customer.manage'),
+    ('manager', 'loyalty.manage'),
+    ('cashier', 'catalog.read'),
+    ('cashier', 'inventory.read'),
+    ('cashier', 'order.read'),
+    ('cashier', 'order.create'),
+    ('cashier', 'order.refund'),
+    ('cashier', 'customer.manage'),
+    ('cashier', 'shift.operate')
+ON CONFLICT (role, permission) DO NOTHING;
 
 -- Hapus data yang mungkin sudah ada untuk menghindari konflik
-TRUNCATE TABLE users;
+TRUNCATE TABLE users CASCADE;
 
 -- Dummy data untuk login dengan email/password
 -- Password: password123 (bcrypt hash)
@@ -59,11 +792,13 @@ VALUES
     );
 
 -- Dummy data untuk login dengan WhatsApp/PIN
+-- PIN disimpan dalam bentuk bcrypt hash: whatsapp_user 123456, dual_auth_user 654321
 INSERT INTO users (
     id,
     username, 
     password_hash,
     whatsapp_number,
+    whatsapp_verified_at,
     pin,
     is_active, 
     failed_login_attempts,
@@ -75,7 +810,8 @@ VALUES
         'whatsapp_user',
         '',
         '+6281234567890',
-        '123456',
+        CURRENT_TIMESTAMP,
+        '$2a$10$Tc0sz5rnlrE.8MF1oylH3OH.BujoNtgHOB5Qp0jEXEXgDWE7bi.6G',
         true,
         0,
         CURRENT_TIMESTAMP
@@ -85,19 +821,21 @@ VALUES
         'dual_auth_user',
         '$2a$10$lT.Lx2GsvtRYEfVpwGfh8e9HM8MJW8.eLm6Ar.iBhstGBxUclfAPO',
         '+6289876543210',
-        '654321',
+        CURRENT_TIMESTAMP,
+        '$2a$10$Dk5gJdLXSDQsWn.YwXwUPedfoFyo9dPB5oh1MChXtnSUFijKwygI.',
         true,
         0,
         CURRENT_TIMESTAMP
     );
 
--- User dengan email dan WhatsApp (bisa login dengan kedua metode)
+-- User dengan email dan WhatsApp (bisa login dengan kedua metode), PIN: 111222
 INSERT INTO users (
     id,
     username, 
     password_hash,
     email,
     whatsapp_number,
+    whatsapp_verified_at,
     pin,
     is_active, 
     failed_login_attempts,
@@ -110,8 +848,32 @@ VALUES
         '$2a$10$lT.Lx2GsvtRYEfVpwGfh8e9HM8MJW8.eLm6Ar.iBhstGBxUclfAPO',
         'complete_user@example.com',
         '+6287654321098',
-        '111222',
+        CURRENT_TIMESTAMP,
+        '$2a$10$5lvWXsZC/O3esIqgW1sKhuZBT1lxqtRTFhtFEh7YhcqiONQqrElNC',
         true,
         0,
         CURRENT_TIMESTAMP
     );
+
+-- User admin mendapatkan role admin
+INSERT INTO user_roles (user_id, role)
+VALUES
+    ('22222222-2222-2222-2222-222222222222', 'admin');
+
+-- Merchant contoh milik complete_user dengan satu outlet, email_user menjadi kasir
+INSERT INTO merchants (id, name, owner_user_id)
+VALUES
+    ('aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa', 'Kijun Coffee', '55555555-5555-5555-5555-555555555555');
+
+INSERT INTO outlets (id, merchant_id, name, address)
+VALUES
+    ('bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb', 'aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa', 'Kijun Coffee Pusat', 'Jl. Merdeka No. 1');
+
+INSERT INTO merchant_members (merchant_id, user_id, role)
+VALUES
+    ('aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa', '55555555-5555-5555-5555-555555555555', 'owner'),
+    ('aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa', '11111111-1111-1111-1111-111111111111', 'cashier');
+
+INSERT INTO outlet_staff (outlet_id, user_id)
+VALUES
+    ('bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb', '11111111-1111-1111-1111-111111111111');
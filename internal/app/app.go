@@ -1,20 +1,46 @@
 package app
 
 import (
+	"context"
+	"fmt"
 	"github/kijunpos/config"
 	"github/kijunpos/config/db"
+	"github/kijunpos/config/redis"
 	"github/kijunpos/internal/delivery/grpc"
+	"github/kijunpos/internal/delivery/webhook"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/authorization"
 	"github/kijunpos/internal/pkg/email"
+	"github/kijunpos/internal/pkg/payment"
+	"github/kijunpos/internal/pkg/token"
+	"github/kijunpos/internal/pkg/whatsapp"
 	"github/kijunpos/internal/repository"
+	catalogUseCase "github/kijunpos/internal/usecase/catalog"
+	inventoryUseCase "github/kijunpos/internal/usecase/inventory"
+	loyaltyUseCase "github/kijunpos/internal/usecase/loyalty"
+	merchantUseCase "github/kijunpos/internal/usecase/merchant"
+	orderUseCase "github/kijunpos/internal/usecase/order"
+	paymentUseCase "github/kijunpos/internal/usecase/payment"
+	promotionUseCase "github/kijunpos/internal/usecase/promotion"
+	receiptUseCase "github/kijunpos/internal/usecase/receipt"
+	refundUseCase "github/kijunpos/internal/usecase/refund"
+	shiftUseCase "github/kijunpos/internal/usecase/shift"
+	taxUseCase "github/kijunpos/internal/usecase/tax"
 	userUseCase "github/kijunpos/internal/usecase/user"
 	"log"
 )
 
 // Application represents the application with all its dependencies
 type Application struct {
-	Config      *config.Config
-	DBManager   *db.Manager
-	GRPCHandler grpc.UserHandler
+	Config       *config.Config
+	DBManager    *db.Manager
+	TokenService domain.TokenService
+	Authorizer   domain.AuthorizationService
+	Tenants      domain.TenantResolver
+	GRPCHandler  grpc.Handlers
+	Webhook      *webhook.Handler
+	// QRISSimulator stands in for the QRIS provider when it is configured
+	QRISSimulator *payment.QRISSimulator
 }
 
 // NewApplication creates and initializes a new application
@@ -24,6 +50,9 @@ func NewApplication() *Application {
 		log.Fatalf("error when loading config data: %v", err)
 	}
 	configData := config.GetConfig()
+	if configData.App.DevMode {
+		log.Printf("WARNING: development mode is enabled, verification codes are returned to callers")
+	}
 
 	// Initialize database connections
 	dbManager := db.NewManager()
@@ -39,7 +68,22 @@ func NewApplication() *Application {
 
 	// Initialize repositories
 	userRepo := repository.NewUserRepository(kijunConn)
-	verificationRepo := repository.NewVerificationRepository()
+	verificationRepo, rateLimitRepo := newVerificationRepositories(configData, kijunConn)
+	refreshTokenRepo := repository.NewRefreshTokenRepository(kijunConn)
+	roleRepo := repository.NewRoleRepository(kijunConn)
+	merchantRepo := repository.NewMerchantRepository(kijunConn)
+	outletRepo := repository.NewOutletRepository(kijunConn)
+	categoryRepo := repository.NewCategoryRepository(kijunConn)
+	productRepo := repository.NewProductRepository(kijunConn)
+	inventoryRepo := repository.NewInventoryRepository(kijunConn)
+	orderRepo := repository.NewOrderRepository(kijunConn)
+	paymentRepo := repository.NewPaymentRepository(kijunConn)
+	refundRepo := repository.NewRefundRepository(kijunConn)
+	shiftRepo := repository.NewShiftRepository(kijunConn)
+	receiptRepo := repository.NewReceiptRepository(kijunConn)
+	taxRuleRepo := repository.NewTaxRuleRepository(kijunConn)
+	promotionRepo := repository.NewPromotionRepository(kijunConn)
+	loyaltyRepo := repository.NewLoyaltyRepository(kijunConn)
 
 	// Initialize email service
 	emailService := email.NewEmailService(email.Config{
@@ -50,21 +94,254 @@ func NewApplication() *Application {
 		SMTPPassword: configData.Email.SMTPPassword,
 	})
 
+	// Initialize WhatsApp service
+	var whatsAppService domain.WhatsAppService
+	switch configData.WhatsApp.Provider {
+	case whatsapp.ProviderHTTP:
+		whatsAppService = whatsapp.NewWhatsAppService(whatsapp.Config{
+			APIURL:           configData.WhatsApp.APIURL,
+			PhoneNumberID:    configData.WhatsApp.PhoneNumberID,
+			AccessToken:      configData.WhatsApp.AccessToken,
+			TemplateName:     configData.WhatsApp.TemplateName,
+			TemplateLanguage: configData.WhatsApp.TemplateLanguage,
+			Timeout:          configData.WhatsApp.Timeout,
+		})
+	case whatsapp.ProviderFake:
+		whatsAppService = whatsapp.NewFakeWhatsAppService()
+	default:
+		log.Fatalf("unknown whatsapp provider: %s", configData.WhatsApp.Provider)
+	}
+
+	// Initialize payment gateways
+	qrisConfig := payment.QRISConfig{
+		APIURL:        configData.Payment.QRISAPIURL,
+		APIKey:        configData.Payment.QRISAPIKey,
+		WebhookSecret: configData.Payment.QRISWebhookSecret,
+		Expiry:        configData.Payment.QRISExpiry,
+		Timeout:       configData.Payment.QRISTimeout,
+	}
+	var qrisSimulator *payment.QRISSimulator
+	switch configData.Payment.QRISProvider {
+	case payment.ProviderHTTP:
+	case payment.ProviderSimulator:
+		qrisSimulator = payment.NewQRISSimulator(
+			configData.Payment.QRISAPIKey,
+			configData.Payment.QRISSimulatorWebhookURL,
+			configData.Payment.QRISWebhookSecret,
+		)
+		qrisConfig.APIURL = fmt.Sprintf("http://localhost:%d", configData.Payment.QRISSimulatorPort)
+	default:
+		log.Fatalf("unknown qris provider: %s", configData.Payment.QRISProvider)
+	}
+	cashGateway := payment.NewCashGateway()
+	qrisGateway := payment.NewQRISGateway(qrisConfig)
+	paymentGateways := payment.NewGateways().
+		Register(cashGateway, domain.PaymentMethodCash, domain.PaymentMethodCard).
+		Register(qrisGateway, domain.PaymentMethodQRIS, domain.PaymentMethodEWallet).
+		Register(payment.NewStoreCreditGateway(), domain.PaymentMethodStoreCredit)
+
+	// Initialize token service
+	tokenService, err := token.NewTokenService(token.Config{
+		Issuer:          configData.Token.Issuer,
+		SigningKeyID:    configData.Token.SigningKeyID,
+		SigningKey:      configData.Token.SigningKey,
+		AccessTokenTTL:  configData.Token.AccessTokenTTL,
+		RefreshTokenTTL: configData.Token.RefreshTokenTTL,
+	})
+	if err != nil {
+		log.Fatalf("error when initializing token service: %v", err)
+	}
+
+	// Initialize authorization service
+	authorizationService := authorization.NewAuthorizationService(roleRepo, configData.Authorization.PermissionCacheTTL)
+
 	// Initialize use cases
-	userUC := userUseCase.NewUserUseCase(userRepo, verificationRepo, emailService)
+	verificationPolicy := domain.VerificationPolicy{
+		ResendCooldown:  configData.Verification.ResendCooldown,
+		DailyLimit:      configData.Verification.DailyLimit,
+		IPDailyLimit:    configData.Verification.IPDailyLimit,
+		MinResponseTime: configData.Verification.MinResponseTime,
+		EchoCode:        configData.App.DevMode,
+	}
+	userUC := userUseCase.NewUserUseCase(
+		userRepo,
+		verificationRepo,
+		refreshTokenRepo,
+		rateLimitRepo,
+		emailService,
+		whatsAppService,
+		tokenService,
+		authorizationService,
+		domain.LockoutPolicy{
+			MaxFailedAttempts: configData.Lockout.MaxFailedAttempts,
+			BaseLockDuration:  configData.Lockout.BaseLockDuration,
+			MaxLockDuration:   configData.Lockout.MaxLockDuration,
+		},
+		verificationPolicy,
+	)
+	adminUserUC := userUseCase.NewAdminUserUseCase(
+		userRepo,
+		verificationRepo,
+		refreshTokenRepo,
+		roleRepo,
+		emailService,
+		authorizationService,
+		verificationPolicy,
+	)
+
+	merchantUC := merchantUseCase.NewMerchantUseCase(
+		merchantRepo,
+		outletRepo,
+		userRepo,
+		roleRepo,
+		authorizationService,
+	)
+
+	catalogUC := catalogUseCase.NewCatalogUseCase(
+		categoryRepo,
+		productRepo,
+		outletRepo,
+		authorizationService,
+	)
+
+	inventoryUC := inventoryUseCase.NewInventoryUseCase(
+		inventoryRepo,
+		productRepo,
+		outletRepo,
+		authorizationService,
+	)
+
+	orderUC := orderUseCase.NewOrderUseCase(
+		orderRepo,
+		productRepo,
+		outletRepo,
+		paymentRepo,
+		shiftRepo,
+		taxRuleRepo,
+		promotionRepo,
+		loyaltyRepo,
+		paymentGateways,
+		authorizationService,
+	)
+
+	paymentUC := paymentUseCase.NewPaymentUseCase(
+		paymentRepo,
+		orderRepo,
+		paymentGateways,
+		authorizationService,
+	)
+
+	refundUC := refundUseCase.NewRefundUseCase(
+		refundRepo,
+		orderRepo,
+		shiftRepo,
+		loyaltyRepo,
+		paymentGateways,
+		authorizationService,
+		configData.Refund.ApprovalThreshold,
+	)
+
+	shiftUC := shiftUseCase.NewShiftUseCase(
+		shiftRepo,
+		authorizationService,
+	)
+
+	receiptUC := receiptUseCase.NewReceiptUseCase(
+		receiptRepo,
+		orderRepo,
+		merchantRepo,
+		outletRepo,
+		userRepo,
+		emailService,
+		whatsAppService,
+		authorizationService,
+	)
+
+	taxUC := taxUseCase.NewTaxUseCase(
+		taxRuleRepo,
+		outletRepo,
+		authorizationService,
+	)
+
+	promotionUC := promotionUseCase.NewPromotionUseCase(
+		promotionRepo,
+		outletRepo,
+		categoryRepo,
+		productRepo,
+		authorizationService,
+	)
+
+	loyaltyUC := loyaltyUseCase.NewLoyaltyUseCase(
+		loyaltyRepo,
+		orderRepo,
+		userRepo,
+		authorizationService,
+	)
 
 	// Initialize gRPC handlers
-	userHandler := grpc.NewUserHandler(userUC)
+	handlers := grpc.Handlers{
+		User:      grpc.NewUserHandler(userUC),
+		AdminUser: grpc.NewAdminUserHandler(adminUserUC),
+		Merchant:  grpc.NewMerchantHandler(merchantUC),
+		Catalog:   grpc.NewCatalogHandler(catalogUC),
+		Inventory: grpc.NewInventoryHandler(inventoryUC),
+		Order:     grpc.NewOrderHandler(orderUC),
+		Payment:   grpc.NewPaymentHandler(paymentUC),
+		Refund:    grpc.NewRefundHandler(refundUC),
+		Shift:     grpc.NewShiftHandler(shiftUC),
+		Receipt:   grpc.NewReceiptHandler(receiptUC),
+		Tax:       grpc.NewTaxHandler(taxUC),
+		Promotion: grpc.NewPromotionHandler(promotionUC),
+		Loyalty:   grpc.NewLoyaltyHandler(loyaltyUC),
+	}
 
 	return &Application{
-		Config:      configData,
-		DBManager:   dbManager,
-		GRPCHandler: userHandler,
+		Config:        configData,
+		DBManager:     dbManager,
+		TokenService:  tokenService,
+		Authorizer:    authorizationService,
+		Tenants:       merchantUC,
+		GRPCHandler:   handlers,
+		Webhook:       webhook.NewHandler(paymentUC),
+		QRISSimulator: qrisSimulator,
 	}
 }
 
 // Start starts the application
 func (app *Application) Start() {
+	if app.QRISSimulator != nil {
+		go func() {
+			log.Printf("qris simulator listening on :%d", app.Config.Payment.QRISSimulatorPort)
+			if err := app.QRISSimulator.ListenAndServe(app.Config.Payment.QRISSimulatorPort); err != nil {
+				log.Fatalf("failed to serve qris simulator: %v", err)
+			}
+		}()
+	}
+
+	// Start the webhook server
+	go webhook.StartWebhookServer(app.Config, app.Webhook)
+
 	// Start the gRPC server
-	grpc.StartGRPCServer(app.Config, app.GRPCHandler)
+	grpc.StartGRPCServer(app.Config, app.TokenService, app.Authorizer, app.Tenants, app.GRPCHandler)
+}
+
+// newVerificationRepositories creates the verification code store and the rate
+// limit counters of the store selected in the configuration
+func newVerificationRepositories(configData *config.Config, kijunConn *db.Connection) (domain.VerificationRepository, domain.RateLimitRepository) {
+	verification := configData.Verification
+	switch verification.Store {
+	case "postgres":
+		return repository.NewPostgresVerificationRepository(kijunConn, verification.CodeSecret, verification.MaxAttempts),
+			repository.NewPostgresRateLimitRepository(kijunConn)
+	case "redis":
+		redisClient, err := redis.NewClient(context.Background(), configData.Redis)
+		if err != nil {
+			log.Fatalf("error when initializing redis: %v", err)
+		}
+		return repository.NewRedisVerificationRepository(redisClient, verification.CodeSecret, verification.MaxAttempts),
+			repository.NewRedisRateLimitRepository(redisClient)
+	default:
+		log.Fatalf("unknown verification store: %s", verification.Store)
+		return nil, nil
+	}
 }
@@ -0,0 +1,34 @@
+package catalog
+
+import (
+	"context"
+	pbCatalog "github/kijunpos/gen/proto/catalog"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// AddVariant handles adding a variant to a product
+func (h *Handler) AddVariant(ctx context.Context, req *pbCatalog.AddVariantRequest) (*pbCatalog.ProductResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.catalog.AddVariant")
+	defer span.End()
+
+	productID, err := errors.ParseID("product_id", req.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	variant := fromVariantInput(req.Variant)
+	variant.ProductID = productID
+
+	// Call use case
+	product, err := h.catalogUseCase.AddVariant(ctx, variant)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbCatalog.ProductResponse{
+		Success: true,
+		Message: "Variant added successfully",
+		Product: toProductData(product, selectedOutlet(ctx)),
+	}, nil
+}
@@ -0,0 +1,35 @@
+package catalog
+
+import (
+	"context"
+	pbCatalog "github/kijunpos/gen/proto/catalog"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// CreateCategory handles adding a category to the selected merchant
+func (h *Handler) CreateCategory(ctx context.Context, req *pbCatalog.CreateCategoryRequest) (*pbCatalog.CategoryResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.catalog.CreateCategory")
+	defer span.End()
+
+	parentID, err := errors.ParseOptionalID("parent_id", req.ParentId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	category, err := h.catalogUseCase.CreateCategory(ctx, &domain.Category{
+		ParentID: parentID,
+		Name:     req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbCatalog.CategoryResponse{
+		Success:  true,
+		Message:  "Category created successfully",
+		Category: toCategoryData(category),
+	}, nil
+}
@@ -0,0 +1,45 @@
+package catalog
+
+import (
+	"context"
+	pbCatalog "github/kijunpos/gen/proto/catalog"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// CreateProduct handles adding a product with its variants to the selected merchant
+func (h *Handler) CreateProduct(ctx context.Context, req *pbCatalog.CreateProductRequest) (*pbCatalog.ProductResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.catalog.CreateProduct")
+	defer span.End()
+
+	categoryID, err := errors.ParseOptionalID("category_id", req.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	product := &domain.Product{
+		CategoryID:  categoryID,
+		Name:        req.Name,
+		Description: req.Description,
+		Unit:        req.Unit,
+		BasePrice:   req.BasePrice,
+		TaxExempt:   req.TaxExempt,
+		Variants:    make([]*domain.ProductVariant, 0, len(req.Variants)),
+	}
+	for _, input := range req.Variants {
+		product.Variants = append(product.Variants, fromVariantInput(input))
+	}
+
+	// Call use case
+	product, err = h.catalogUseCase.CreateProduct(ctx, product)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbCatalog.ProductResponse{
+		Success: true,
+		Message: "Product created successfully",
+		Product: toProductData(product, selectedOutlet(ctx)),
+	}, nil
+}
@@ -0,0 +1,29 @@
+package catalog
+
+import (
+	"context"
+	pbCatalog "github/kijunpos/gen/proto/catalog"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// DeleteCategory handles removing a category
+func (h *Handler) DeleteCategory(ctx context.Context, req *pbCatalog.DeleteCategoryRequest) (*pbCatalog.CatalogResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.catalog.DeleteCategory")
+	defer span.End()
+
+	id, err := errors.ParseID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	if err := h.catalogUseCase.DeleteCategory(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return &pbCatalog.CatalogResponse{
+		Success: true,
+		Message: "Category deleted successfully",
+	}, nil
+}
@@ -0,0 +1,29 @@
+package catalog
+
+import (
+	"context"
+	pbCatalog "github/kijunpos/gen/proto/catalog"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// DeleteProduct handles removing a product from the catalog
+func (h *Handler) DeleteProduct(ctx context.Context, req *pbCatalog.DeleteProductRequest) (*pbCatalog.CatalogResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.catalog.DeleteProduct")
+	defer span.End()
+
+	id, err := errors.ParseID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	if err := h.catalogUseCase.DeleteProduct(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return &pbCatalog.CatalogResponse{
+		Success: true,
+		Message: "Product deleted successfully",
+	}, nil
+}
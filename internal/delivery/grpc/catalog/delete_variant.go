@@ -0,0 +1,29 @@
+package catalog
+
+import (
+	"context"
+	pbCatalog "github/kijunpos/gen/proto/catalog"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// DeleteVariant handles removing a variant of a product
+func (h *Handler) DeleteVariant(ctx context.Context, req *pbCatalog.DeleteVariantRequest) (*pbCatalog.CatalogResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.catalog.DeleteVariant")
+	defer span.End()
+
+	id, err := errors.ParseID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	if err := h.catalogUseCase.DeleteVariant(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return &pbCatalog.CatalogResponse{
+		Success: true,
+		Message: "Variant deleted successfully",
+	}, nil
+}
@@ -0,0 +1,31 @@
+package catalog
+
+import (
+	"context"
+	pbCatalog "github/kijunpos/gen/proto/catalog"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// GetProduct handles retrieving a product of the selected merchant
+func (h *Handler) GetProduct(ctx context.Context, req *pbCatalog.GetProductRequest) (*pbCatalog.ProductResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.catalog.GetProduct")
+	defer span.End()
+
+	id, err := errors.ParseID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	product, err := h.catalogUseCase.GetProduct(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbCatalog.ProductResponse{
+		Success: true,
+		Message: "Product retrieved successfully",
+		Product: toProductData(product, selectedOutlet(ctx)),
+	}, nil
+}
@@ -0,0 +1,25 @@
+package catalog
+
+import (
+	"context"
+	pbCatalog "github/kijunpos/gen/proto/catalog"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// GetProductByBarcode handles looking up a scanned barcode
+func (h *Handler) GetProductByBarcode(ctx context.Context, req *pbCatalog.GetProductByBarcodeRequest) (*pbCatalog.ProductResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.catalog.GetProductByBarcode")
+	defer span.End()
+
+	// Call use case
+	product, err := h.catalogUseCase.GetProductByBarcode(ctx, req.Barcode)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbCatalog.ProductResponse{
+		Success: true,
+		Message: "Product retrieved successfully",
+		Product: toProductData(product, selectedOutlet(ctx)),
+	}, nil
+}
@@ -0,0 +1,112 @@
+package catalog
+
+import (
+	"context"
+	"database/sql"
+	pbCatalog "github/kijunpos/gen/proto/catalog"
+	"github/kijunpos/internal/domain"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Handler handles gRPC requests for catalog service
+type Handler struct {
+	pbCatalog.UnimplementedCatalogServiceServer
+	catalogUseCase domain.CatalogUseCase
+}
+
+// NewHandler creates a new catalog handler
+func NewHandler(catalogUseCase domain.CatalogUseCase) *Handler {
+	return &Handler{
+		catalogUseCase: catalogUseCase,
+	}
+}
+
+// fromVariantInput converts the variant of a request into a domain variant
+func fromVariantInput(input *pbCatalog.VariantInput) *domain.ProductVariant {
+	variant := &domain.ProductVariant{
+		SKU:        input.Sku,
+		Barcode:    input.Barcode,
+		Name:       input.Name,
+		Attributes: domain.VariantAttributes(input.Attributes),
+	}
+	if input.Price != nil {
+		variant.Price = sql.NullInt64{Int64: *input.Price, Valid: true}
+	}
+	return variant
+}
+
+// toCategoryData converts a category into its protobuf representation
+func toCategoryData(category *domain.Category) *pbCatalog.CategoryData {
+	data := &pbCatalog.CategoryData{
+		Id:        category.ID.String(),
+		Name:      category.Name,
+		CreatedAt: timestamppb.New(category.CreatedAt),
+	}
+	if category.ParentID.Valid {
+		data.ParentId = category.ParentID.UUID.String()
+	}
+	return data
+}
+
+// toProductData converts a product into its protobuf representation, priced
+// at the outlet selected for the request
+func toProductData(product *domain.Product, outletID uuid.UUID) *pbCatalog.ProductData {
+	data := &pbCatalog.ProductData{
+		Id:          product.ID.String(),
+		Name:        product.Name,
+		Description: product.Description,
+		Unit:        product.Unit,
+		BasePrice:   product.BasePrice,
+		Active:      product.IsActive,
+		TaxExempt:   product.TaxExempt,
+		Variants:    make([]*pbCatalog.VariantData, 0, len(product.Variants)),
+		CreatedAt:   timestamppb.New(product.CreatedAt),
+	}
+	if product.CategoryID.Valid {
+		data.CategoryId = product.CategoryID.UUID.String()
+	}
+	if product.UpdatedAt.Valid {
+		data.UpdatedAt = timestamppb.New(product.UpdatedAt.Time)
+	}
+	for _, variant := range product.Variants {
+		data.Variants = append(data.Variants, toVariantData(variant, product, outletID))
+	}
+	return data
+}
+
+// toVariantData converts a variant of the product into its protobuf representation
+func toVariantData(variant *domain.ProductVariant, product *domain.Product, outletID uuid.UUID) *pbCatalog.VariantData {
+	data := &pbCatalog.VariantData{
+		Id:           variant.ID.String(),
+		ProductId:    variant.ProductID.String(),
+		Sku:          variant.SKU,
+		Barcode:      variant.Barcode,
+		Name:         variant.Name,
+		Attributes:   variant.Attributes,
+		Active:       variant.IsActive,
+		OutletPrices: make([]*pbCatalog.OutletPriceData, 0, len(variant.OutletPrices)),
+	}
+	if variant.Price.Valid {
+		price := variant.Price.Int64
+		data.Price = &price
+	}
+	for _, outletPrice := range variant.OutletPrices {
+		data.OutletPrices = append(data.OutletPrices, &pbCatalog.OutletPriceData{
+			OutletId: outletPrice.OutletID.String(),
+			Price:    outletPrice.Price,
+		})
+	}
+	data.EffectivePrice = variant.PriceAt(product, outletID)
+	return data
+}
+
+// selectedOutlet returns the outlet selected with the x-outlet-id header, or uuid.Nil
+func selectedOutlet(ctx context.Context) uuid.UUID {
+	tenant, ok := domain.TenantFromContext(ctx)
+	if !ok {
+		return uuid.Nil
+	}
+	return tenant.OutletID
+}
@@ -0,0 +1,30 @@
+package catalog
+
+import (
+	"context"
+	pbCatalog "github/kijunpos/gen/proto/catalog"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// ListCategories handles listing the categories of the selected merchant
+func (h *Handler) ListCategories(ctx context.Context, req *pbCatalog.ListCategoriesRequest) (*pbCatalog.ListCategoriesResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.catalog.ListCategories")
+	defer span.End()
+
+	// Call use case
+	categories, err := h.catalogUseCase.ListCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*pbCatalog.CategoryData, 0, len(categories))
+	for _, category := range categories {
+		data = append(data, toCategoryData(category))
+	}
+
+	return &pbCatalog.ListCategoriesResponse{
+		Success:    true,
+		Message:    "Categories retrieved successfully",
+		Categories: data,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package catalog
+
+import (
+	"context"
+	pbCatalog "github/kijunpos/gen/proto/catalog"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// SearchProducts handles searching the products of the selected merchant
+func (h *Handler) SearchProducts(ctx context.Context, req *pbCatalog.SearchProductsRequest) (*pbCatalog.SearchProductsResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.catalog.SearchProducts")
+	defer span.End()
+
+	categoryID, err := errors.ParseOptionalID("category_id", req.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := domain.ProductFilter{
+		Query:           req.Query,
+		CategoryID:      categoryID.UUID,
+		IncludeInactive: req.IncludeInactive,
+	}
+
+	// Call use case
+	page, err := h.catalogUseCase.SearchProducts(ctx, filter, int(req.PageSize), req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	outletID := selectedOutlet(ctx)
+	products := make([]*pbCatalog.ProductData, 0, len(page.Products))
+	for _, product := range page.Products {
+		products = append(products, toProductData(product, outletID))
+	}
+
+	return &pbCatalog.SearchProductsResponse{
+		Success:    true,
+		Message:    "Products retrieved successfully",
+		Products:   products,
+		NextCursor: page.NextCursor,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package catalog
+
+import (
+	"context"
+	pbCatalog "github/kijunpos/gen/proto/catalog"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// SetOutletPrice handles overriding the price of a variant at an outlet
+func (h *Handler) SetOutletPrice(ctx context.Context, req *pbCatalog.SetOutletPriceRequest) (*pbCatalog.CatalogResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.catalog.SetOutletPrice")
+	defer span.End()
+
+	variantID, err := errors.ParseID("variant_id", req.VariantId)
+	if err != nil {
+		return nil, err
+	}
+	outletID, err := errors.ParseID("outlet_id", req.OutletId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	if err := h.catalogUseCase.SetOutletPrice(ctx, variantID, outletID, req.Price); err != nil {
+		return nil, err
+	}
+
+	message := "Outlet price set successfully"
+	if req.Price == nil {
+		message = "Outlet price removed successfully"
+	}
+
+	return &pbCatalog.CatalogResponse{
+		Success: true,
+		Message: message,
+	}, nil
+}
@@ -0,0 +1,40 @@
+package catalog
+
+import (
+	"context"
+	pbCatalog "github/kijunpos/gen/proto/catalog"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// UpdateCategory handles renaming or moving a category
+func (h *Handler) UpdateCategory(ctx context.Context, req *pbCatalog.UpdateCategoryRequest) (*pbCatalog.CategoryResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.catalog.UpdateCategory")
+	defer span.End()
+
+	id, err := errors.ParseID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	parentID, err := errors.ParseOptionalID("parent_id", req.ParentId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	category, err := h.catalogUseCase.UpdateCategory(ctx, &domain.Category{
+		ID:       id,
+		ParentID: parentID,
+		Name:     req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbCatalog.CategoryResponse{
+		Success:  true,
+		Message:  "Category updated successfully",
+		Category: toCategoryData(category),
+	}, nil
+}
@@ -0,0 +1,45 @@
+package catalog
+
+import (
+	"context"
+	pbCatalog "github/kijunpos/gen/proto/catalog"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// UpdateProduct handles updating the details of a product
+func (h *Handler) UpdateProduct(ctx context.Context, req *pbCatalog.UpdateProductRequest) (*pbCatalog.ProductResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.catalog.UpdateProduct")
+	defer span.End()
+
+	id, err := errors.ParseID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	categoryID, err := errors.ParseOptionalID("category_id", req.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	product, err := h.catalogUseCase.UpdateProduct(ctx, &domain.Product{
+		ID:          id,
+		CategoryID:  categoryID,
+		Name:        req.Name,
+		Description: req.Description,
+		Unit:        req.Unit,
+		BasePrice:   req.BasePrice,
+		IsActive:    req.Active,
+		TaxExempt:   req.TaxExempt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbCatalog.ProductResponse{
+		Success: true,
+		Message: "Product updated successfully",
+		Product: toProductData(product, selectedOutlet(ctx)),
+	}, nil
+}
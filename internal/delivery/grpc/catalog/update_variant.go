@@ -0,0 +1,35 @@
+package catalog
+
+import (
+	"context"
+	pbCatalog "github/kijunpos/gen/proto/catalog"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// UpdateVariant handles updating a variant of a product
+func (h *Handler) UpdateVariant(ctx context.Context, req *pbCatalog.UpdateVariantRequest) (*pbCatalog.ProductResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.catalog.UpdateVariant")
+	defer span.End()
+
+	id, err := errors.ParseID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	variant := fromVariantInput(req.Variant)
+	variant.ID = id
+	variant.IsActive = req.Active
+
+	// Call use case
+	product, err := h.catalogUseCase.UpdateVariant(ctx, variant)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbCatalog.ProductResponse{
+		Success: true,
+		Message: "Variant updated successfully",
+		Product: toProductData(product, selectedOutlet(ctx)),
+	}, nil
+}
@@ -1,11 +1,50 @@
 package grpc
 
 import (
+	pbCatalog "github/kijunpos/gen/proto/catalog"
+	pbInventory "github/kijunpos/gen/proto/inventory"
+	pbLoyalty "github/kijunpos/gen/proto/loyalty"
+	pbMerchant "github/kijunpos/gen/proto/merchant"
+	pbOrder "github/kijunpos/gen/proto/order"
+	pbPayment "github/kijunpos/gen/proto/payment"
+	pbPromotion "github/kijunpos/gen/proto/promotion"
+	pbReceipt "github/kijunpos/gen/proto/receipt"
+	pbRefund "github/kijunpos/gen/proto/refund"
+	pbShift "github/kijunpos/gen/proto/shift"
+	pbTax "github/kijunpos/gen/proto/tax"
 	pbUser "github/kijunpos/gen/proto/user"
+	catalogHandler "github/kijunpos/internal/delivery/grpc/catalog"
+	inventoryHandler "github/kijunpos/internal/delivery/grpc/inventory"
+	loyaltyHandler "github/kijunpos/internal/delivery/grpc/loyalty"
+	merchantHandler "github/kijunpos/internal/delivery/grpc/merchant"
+	orderHandler "github/kijunpos/internal/delivery/grpc/order"
+	paymentHandler "github/kijunpos/internal/delivery/grpc/payment"
+	promotionHandler "github/kijunpos/internal/delivery/grpc/promotion"
+	receiptHandler "github/kijunpos/internal/delivery/grpc/receipt"
+	refundHandler "github/kijunpos/internal/delivery/grpc/refund"
+	shiftHandler "github/kijunpos/internal/delivery/grpc/shift"
+	taxHandler "github/kijunpos/internal/delivery/grpc/tax"
 	userHandler "github/kijunpos/internal/delivery/grpc/user"
 	"github/kijunpos/internal/domain"
 )
 
+// Handlers holds the handlers of all gRPC services
+type Handlers struct {
+	User      UserHandler
+	AdminUser AdminUserHandler
+	Merchant  MerchantHandler
+	Catalog   CatalogHandler
+	Inventory InventoryHandler
+	Order     OrderHandler
+	Payment   PaymentHandler
+	Refund    RefundHandler
+	Shift     ShiftHandler
+	Receipt   ReceiptHandler
+	Tax       TaxHandler
+	Promotion PromotionHandler
+	Loyalty   LoyaltyHandler
+}
+
 // UserHandler interface for gRPC user handler
 type UserHandler interface {
 	pbUser.UserServiceServer
@@ -15,3 +54,123 @@ type UserHandler interface {
 func NewUserHandler(userUseCase domain.UserUseCase) UserHandler {
 	return userHandler.NewHandler(userUseCase)
 }
+
+// AdminUserHandler interface for gRPC back office user handler
+type AdminUserHandler interface {
+	pbUser.AdminUserServiceServer
+}
+
+// NewAdminUserHandler creates a new back office user handler
+func NewAdminUserHandler(adminUserUseCase domain.AdminUserUseCase) AdminUserHandler {
+	return userHandler.NewAdminHandler(adminUserUseCase)
+}
+
+// MerchantHandler interface for gRPC merchant handler
+type MerchantHandler interface {
+	pbMerchant.MerchantServiceServer
+}
+
+// NewMerchantHandler creates a new merchant handler
+func NewMerchantHandler(merchantUseCase domain.MerchantUseCase) MerchantHandler {
+	return merchantHandler.NewHandler(merchantUseCase)
+}
+
+// CatalogHandler interface for gRPC catalog handler
+type CatalogHandler interface {
+	pbCatalog.CatalogServiceServer
+}
+
+// NewCatalogHandler creates a new catalog handler
+func NewCatalogHandler(catalogUseCase domain.CatalogUseCase) CatalogHandler {
+	return catalogHandler.NewHandler(catalogUseCase)
+}
+
+// InventoryHandler interface for gRPC inventory handler
+type InventoryHandler interface {
+	pbInventory.InventoryServiceServer
+}
+
+// NewInventoryHandler creates a new inventory handler
+func NewInventoryHandler(inventoryUseCase domain.InventoryUseCase) InventoryHandler {
+	return inventoryHandler.NewHandler(inventoryUseCase)
+}
+
+// OrderHandler interface for gRPC order handler
+type OrderHandler interface {
+	pbOrder.OrderServiceServer
+}
+
+// NewOrderHandler creates a new order handler
+func NewOrderHandler(orderUseCase domain.OrderUseCase) OrderHandler {
+	return orderHandler.NewHandler(orderUseCase)
+}
+
+// PaymentHandler interface for gRPC payment handler
+type PaymentHandler interface {
+	pbPayment.PaymentServiceServer
+}
+
+// NewPaymentHandler creates a new payment handler
+func NewPaymentHandler(paymentUseCase domain.PaymentUseCase) PaymentHandler {
+	return paymentHandler.NewHandler(paymentUseCase)
+}
+
+// RefundHandler interface for gRPC refund handler
+type RefundHandler interface {
+	pbRefund.RefundServiceServer
+}
+
+// NewRefundHandler creates a new refund handler
+func NewRefundHandler(refundUseCase domain.RefundUseCase) RefundHandler {
+	return refundHandler.NewHandler(refundUseCase)
+}
+
+// ShiftHandler interface for gRPC shift handler
+type ShiftHandler interface {
+	pbShift.ShiftServiceServer
+}
+
+// NewShiftHandler creates a new shift handler
+func NewShiftHandler(shiftUseCase domain.ShiftUseCase) ShiftHandler {
+	return shiftHandler.NewHandler(shiftUseCase)
+}
+
+// ReceiptHandler interface for gRPC receipt handler
+type ReceiptHandler interface {
+	pbReceipt.ReceiptServiceServer
+}
+
+// NewReceiptHandler creates a new receipt handler
+func NewReceiptHandler(receiptUseCase domain.ReceiptUseCase) ReceiptHandler {
+	return receiptHandler.NewHandler(receiptUseCase)
+}
+
+// TaxHandler interface for gRPC tax handler
+type TaxHandler interface {
+	pbTax.TaxServiceServer
+}
+
+// NewTaxHandler creates a new tax handler
+func NewTaxHandler(taxUseCase domain.TaxUseCase) TaxHandler {
+	return taxHandler.NewHandler(taxUseCase)
+}
+
+// PromotionHandler interface for gRPC promotion handler
+type PromotionHandler interface {
+	pbPromotion.PromotionServiceServer
+}
+
+// NewPromotionHandler creates a new promotion handler
+func NewPromotionHandler(promotionUseCase domain.PromotionUseCase) PromotionHandler {
+	return promotionHandler.NewHandler(promotionUseCase)
+}
+
+// LoyaltyHandler interface for gRPC loyalty handler
+type LoyaltyHandler interface {
+	pbLoyalty.LoyaltyServiceServer
+}
+
+// NewLoyaltyHandler creates a new loyalty handler
+func NewLoyaltyHandler(loyaltyUseCase domain.LoyaltyUseCase) LoyaltyHandler {
+	return loyaltyHandler.NewHandler(loyaltyUseCase)
+}
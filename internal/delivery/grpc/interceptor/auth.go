@@ -0,0 +1,120 @@
+package interceptor
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "bearer "
+)
+
+// AuthInterceptor authenticates incoming calls with a bearer access token
+// and places the authenticated principal into the request context
+type AuthInterceptor struct {
+	tokenService  domain.TokenService
+	publicMethods map[string]bool
+}
+
+// NewAuthInterceptor creates a new authentication interceptor.
+// Calls to publicMethods (full gRPC method names) are allowed without a token.
+func NewAuthInterceptor(tokenService domain.TokenService, publicMethods ...string) *AuthInterceptor {
+	allowed := make(map[string]bool, len(publicMethods))
+	for _, method := range publicMethods {
+		allowed[method] = true
+	}
+
+	return &AuthInterceptor{
+		tokenService:  tokenService,
+		publicMethods: allowed,
+	}
+}
+
+// Unary returns the unary server interceptor
+func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := i.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Stream returns the stream server interceptor
+func (i *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := i.authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticate validates the bearer token of the call, public methods are
+// passed through but still get a principal when a valid token is sent
+func (i *AuthInterceptor) authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
+	spanCtx, span := apm.GetTracer().Start(ctx, "delivery.grpc.interceptor.authenticate")
+	defer span.End()
+
+	isPublic := i.publicMethods[fullMethod]
+
+	accessToken, ok := bearerToken(ctx)
+	if !ok {
+		if isPublic {
+			return ctx, nil
+		}
+		return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+
+	claims, err := i.tokenService.ParseAccessToken(spanCtx, accessToken)
+	if err != nil {
+		if isPublic {
+			return ctx, nil
+		}
+		span.RecordError(err)
+		return nil, status.Error(codes.Unauthenticated, "invalid or expired access token")
+	}
+
+	return domain.ContextWithPrincipal(ctx, &domain.Principal{
+		UserID:   claims.UserID,
+		UserName: claims.UserName,
+		AuthType: claims.AuthType,
+		Roles:    claims.Roles,
+	}), nil
+}
+
+// bearerToken extracts the token from the "authorization: Bearer <token>" metadata
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	for _, value := range md.Get(authorizationHeader) {
+		if len(value) > len(bearerPrefix) && strings.EqualFold(value[:len(bearerPrefix)], bearerPrefix) {
+			return strings.TrimSpace(value[len(bearerPrefix):]), true
+		}
+	}
+	return "", false
+}
+
+// wrappedStream overrides the context of a server stream
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context set by the interceptor
+func (w *wrappedStream) Context() context.Context {
+	return w.ctx
+}
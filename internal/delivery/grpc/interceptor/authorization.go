@@ -0,0 +1,95 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	pbAuthz "github/kijunpos/gen/proto/authz"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// AuthorizationInterceptor rejects calls when the caller lacks a permission
+// required by the (authz.permissions) option of the called RPC
+type AuthorizationInterceptor struct {
+	authorizationService domain.AuthorizationService
+	// permissions caches the required permissions per full method name
+	permissions sync.Map
+}
+
+// NewAuthorizationInterceptor creates a new authorization interceptor
+func NewAuthorizationInterceptor(authorizationService domain.AuthorizationService) *AuthorizationInterceptor {
+	return &AuthorizationInterceptor{
+		authorizationService: authorizationService,
+	}
+}
+
+// Unary returns the unary server interceptor
+func (i *AuthorizationInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := i.authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Stream returns the stream server interceptor
+func (i *AuthorizationInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := i.authorize(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// authorize checks the permissions required by the method, methods without
+// the option are left to the use cases
+func (i *AuthorizationInterceptor) authorize(ctx context.Context, fullMethod string) error {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.interceptor.authorize")
+	defer span.End()
+
+	permissions, err := i.requiredPermissions(fullMethod)
+	if err != nil {
+		return err
+	}
+	if len(permissions) == 0 {
+		return nil
+	}
+	return i.authorizationService.Authorize(ctx, permissions...)
+}
+
+// requiredPermissions returns the permissions declared on the method in the proto files
+func (i *AuthorizationInterceptor) requiredPermissions(fullMethod string) ([]domain.Permission, error) {
+	if cached, ok := i.permissions.Load(fullMethod); ok {
+		return cached.([]domain.Permission), nil
+	}
+
+	// "/package.Service/Method" is registered as "package.Service.Method"
+	name := protoreflect.FullName(strings.Replace(strings.TrimPrefix(fullMethod, "/"), "/", ".", 1))
+	descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		// Methods missing from the registry declare no permissions
+		i.permissions.Store(fullMethod, []domain.Permission(nil))
+		return nil, nil
+	}
+	method, ok := descriptor.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a method", name)
+	}
+
+	var permissions []domain.Permission
+	for _, permission := range proto.GetExtension(method.Options(), pbAuthz.E_Permissions).([]string) {
+		permissions = append(permissions, domain.Permission(permission))
+	}
+
+	i.permissions.Store(fullMethod, permissions)
+	return permissions, nil
+}
@@ -0,0 +1,82 @@
+package interceptor
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const forwardedForHeader = "x-forwarded-for"
+
+// ClientIPInterceptor places the IP address of the calling client into the request context
+type ClientIPInterceptor struct {
+	trustProxyHeaders bool
+}
+
+// NewClientIPInterceptor creates a new client IP interceptor.
+// With trustProxyHeaders the address appended to x-forwarded-for by the proxy in
+// front of the server is used, otherwise the address of the connection.
+func NewClientIPInterceptor(trustProxyHeaders bool) *ClientIPInterceptor {
+	return &ClientIPInterceptor{
+		trustProxyHeaders: trustProxyHeaders,
+	}
+}
+
+// Unary returns the unary server interceptor
+func (i *ClientIPInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(i.withClientIP(ctx), req)
+	}
+}
+
+// Stream returns the stream server interceptor
+func (i *ClientIPInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: i.withClientIP(ss.Context())})
+	}
+}
+
+// withClientIP returns ctx carrying the client IP, ctx is returned as is when the IP is unknown
+func (i *ClientIPInterceptor) withClientIP(ctx context.Context) context.Context {
+	if i.trustProxyHeaders {
+		if ip, ok := forwardedFor(ctx); ok {
+			return domain.ContextWithClientIP(ctx, ip)
+		}
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ctx
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	return domain.ContextWithClientIP(ctx, host)
+}
+
+// forwardedFor returns the last address of the x-forwarded-for metadata
+func forwardedFor(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	values := md.Get(forwardedForHeader)
+	if len(values) == 0 {
+		return "", false
+	}
+
+	addresses := strings.Split(values[len(values)-1], ",")
+	ip := strings.TrimSpace(addresses[len(addresses)-1])
+	if net.ParseIP(ip) == nil {
+		return "", false
+	}
+	return ip, true
+}
@@ -0,0 +1,51 @@
+package interceptor
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+
+	"google.golang.org/grpc"
+)
+
+// ErrorInterceptor converts the errors returned by handlers into gRPC status
+// errors, mapping AppError categories to status codes with error details
+type ErrorInterceptor struct{}
+
+// NewErrorInterceptor creates a new error interceptor
+func NewErrorInterceptor() *ErrorInterceptor {
+	return &ErrorInterceptor{}
+}
+
+// Unary returns the unary server interceptor
+func (i *ErrorInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, i.toStatusError(ctx, err)
+		}
+		return resp, nil
+	}
+}
+
+// Stream returns the stream server interceptor
+func (i *ErrorInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return i.toStatusError(ss.Context(), err)
+		}
+		return nil
+	}
+}
+
+// toStatusError converts err into a status error, internal errors are traced
+// and logged since their details are not returned to the client
+func (i *ErrorInterceptor) toStatusError(ctx context.Context, err error) error {
+	if appErrors.IsInternal(err) {
+		apm.TraceError(ctx, err)
+	}
+
+	requestID, _ := domain.RequestIDFromContext(ctx)
+	return appErrors.ToStatus(err, requestID).Err()
+}
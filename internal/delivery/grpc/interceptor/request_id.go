@@ -0,0 +1,75 @@
+package interceptor
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	requestIDHeader    = "x-request-id"
+	maxRequestIDLength = 128
+)
+
+// RequestIDInterceptor assigns every call a request ID, taken from the
+// x-request-id metadata when the client sends one, and returns it in the
+// response header
+type RequestIDInterceptor struct{}
+
+// NewRequestIDInterceptor creates a new request ID interceptor
+func NewRequestIDInterceptor() *RequestIDInterceptor {
+	return &RequestIDInterceptor{}
+}
+
+// Unary returns the unary server interceptor
+func (i *RequestIDInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, requestID := i.withRequestID(ctx)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, requestID))
+		return handler(ctx, req)
+	}
+}
+
+// Stream returns the stream server interceptor
+func (i *RequestIDInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, requestID := i.withRequestID(ss.Context())
+		_ = ss.SetHeader(metadata.Pairs(requestIDHeader, requestID))
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// withRequestID returns ctx carrying the request ID of the call
+func (i *RequestIDInterceptor) withRequestID(ctx context.Context) (context.Context, string) {
+	requestID, ok := incomingRequestID(ctx)
+	if !ok {
+		requestID = uuid.NewString()
+	}
+
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("request_id", requestID))
+	return domain.ContextWithRequestID(ctx, requestID), requestID
+}
+
+// incomingRequestID returns the request ID sent by the client, if it is usable
+func incomingRequestID(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	values := md.Get(requestIDHeader)
+	if len(values) == 0 || values[0] == "" || len(values[0]) > maxRequestIDLength {
+		return "", false
+	}
+	for _, r := range values[0] {
+		if r < 0x21 || r > 0x7e {
+			return "", false
+		}
+	}
+	return values[0], true
+}
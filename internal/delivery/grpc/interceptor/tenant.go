@@ -0,0 +1,94 @@
+package interceptor
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	merchantIDHeader = "x-merchant-id"
+	outletIDHeader   = "x-outlet-id"
+)
+
+// TenantInterceptor places the merchant and outlet selected with the
+// x-merchant-id and x-outlet-id headers into the request context, after
+// checking that the caller belongs to them
+type TenantInterceptor struct {
+	tenantResolver domain.TenantResolver
+}
+
+// NewTenantInterceptor creates a new tenant interceptor
+func NewTenantInterceptor(tenantResolver domain.TenantResolver) *TenantInterceptor {
+	return &TenantInterceptor{
+		tenantResolver: tenantResolver,
+	}
+}
+
+// Unary returns the unary server interceptor
+func (i *TenantInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := i.withTenant(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Stream returns the stream server interceptor
+func (i *TenantInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := i.withTenant(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// withTenant returns ctx carrying the tenant of the request. Requests without
+// the headers or without an authenticated caller are passed through unchanged.
+func (i *TenantInterceptor) withTenant(ctx context.Context) (context.Context, error) {
+	spanCtx, span := apm.GetTracer().Start(ctx, "delivery.grpc.interceptor.withTenant")
+	defer span.End()
+
+	if _, ok := domain.PrincipalFromContext(ctx); !ok {
+		return ctx, nil
+	}
+
+	merchantID, ok, err := headerUUID(ctx, merchantIDHeader)
+	if err != nil || !ok {
+		return ctx, err
+	}
+	outletID, _, err := headerUUID(ctx, outletIDHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, err := i.tenantResolver.ResolveTenant(spanCtx, merchantID, outletID)
+	if err != nil {
+		return nil, err
+	}
+	return domain.ContextWithTenant(ctx, tenant), nil
+}
+
+// headerUUID parses the UUID sent in a metadata header, ok is false when the header is missing
+func headerUUID(ctx context.Context, header string) (id uuid.UUID, ok bool, err error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(header)
+	if len(values) == 0 || values[0] == "" {
+		return uuid.Nil, false, nil
+	}
+
+	id, err = uuid.Parse(values[0])
+	if err != nil {
+		return uuid.Nil, false, appErrors.NewFieldValidationError(header, "must be a valid UUID")
+	}
+	return id, true, nil
+}
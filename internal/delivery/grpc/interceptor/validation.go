@@ -0,0 +1,95 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	appErrors "github/kijunpos/internal/pkg/errors"
+
+	"google.golang.org/grpc"
+)
+
+// validator is implemented by request messages with protoc-gen-validate rules
+type validator interface {
+	ValidateAll() error
+}
+
+// fieldError is implemented by the single field errors generated by protoc-gen-validate
+type fieldError interface {
+	Field() string
+	Reason() string
+}
+
+// multiError is implemented by the aggregated errors generated by protoc-gen-validate
+type multiError interface {
+	AllErrors() []error
+}
+
+// ValidationInterceptor validates incoming requests against the rules declared
+// in the proto files and rejects invalid requests with their field violations
+type ValidationInterceptor struct{}
+
+// NewValidationInterceptor creates a new validation interceptor
+func NewValidationInterceptor() *ValidationInterceptor {
+	return &ValidationInterceptor{}
+}
+
+// Unary returns the unary server interceptor
+func (i *ValidationInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := validate(req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Stream returns the stream server interceptor, every received message is validated
+func (i *ValidationInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingStream{ServerStream: ss})
+	}
+}
+
+// validatingStream validates the messages received on a server stream
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+// RecvMsg receives the next message and validates it
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return validate(m)
+}
+
+// validate runs the generated validation of req and converts the violations into a validation error
+func validate(req interface{}) error {
+	v, ok := req.(validator)
+	if !ok {
+		return nil
+	}
+
+	err := v.ValidateAll()
+	if err == nil {
+		return nil
+	}
+
+	errs := []error{err}
+	var multi multiError
+	if errors.As(err, &multi) {
+		errs = multi.AllErrors()
+	}
+
+	violations := make([]appErrors.FieldViolation, 0, len(errs))
+	for _, e := range errs {
+		var fe fieldError
+		if errors.As(e, &fe) {
+			violations = append(violations, appErrors.FieldViolation{Field: fe.Field(), Description: fe.Reason()})
+			continue
+		}
+		violations = append(violations, appErrors.FieldViolation{Description: e.Error()})
+	}
+
+	return appErrors.NewFieldViolationsError(violations)
+}
@@ -0,0 +1,28 @@
+package inventory
+
+import (
+	"context"
+	pbInventory "github/kijunpos/gen/proto/inventory"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// AdjustStock handles correcting the stock of a variant
+func (h *Handler) AdjustStock(ctx context.Context, req *pbInventory.AdjustStockRequest) (*pbInventory.StockMovementsResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.inventory.AdjustStock")
+	defer span.End()
+
+	variantID, err := errors.ParseID("variant_id", req.VariantId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	movement, err := h.inventoryUseCase.AdjustStock(ctx, variantID, req.Quantity, req.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	return toStockMovementsResponse("Stock adjusted successfully", []*domain.StockMovement{movement}), nil
+}
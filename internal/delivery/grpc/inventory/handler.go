@@ -0,0 +1,89 @@
+package inventory
+
+import (
+	pbInventory "github/kijunpos/gen/proto/inventory"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/errors"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Handler handles gRPC requests for inventory service
+type Handler struct {
+	pbInventory.UnimplementedInventoryServiceServer
+	inventoryUseCase domain.InventoryUseCase
+}
+
+// NewHandler creates a new inventory handler
+func NewHandler(inventoryUseCase domain.InventoryUseCase) *Handler {
+	return &Handler{
+		inventoryUseCase: inventoryUseCase,
+	}
+}
+
+// fromStockItems converts the items of a request into domain items
+func fromStockItems(items []*pbInventory.StockItem) ([]*domain.StockItem, error) {
+	result := make([]*domain.StockItem, 0, len(items))
+	for _, item := range items {
+		variantID, err := errors.ParseID("variant_id", item.VariantId)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &domain.StockItem{VariantID: variantID, Quantity: item.Quantity})
+	}
+	return result, nil
+}
+
+// toStockLevelData converts a stock level into its protobuf representation
+func toStockLevelData(level *domain.StockLevel) *pbInventory.StockLevelData {
+	data := &pbInventory.StockLevelData{
+		VariantId:   level.VariantID.String(),
+		Sku:         level.SKU,
+		VariantName: level.VariantName,
+		ProductName: level.ProductName,
+		OnHand:      level.OnHand,
+		LowStock:    level.IsLow(),
+	}
+	if level.LowStockThreshold.Valid {
+		threshold := level.LowStockThreshold.Int64
+		data.LowStockThreshold = &threshold
+	}
+	if level.UpdatedAt.Valid {
+		data.UpdatedAt = timestamppb.New(level.UpdatedAt.Time)
+	}
+	return data
+}
+
+// toStockMovementData converts a stock movement into its protobuf representation
+func toStockMovementData(movement *domain.StockMovement) *pbInventory.StockMovementData {
+	data := &pbInventory.StockMovementData{
+		Id:           movement.ID.String(),
+		OutletId:     movement.OutletID.String(),
+		VariantId:    movement.VariantID.String(),
+		Type:         string(movement.Type),
+		Quantity:     movement.Quantity,
+		BalanceAfter: movement.BalanceAfter,
+		Note:         movement.Note,
+		CreatedAt:    timestamppb.New(movement.CreatedAt),
+	}
+	if movement.ReferenceID.Valid {
+		data.ReferenceId = movement.ReferenceID.UUID.String()
+	}
+	if movement.CreatedBy.Valid {
+		data.CreatedBy = movement.CreatedBy.UUID.String()
+	}
+	return data
+}
+
+// toStockMovementsResponse converts recorded movements into a response
+func toStockMovementsResponse(message string, movements []*domain.StockMovement) *pbInventory.StockMovementsResponse {
+	data := make([]*pbInventory.StockMovementData, 0, len(movements))
+	for _, movement := range movements {
+		data = append(data, toStockMovementData(movement))
+	}
+	return &pbInventory.StockMovementsResponse{
+		Success:   true,
+		Message:   message,
+		Movements: data,
+	}
+}
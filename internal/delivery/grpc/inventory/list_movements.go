@@ -0,0 +1,42 @@
+package inventory
+
+import (
+	"context"
+	pbInventory "github/kijunpos/gen/proto/inventory"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// ListMovements handles listing the stock ledger of the selected outlet
+func (h *Handler) ListMovements(ctx context.Context, req *pbInventory.ListMovementsRequest) (*pbInventory.ListMovementsResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.inventory.ListMovements")
+	defer span.End()
+
+	variantID := uuid.Nil
+	if req.VariantId != "" {
+		var err error
+		if variantID, err = errors.ParseID("variant_id", req.VariantId); err != nil {
+			return nil, err
+		}
+	}
+
+	// Call use case
+	page, err := h.inventoryUseCase.ListMovements(ctx, variantID, int(req.PageSize), req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	movements := make([]*pbInventory.StockMovementData, 0, len(page.Movements))
+	for _, movement := range page.Movements {
+		movements = append(movements, toStockMovementData(movement))
+	}
+
+	return &pbInventory.ListMovementsResponse{
+		Success:    true,
+		Message:    "Stock movements retrieved successfully",
+		Movements:  movements,
+		NextCursor: page.NextCursor,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package inventory
+
+import (
+	"context"
+	pbInventory "github/kijunpos/gen/proto/inventory"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// ListStock handles listing the stock of the selected outlet
+func (h *Handler) ListStock(ctx context.Context, req *pbInventory.ListStockRequest) (*pbInventory.ListStockResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.inventory.ListStock")
+	defer span.End()
+
+	filter := domain.StockLevelFilter{
+		VariantIDs:   make([]uuid.UUID, 0, len(req.VariantIds)),
+		LowStockOnly: req.LowStockOnly,
+	}
+	for _, value := range req.VariantIds {
+		variantID, err := errors.ParseID("variant_ids", value)
+		if err != nil {
+			return nil, err
+		}
+		filter.VariantIDs = append(filter.VariantIDs, variantID)
+	}
+
+	// Call use case
+	levels, err := h.inventoryUseCase.ListStock(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	stock := make([]*pbInventory.StockLevelData, 0, len(levels))
+	for _, level := range levels {
+		stock = append(stock, toStockLevelData(level))
+	}
+
+	return &pbInventory.ListStockResponse{
+		Success: true,
+		Message: "Stock retrieved successfully",
+		Stock:   stock,
+	}, nil
+}
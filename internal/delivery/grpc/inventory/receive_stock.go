@@ -0,0 +1,26 @@
+package inventory
+
+import (
+	"context"
+	pbInventory "github/kijunpos/gen/proto/inventory"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// ReceiveStock handles receiving stock from a supplier
+func (h *Handler) ReceiveStock(ctx context.Context, req *pbInventory.ReceiveStockRequest) (*pbInventory.StockMovementsResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.inventory.ReceiveStock")
+	defer span.End()
+
+	items, err := fromStockItems(req.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	movements, err := h.inventoryUseCase.ReceiveStock(ctx, items, req.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	return toStockMovementsResponse("Stock received successfully", movements), nil
+}
@@ -0,0 +1,34 @@
+package inventory
+
+import (
+	"context"
+	pbInventory "github/kijunpos/gen/proto/inventory"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// SetLowStockThreshold handles setting the low stock threshold of a variant
+func (h *Handler) SetLowStockThreshold(ctx context.Context, req *pbInventory.SetLowStockThresholdRequest) (*pbInventory.InventoryResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.inventory.SetLowStockThreshold")
+	defer span.End()
+
+	variantID, err := errors.ParseID("variant_id", req.VariantId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	if err := h.inventoryUseCase.SetLowStockThreshold(ctx, variantID, req.Threshold); err != nil {
+		return nil, err
+	}
+
+	message := "Low stock threshold set successfully"
+	if req.Threshold == nil {
+		message = "Low stock threshold removed successfully"
+	}
+
+	return &pbInventory.InventoryResponse{
+		Success: true,
+		Message: message,
+	}, nil
+}
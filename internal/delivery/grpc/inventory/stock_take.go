@@ -0,0 +1,26 @@
+package inventory
+
+import (
+	"context"
+	pbInventory "github/kijunpos/gen/proto/inventory"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// StockTake handles recording the counted stock
+func (h *Handler) StockTake(ctx context.Context, req *pbInventory.StockTakeRequest) (*pbInventory.StockMovementsResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.inventory.StockTake")
+	defer span.End()
+
+	items, err := fromStockItems(req.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	movements, err := h.inventoryUseCase.StockTake(ctx, items, req.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	return toStockMovementsResponse("Stock take recorded successfully", movements), nil
+}
@@ -0,0 +1,40 @@
+package inventory
+
+import (
+	"context"
+	pbInventory "github/kijunpos/gen/proto/inventory"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TransferStock handles moving stock to another outlet
+func (h *Handler) TransferStock(ctx context.Context, req *pbInventory.TransferStockRequest) (*pbInventory.TransferStockResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.inventory.TransferStock")
+	defer span.End()
+
+	toOutletID, err := errors.ParseID("to_outlet_id", req.ToOutletId)
+	if err != nil {
+		return nil, err
+	}
+	items, err := fromStockItems(req.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	transfer, err := h.inventoryUseCase.TransferStock(ctx, toOutletID, items, req.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbInventory.TransferStockResponse{
+		Success:      true,
+		Message:      "Stock transferred successfully",
+		TransferId:   transfer.ID.String(),
+		FromOutletId: transfer.FromOutletID.String(),
+		ToOutletId:   transfer.ToOutletID.String(),
+		CreatedAt:    timestamppb.New(transfer.CreatedAt),
+	}, nil
+}
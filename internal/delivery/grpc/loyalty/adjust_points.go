@@ -0,0 +1,31 @@
+package loyalty
+
+import (
+	"context"
+	pbLoyalty "github/kijunpos/gen/proto/loyalty"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// AdjustPoints handles correcting the points of a customer by hand
+func (h *Handler) AdjustPoints(ctx context.Context, req *pbLoyalty.AdjustPointsRequest) (*pbLoyalty.CustomerResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.loyalty.AdjustPoints")
+	defer span.End()
+
+	customerID, err := errors.ParseID("customer_id", req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	customer, err := h.loyaltyUseCase.AdjustPoints(ctx, customerID, req.Points, req.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbLoyalty.CustomerResponse{
+		Success:  true,
+		Message:  "Points adjusted successfully",
+		Customer: toCustomerData(customer),
+	}, nil
+}
@@ -0,0 +1,31 @@
+package loyalty
+
+import (
+	"context"
+	pbLoyalty "github/kijunpos/gen/proto/loyalty"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// AttachCustomer handles setting the customer of a cart
+func (h *Handler) AttachCustomer(ctx context.Context, req *pbLoyalty.AttachCustomerRequest) (*pbLoyalty.OrderResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.loyalty.AttachCustomer")
+	defer span.End()
+
+	orderID, err := errors.ParseID("order_id", req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	order, err := h.loyaltyUseCase.AttachCustomer(ctx, orderID, req.WhatsappNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbLoyalty.OrderResponse{
+		Success: true,
+		Message: "Customer attached successfully",
+		Order:   toOrderSummaryData(order),
+	}, nil
+}
@@ -0,0 +1,30 @@
+package loyalty
+
+import (
+	"context"
+	pbLoyalty "github/kijunpos/gen/proto/loyalty"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// CreateCustomer handles registering a customer
+func (h *Handler) CreateCustomer(ctx context.Context, req *pbLoyalty.CreateCustomerRequest) (*pbLoyalty.CustomerResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.loyalty.CreateCustomer")
+	defer span.End()
+
+	// Call use case
+	customer, err := h.loyaltyUseCase.CreateCustomer(ctx, &domain.Customer{
+		Name:           req.Name,
+		WhatsAppNumber: req.WhatsappNumber,
+		Email:          req.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbLoyalty.CustomerResponse{
+		Success:  true,
+		Message:  "Customer created successfully",
+		Customer: toCustomerData(customer),
+	}, nil
+}
@@ -0,0 +1,25 @@
+package loyalty
+
+import (
+	"context"
+	pbLoyalty "github/kijunpos/gen/proto/loyalty"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// FindCustomer handles looking a customer up by WhatsApp number
+func (h *Handler) FindCustomer(ctx context.Context, req *pbLoyalty.FindCustomerRequest) (*pbLoyalty.CustomerResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.loyalty.FindCustomer")
+	defer span.End()
+
+	// Call use case
+	customer, err := h.loyaltyUseCase.FindCustomer(ctx, req.WhatsappNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbLoyalty.CustomerResponse{
+		Success:  true,
+		Message:  "Customer retrieved successfully",
+		Customer: toCustomerData(customer),
+	}, nil
+}
@@ -0,0 +1,31 @@
+package loyalty
+
+import (
+	"context"
+	pbLoyalty "github/kijunpos/gen/proto/loyalty"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// GetCustomer handles retrieving a customer
+func (h *Handler) GetCustomer(ctx context.Context, req *pbLoyalty.GetCustomerRequest) (*pbLoyalty.CustomerResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.loyalty.GetCustomer")
+	defer span.End()
+
+	id, err := errors.ParseID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	customer, err := h.loyaltyUseCase.GetCustomer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbLoyalty.CustomerResponse{
+		Success:  true,
+		Message:  "Customer retrieved successfully",
+		Customer: toCustomerData(customer),
+	}, nil
+}
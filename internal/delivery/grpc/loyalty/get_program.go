@@ -0,0 +1,25 @@
+package loyalty
+
+import (
+	"context"
+	pbLoyalty "github/kijunpos/gen/proto/loyalty"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// GetProgram handles retrieving the loyalty program
+func (h *Handler) GetProgram(ctx context.Context, req *pbLoyalty.GetProgramRequest) (*pbLoyalty.ProgramResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.loyalty.GetProgram")
+	defer span.End()
+
+	// Call use case
+	program, err := h.loyaltyUseCase.GetProgram(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbLoyalty.ProgramResponse{
+		Success: true,
+		Message: "Loyalty program retrieved successfully",
+		Program: toProgramData(program),
+	}, nil
+}
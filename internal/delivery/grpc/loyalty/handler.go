@@ -0,0 +1,138 @@
+package loyalty
+
+import (
+	pbLoyalty "github/kijunpos/gen/proto/loyalty"
+	"github/kijunpos/internal/domain"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Handler handles gRPC requests for loyalty service
+type Handler struct {
+	pbLoyalty.UnimplementedLoyaltyServiceServer
+	loyaltyUseCase domain.LoyaltyUseCase
+}
+
+// NewHandler creates a new loyalty handler
+func NewHandler(loyaltyUseCase domain.LoyaltyUseCase) *Handler {
+	return &Handler{
+		loyaltyUseCase: loyaltyUseCase,
+	}
+}
+
+// toProgramData converts a loyalty program into its protobuf representation
+func toProgramData(program *domain.LoyaltyProgram) *pbLoyalty.ProgramData {
+	data := &pbLoyalty.ProgramData{
+		IsActive:         program.IsActive,
+		EarnSpend:        program.EarnSpend,
+		EarnPoints:       program.EarnPoints,
+		PointValue:       program.PointValue,
+		MinRedeemPoints:  program.MinRedeemPoints,
+		MaxRedeemPercent: program.MaxRedeemPercent.String(),
+		ExpiryDays:       int32(program.ExpiryDays),
+		Tiers:            make([]*pbLoyalty.TierData, 0, len(program.Tiers)),
+	}
+	for _, tier := range program.Tiers {
+		data.Tiers = append(data.Tiers, &pbLoyalty.TierData{
+			Name:       tier.Name,
+			MinSpend:   tier.MinSpend,
+			Multiplier: tier.Multiplier.String(),
+		})
+	}
+	if program.UpdatedAt.Valid {
+		data.UpdatedAt = timestamppb.New(program.UpdatedAt.Time)
+	}
+	return data
+}
+
+// toCustomerData converts a customer into its protobuf representation
+func toCustomerData(customer *domain.Customer) *pbLoyalty.CustomerData {
+	data := &pbLoyalty.CustomerData{
+		Id:             customer.ID.String(),
+		MerchantId:     customer.MerchantID.String(),
+		Name:           customer.Name,
+		WhatsappNumber: customer.WhatsAppNumber,
+		Email:          customer.Email,
+		PointsBalance:  customer.PointsBalance,
+		TotalSpend:     customer.TotalSpend,
+		VisitCount:     customer.VisitCount,
+		CreatedAt:      timestamppb.New(customer.CreatedAt),
+	}
+	if customer.UserID.Valid {
+		data.UserId = customer.UserID.UUID.String()
+	}
+	if customer.LastPurchaseAt.Valid {
+		data.LastPurchaseAt = timestamppb.New(customer.LastPurchaseAt.Time)
+	}
+	if customer.Tier != nil {
+		data.Tier = customer.Tier.Name
+	}
+	if customer.UpdatedAt.Valid {
+		data.UpdatedAt = timestamppb.New(customer.UpdatedAt.Time)
+	}
+	return data
+}
+
+// toCustomersData converts customers into their protobuf representation
+func toCustomersData(customers []*domain.Customer) []*pbLoyalty.CustomerData {
+	data := make([]*pbLoyalty.CustomerData, 0, len(customers))
+	for _, customer := range customers {
+		data = append(data, toCustomerData(customer))
+	}
+	return data
+}
+
+// toPointEntryData converts a points entry into its protobuf representation
+func toPointEntryData(entry *domain.PointEntry) *pbLoyalty.PointEntryData {
+	data := &pbLoyalty.PointEntryData{
+		Id:           entry.ID.String(),
+		Type:         string(entry.Type),
+		Points:       entry.Points,
+		BalanceAfter: entry.BalanceAfter,
+		Note:         entry.Note,
+		CreatedAt:    timestamppb.New(entry.CreatedAt),
+	}
+	if entry.OrderID.Valid {
+		data.OrderId = entry.OrderID.UUID.String()
+	}
+	if entry.ExpiresAt.Valid {
+		data.ExpiresAt = timestamppb.New(entry.ExpiresAt.Time)
+	}
+	return data
+}
+
+// toOrderSummaryData converts an order into its protobuf summary
+func toOrderSummaryData(order *domain.Order) *pbLoyalty.OrderSummaryData {
+	data := &pbLoyalty.OrderSummaryData{
+		Id:             order.ID.String(),
+		OutletId:       order.OutletID.String(),
+		Number:         order.Number.Int64,
+		Status:         string(order.Status),
+		Subtotal:       order.Subtotal,
+		DiscountTotal:  order.DiscountTotal,
+		Total:          order.Total,
+		RefundedTotal:  order.RefundedTotal,
+		PointsRedeemed: order.PointsRedeemed,
+		PointsDiscount: order.PointsDiscount,
+		PointsEarned:   order.PointsEarned,
+		Items:          make([]*pbLoyalty.OrderItemSummaryData, 0, len(order.Items)),
+		CreatedAt:      timestamppb.New(order.CreatedAt),
+	}
+	if order.CustomerID.Valid {
+		data.CustomerId = order.CustomerID.UUID.String()
+	}
+	for _, item := range order.Items {
+		data.Items = append(data.Items, &pbLoyalty.OrderItemSummaryData{
+			Name:     item.Name,
+			Quantity: item.Quantity,
+			Total:    item.Total,
+		})
+	}
+	if order.CompletedAt.Valid {
+		data.CompletedAt = timestamppb.New(order.CompletedAt.Time)
+	}
+	if order.VoidedAt.Valid {
+		data.VoidedAt = timestamppb.New(order.VoidedAt.Time)
+	}
+	return data
+}
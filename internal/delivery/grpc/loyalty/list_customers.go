@@ -0,0 +1,26 @@
+package loyalty
+
+import (
+	"context"
+	pbLoyalty "github/kijunpos/gen/proto/loyalty"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// ListCustomers handles listing the customers
+func (h *Handler) ListCustomers(ctx context.Context, req *pbLoyalty.ListCustomersRequest) (*pbLoyalty.ListCustomersResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.loyalty.ListCustomers")
+	defer span.End()
+
+	// Call use case
+	page, err := h.loyaltyUseCase.ListCustomers(ctx, req.Search, int(req.PageSize), req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbLoyalty.ListCustomersResponse{
+		Success:    true,
+		Message:    "Customers retrieved successfully",
+		Customers:  toCustomersData(page.Customers),
+		NextCursor: page.NextCursor,
+	}, nil
+}
@@ -0,0 +1,25 @@
+package loyalty
+
+import (
+	"context"
+	pbLoyalty "github/kijunpos/gen/proto/loyalty"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// ListMemberships handles listing the memberships of the caller
+func (h *Handler) ListMemberships(ctx context.Context, req *pbLoyalty.ListMembershipsRequest) (*pbLoyalty.ListMembershipsResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.loyalty.ListMemberships")
+	defer span.End()
+
+	// Call use case
+	customers, err := h.loyaltyUseCase.ListMemberships(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbLoyalty.ListMembershipsResponse{
+		Success:   true,
+		Message:   "Memberships retrieved successfully",
+		Customers: toCustomersData(customers),
+	}, nil
+}
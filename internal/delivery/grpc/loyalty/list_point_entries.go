@@ -0,0 +1,37 @@
+package loyalty
+
+import (
+	"context"
+	pbLoyalty "github/kijunpos/gen/proto/loyalty"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// ListPointEntries handles listing the points ledger of a customer
+func (h *Handler) ListPointEntries(ctx context.Context, req *pbLoyalty.ListPointEntriesRequest) (*pbLoyalty.ListPointEntriesResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.loyalty.ListPointEntries")
+	defer span.End()
+
+	customerID, err := errors.ParseID("customer_id", req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	page, err := h.loyaltyUseCase.ListPointEntries(ctx, customerID, int(req.PageSize), req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*pbLoyalty.PointEntryData, 0, len(page.Entries))
+	for _, entry := range page.Entries {
+		data = append(data, toPointEntryData(entry))
+	}
+
+	return &pbLoyalty.ListPointEntriesResponse{
+		Success:    true,
+		Message:    "Point entries retrieved successfully",
+		Entries:    data,
+		NextCursor: page.NextCursor,
+	}, nil
+}
@@ -0,0 +1,37 @@
+package loyalty
+
+import (
+	"context"
+	pbLoyalty "github/kijunpos/gen/proto/loyalty"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// ListPurchases handles listing the sales of a customer
+func (h *Handler) ListPurchases(ctx context.Context, req *pbLoyalty.ListPurchasesRequest) (*pbLoyalty.ListPurchasesResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.loyalty.ListPurchases")
+	defer span.End()
+
+	customerID, err := errors.ParseID("customer_id", req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	page, err := h.loyaltyUseCase.ListPurchases(ctx, customerID, int(req.PageSize), req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*pbLoyalty.OrderSummaryData, 0, len(page.Orders))
+	for _, order := range page.Orders {
+		data = append(data, toOrderSummaryData(order))
+	}
+
+	return &pbLoyalty.ListPurchasesResponse{
+		Success:    true,
+		Message:    "Purchases retrieved successfully",
+		Orders:     data,
+		NextCursor: page.NextCursor,
+	}, nil
+}
@@ -0,0 +1,31 @@
+package loyalty
+
+import (
+	"context"
+	pbLoyalty "github/kijunpos/gen/proto/loyalty"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// RedeemPoints handles paying part of a cart with points
+func (h *Handler) RedeemPoints(ctx context.Context, req *pbLoyalty.RedeemPointsRequest) (*pbLoyalty.OrderResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.loyalty.RedeemPoints")
+	defer span.End()
+
+	orderID, err := errors.ParseID("order_id", req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	order, err := h.loyaltyUseCase.RedeemPoints(ctx, orderID, req.Points)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbLoyalty.OrderResponse{
+		Success: true,
+		Message: "Points redeemed successfully",
+		Order:   toOrderSummaryData(order),
+	}, nil
+}
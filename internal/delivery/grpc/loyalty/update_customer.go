@@ -0,0 +1,37 @@
+package loyalty
+
+import (
+	"context"
+	pbLoyalty "github/kijunpos/gen/proto/loyalty"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// UpdateCustomer handles changing a customer
+func (h *Handler) UpdateCustomer(ctx context.Context, req *pbLoyalty.UpdateCustomerRequest) (*pbLoyalty.CustomerResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.loyalty.UpdateCustomer")
+	defer span.End()
+
+	id, err := errors.ParseID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	customer, err := h.loyaltyUseCase.UpdateCustomer(ctx, &domain.Customer{
+		ID:             id,
+		Name:           req.Name,
+		WhatsAppNumber: req.WhatsappNumber,
+		Email:          req.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbLoyalty.CustomerResponse{
+		Success:  true,
+		Message:  "Customer updated successfully",
+		Customer: toCustomerData(customer),
+	}, nil
+}
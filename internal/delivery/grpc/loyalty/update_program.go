@@ -0,0 +1,56 @@
+package loyalty
+
+import (
+	"context"
+	"fmt"
+	pbLoyalty "github/kijunpos/gen/proto/loyalty"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// UpdateProgram handles replacing the rules and the tiers of the loyalty program
+func (h *Handler) UpdateProgram(ctx context.Context, req *pbLoyalty.UpdateProgramRequest) (*pbLoyalty.ProgramResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.loyalty.UpdateProgram")
+	defer span.End()
+
+	maxRedeemPercent, err := decimal.NewFromString(req.MaxRedeemPercent)
+	if err != nil {
+		return nil, errors.NewFieldValidationError("max_redeem_percent", "invalid max_redeem_percent")
+	}
+	program := &domain.LoyaltyProgram{
+		IsActive:         req.IsActive,
+		EarnSpend:        req.EarnSpend,
+		EarnPoints:       req.EarnPoints,
+		PointValue:       req.PointValue,
+		MinRedeemPoints:  req.MinRedeemPoints,
+		MaxRedeemPercent: maxRedeemPercent,
+		ExpiryDays:       int(req.ExpiryDays),
+		Tiers:            make([]*domain.LoyaltyTier, 0, len(req.Tiers)),
+	}
+	for i, tier := range req.Tiers {
+		multiplier, err := decimal.NewFromString(tier.Multiplier)
+		if err != nil {
+			return nil, errors.NewFieldValidationError(fmt.Sprintf("tiers[%d].multiplier", i), "invalid multiplier")
+		}
+		program.Tiers = append(program.Tiers, &domain.LoyaltyTier{
+			Name:       tier.Name,
+			MinSpend:   tier.MinSpend,
+			Multiplier: multiplier,
+		})
+	}
+
+	// Call use case
+	program, err = h.loyaltyUseCase.UpdateProgram(ctx, program)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbLoyalty.ProgramResponse{
+		Success: true,
+		Message: "Loyalty program updated successfully",
+		Program: toProgramData(program),
+	}, nil
+}
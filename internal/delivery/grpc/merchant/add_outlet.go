@@ -0,0 +1,32 @@
+package merchant
+
+import (
+	"context"
+	pbMerchant "github/kijunpos/gen/proto/merchant"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// AddOutlet handles adding an outlet to the selected merchant
+func (h *Handler) AddOutlet(ctx context.Context, req *pbMerchant.AddOutletRequest) (*pbMerchant.OutletResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.merchant.AddOutlet")
+	defer span.End()
+
+	// Call use case
+	outlet, err := h.merchantUseCase.AddOutlet(ctx, &domain.Outlet{
+		Name:               req.Name,
+		Address:            req.Address,
+		PhoneNumber:        req.PhoneNumber,
+		AllowNegativeStock: req.AllowNegativeStock,
+		Timezone:           req.Timezone,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbMerchant.OutletResponse{
+		Success: true,
+		Message: "Outlet added successfully",
+		Outlet:  toOutletData(outlet),
+	}, nil
+}
@@ -0,0 +1,25 @@
+package merchant
+
+import (
+	"context"
+	pbMerchant "github/kijunpos/gen/proto/merchant"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// CreateMerchant handles creating a merchant owned by the caller
+func (h *Handler) CreateMerchant(ctx context.Context, req *pbMerchant.CreateMerchantRequest) (*pbMerchant.MerchantResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.merchant.CreateMerchant")
+	defer span.End()
+
+	// Call use case
+	merchant, err := h.merchantUseCase.CreateMerchant(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbMerchant.MerchantResponse{
+		Success:  true,
+		Message:  "Merchant created successfully",
+		Merchant: toMerchantData(merchant),
+	}, nil
+}
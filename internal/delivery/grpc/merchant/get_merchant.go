@@ -0,0 +1,25 @@
+package merchant
+
+import (
+	"context"
+	pbMerchant "github/kijunpos/gen/proto/merchant"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// GetMerchant handles returning the selected merchant
+func (h *Handler) GetMerchant(ctx context.Context, req *pbMerchant.GetMerchantRequest) (*pbMerchant.MerchantResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.merchant.GetMerchant")
+	defer span.End()
+
+	// Call use case
+	merchant, err := h.merchantUseCase.GetMerchant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbMerchant.MerchantResponse{
+		Success:  true,
+		Message:  "Merchant retrieved successfully",
+		Merchant: toMerchantData(merchant),
+	}, nil
+}
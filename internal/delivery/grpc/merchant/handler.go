@@ -0,0 +1,46 @@
+package merchant
+
+import (
+	pbMerchant "github/kijunpos/gen/proto/merchant"
+	"github/kijunpos/internal/domain"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Handler handles gRPC requests for merchant service
+type Handler struct {
+	pbMerchant.UnimplementedMerchantServiceServer
+	merchantUseCase domain.MerchantUseCase
+}
+
+// NewHandler creates a new merchant handler
+func NewHandler(merchantUseCase domain.MerchantUseCase) *Handler {
+	return &Handler{
+		merchantUseCase: merchantUseCase,
+	}
+}
+
+// toMerchantData converts a merchant into its protobuf representation
+func toMerchantData(merchant *domain.Merchant) *pbMerchant.MerchantData {
+	return &pbMerchant.MerchantData{
+		Id:          merchant.ID.String(),
+		Name:        merchant.Name,
+		OwnerUserId: merchant.OwnerUserID.String(),
+		CreatedAt:   timestamppb.New(merchant.CreatedAt),
+	}
+}
+
+// toOutletData converts an outlet into its protobuf representation
+func toOutletData(outlet *domain.Outlet) *pbMerchant.OutletData {
+	return &pbMerchant.OutletData{
+		Id:                 outlet.ID.String(),
+		MerchantId:         outlet.MerchantID.String(),
+		Name:               outlet.Name,
+		Address:            outlet.Address,
+		PhoneNumber:        outlet.PhoneNumber,
+		Active:             outlet.IsActive,
+		AllowNegativeStock: outlet.AllowNegativeStock,
+		Timezone:           outlet.Timezone,
+		CreatedAt:          timestamppb.New(outlet.CreatedAt),
+	}
+}
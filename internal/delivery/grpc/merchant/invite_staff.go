@@ -0,0 +1,35 @@
+package merchant
+
+import (
+	"context"
+	pbMerchant "github/kijunpos/gen/proto/merchant"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// InviteStaff handles adding a staff member to an outlet of the selected merchant
+func (h *Handler) InviteStaff(ctx context.Context, req *pbMerchant.InviteStaffRequest) (*pbMerchant.InviteStaffResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.merchant.InviteStaff")
+	defer span.End()
+
+	// The outlet ID format is checked by the validation rules
+	outletID, err := uuid.Parse(req.OutletId)
+	if err != nil {
+		return nil, errors.NewFieldValidationError("outlet_id", "invalid outlet id")
+	}
+
+	// Call use case
+	member, err := h.merchantUseCase.InviteStaff(ctx, outletID, req.Identifier, req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbMerchant.InviteStaffResponse{
+		Success: true,
+		Message: "Staff invited successfully",
+		UserId:  member.UserID.String(),
+		Role:    member.Role,
+	}, nil
+}
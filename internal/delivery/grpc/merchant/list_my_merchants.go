@@ -0,0 +1,30 @@
+package merchant
+
+import (
+	"context"
+	pbMerchant "github/kijunpos/gen/proto/merchant"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// ListMyMerchants handles listing the merchants of the caller
+func (h *Handler) ListMyMerchants(ctx context.Context, req *pbMerchant.ListMyMerchantsRequest) (*pbMerchant.ListMerchantsResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.merchant.ListMyMerchants")
+	defer span.End()
+
+	// Call use case
+	merchants, err := h.merchantUseCase.ListMyMerchants(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*pbMerchant.MerchantData, 0, len(merchants))
+	for _, merchant := range merchants {
+		data = append(data, toMerchantData(merchant))
+	}
+
+	return &pbMerchant.ListMerchantsResponse{
+		Success:   true,
+		Message:   "Merchants retrieved successfully",
+		Merchants: data,
+	}, nil
+}
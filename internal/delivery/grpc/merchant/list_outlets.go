@@ -0,0 +1,30 @@
+package merchant
+
+import (
+	"context"
+	pbMerchant "github/kijunpos/gen/proto/merchant"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// ListOutlets handles listing the outlets of the selected merchant
+func (h *Handler) ListOutlets(ctx context.Context, req *pbMerchant.ListOutletsRequest) (*pbMerchant.ListOutletsResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.merchant.ListOutlets")
+	defer span.End()
+
+	// Call use case
+	outlets, err := h.merchantUseCase.ListOutlets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*pbMerchant.OutletData, 0, len(outlets))
+	for _, outlet := range outlets {
+		data = append(data, toOutletData(outlet))
+	}
+
+	return &pbMerchant.ListOutletsResponse{
+		Success: true,
+		Message: "Outlets retrieved successfully",
+		Outlets: data,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package order
+
+import (
+	"context"
+	pbOrder "github/kijunpos/gen/proto/order"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// AddItem handles adding a variant to a cart
+func (h *Handler) AddItem(ctx context.Context, req *pbOrder.AddItemRequest) (*pbOrder.OrderResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.order.AddItem")
+	defer span.End()
+
+	orderID, err := errors.ParseID("order_id", req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	variantID, err := errors.ParseID("variant_id", req.VariantId)
+	if err != nil {
+		return nil, err
+	}
+	discount, err := fromDiscount(req.Discount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	order, err := h.orderUseCase.AddItem(ctx, orderID, variantID, req.Quantity, discount)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOrderResponse("Item added successfully", order), nil
+}
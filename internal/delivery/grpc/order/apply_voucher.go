@@ -0,0 +1,27 @@
+package order
+
+import (
+	"context"
+	pbOrder "github/kijunpos/gen/proto/order"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// ApplyVoucher handles attaching a voucher to a cart
+func (h *Handler) ApplyVoucher(ctx context.Context, req *pbOrder.ApplyVoucherRequest) (*pbOrder.OrderResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.order.ApplyVoucher")
+	defer span.End()
+
+	orderID, err := errors.ParseID("order_id", req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	order, err := h.orderUseCase.ApplyVoucher(ctx, orderID, req.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOrderResponse("Voucher applied successfully", order), nil
+}
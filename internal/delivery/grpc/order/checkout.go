@@ -0,0 +1,38 @@
+package order
+
+import (
+	"context"
+	pbOrder "github/kijunpos/gen/proto/order"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// Checkout handles paying and completing a cart
+func (h *Handler) Checkout(ctx context.Context, req *pbOrder.CheckoutRequest) (*pbOrder.OrderResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.order.Checkout")
+	defer span.End()
+
+	orderID, err := errors.ParseID("order_id", req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	payments := make([]*domain.Payment, 0, len(req.Payments))
+	for _, payment := range req.Payments {
+		payments = append(payments, &domain.Payment{
+			Method:    domain.PaymentMethod(payment.Method),
+			Amount:    payment.Amount,
+			Tendered:  payment.Tendered,
+			Reference: payment.Reference,
+		})
+	}
+
+	// Call use case
+	order, err := h.orderUseCase.Checkout(ctx, orderID, payments, req.IdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOrderResponse("Order checked out successfully", order), nil
+}
@@ -0,0 +1,21 @@
+package order
+
+import (
+	"context"
+	pbOrder "github/kijunpos/gen/proto/order"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// CreateOrder handles opening a cart at the selected outlet
+func (h *Handler) CreateOrder(ctx context.Context, req *pbOrder.CreateOrderRequest) (*pbOrder.OrderResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.order.CreateOrder")
+	defer span.End()
+
+	// Call use case
+	order, err := h.orderUseCase.CreateOrder(ctx, req.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOrderResponse("Order created successfully", order), nil
+}
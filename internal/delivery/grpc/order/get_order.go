@@ -0,0 +1,27 @@
+package order
+
+import (
+	"context"
+	pbOrder "github/kijunpos/gen/proto/order"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// GetOrder handles retrieving an order of the selected outlet
+func (h *Handler) GetOrder(ctx context.Context, req *pbOrder.GetOrderRequest) (*pbOrder.OrderResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.order.GetOrder")
+	defer span.End()
+
+	orderID, err := errors.ParseID("order_id", req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	order, err := h.orderUseCase.GetOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOrderResponse("Order retrieved successfully", order), nil
+}
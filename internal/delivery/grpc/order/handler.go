@@ -0,0 +1,161 @@
+package order
+
+import (
+	pbOrder "github/kijunpos/gen/proto/order"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/errors"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Handler handles gRPC requests for order service
+type Handler struct {
+	pbOrder.UnimplementedOrderServiceServer
+	orderUseCase domain.OrderUseCase
+}
+
+// NewHandler creates a new order handler
+func NewHandler(orderUseCase domain.OrderUseCase) *Handler {
+	return &Handler{
+		orderUseCase: orderUseCase,
+	}
+}
+
+// fromDiscount converts the discount of a request, nil is no discount
+func fromDiscount(discount *pbOrder.Discount) (domain.Discount, error) {
+	if discount == nil {
+		return domain.Discount{}, nil
+	}
+	value, err := decimal.NewFromString(discount.Value)
+	if err != nil {
+		return domain.Discount{}, errors.NewFieldValidationError("discount.value", "invalid discount value")
+	}
+	return domain.Discount{Type: domain.DiscountType(discount.Type), Value: value}, nil
+}
+
+// toDiscount converts a discount into its protobuf representation, no discount is nil
+func toDiscount(discountType domain.DiscountType, value decimal.Decimal) *pbOrder.Discount {
+	if discountType == "" {
+		return nil
+	}
+	return &pbOrder.Discount{Type: string(discountType), Value: value.String()}
+}
+
+// toOrderData converts an order into its protobuf representation
+func toOrderData(order *domain.Order) *pbOrder.OrderData {
+	data := &pbOrder.OrderData{
+		Id:                 order.ID.String(),
+		OutletId:           order.OutletID.String(),
+		CashierId:          order.CashierID.String(),
+		Status:             string(order.Status),
+		Number:             order.Number.Int64,
+		Note:               order.Note,
+		Discount:           toDiscount(order.DiscountType, order.DiscountValue),
+		Subtotal:           order.Subtotal,
+		DiscountTotal:      order.DiscountTotal,
+		ServiceChargeTotal: order.ServiceChargeTotal,
+		TaxTotal:           order.TaxTotal,
+		Total:              order.Total,
+		RefundedTotal:      order.RefundedTotal,
+		VoidReason:         order.VoidReason,
+		Items:              make([]*pbOrder.OrderItemData, 0, len(order.Items)),
+		Payments:           make([]*pbOrder.PaymentData, 0, len(order.Payments)),
+		Taxes:              make([]*pbOrder.OrderTaxData, 0, len(order.Taxes)),
+		PromotionTotal:     order.PromotionTotal,
+		VoucherCode:        order.VoucherCode,
+		Promotions:         make([]*pbOrder.OrderPromotionData, 0, len(order.Promotions)),
+		PointsRedeemed:     order.PointsRedeemed,
+		PointsDiscount:     order.PointsDiscount,
+		PointsEarned:       order.PointsEarned,
+		CreatedAt:          timestamppb.New(order.CreatedAt),
+	}
+	if order.ShiftID.Valid {
+		data.ShiftId = order.ShiftID.UUID.String()
+	}
+	if order.CustomerID.Valid {
+		data.CustomerId = order.CustomerID.UUID.String()
+	}
+	for _, item := range order.Items {
+		itemData := &pbOrder.OrderItemData{
+			Id:                item.ID.String(),
+			ProductId:         item.ProductID.String(),
+			VariantId:         item.VariantID.String(),
+			Sku:               item.SKU,
+			Name:              item.Name,
+			Unit:              item.Unit,
+			Quantity:          item.Quantity,
+			UnitPrice:         item.UnitPrice,
+			Discount:          toDiscount(item.DiscountType, item.DiscountValue),
+			Subtotal:          item.Subtotal,
+			DiscountAmount:    item.DiscountAmount,
+			Total:             item.Total,
+			RefundedQuantity:  item.RefundedQuantity,
+			TaxExempt:         item.TaxExempt,
+			OrderDiscount:     item.OrderDiscount,
+			ServiceCharge:     item.ServiceCharge,
+			TaxBase:           item.TaxBase,
+			TaxAmount:         item.TaxAmount,
+			GrandTotal:        item.GrandTotal,
+			PromotionDiscount: item.PromotionDiscount,
+		}
+		if item.CategoryID.Valid {
+			itemData.CategoryId = item.CategoryID.UUID.String()
+		}
+		data.Items = append(data.Items, itemData)
+	}
+	for _, p := range order.Promotions {
+		promotionData := &pbOrder.OrderPromotionData{
+			PromotionId: p.PromotionID.String(),
+			Name:        p.Name,
+			Amount:      p.Amount,
+		}
+		if p.ItemID.Valid {
+			promotionData.ItemId = p.ItemID.UUID.String()
+		}
+		data.Promotions = append(data.Promotions, promotionData)
+	}
+	for _, tax := range order.Taxes {
+		data.Taxes = append(data.Taxes, &pbOrder.OrderTaxData{
+			Type:      string(tax.Type),
+			Name:      tax.Name,
+			Rate:      tax.Rate.String(),
+			Inclusive: tax.Inclusive,
+			Base:      tax.Base,
+			Amount:    tax.Amount,
+		})
+	}
+	for _, payment := range order.Payments {
+		data.Payments = append(data.Payments, &pbOrder.PaymentData{
+			Id:             payment.ID.String(),
+			Method:         string(payment.Method),
+			Status:         string(payment.Status),
+			Amount:         payment.Amount,
+			Tendered:       payment.Tendered,
+			Change:         payment.Change,
+			Reference:      payment.Reference,
+			CreatedAt:      timestamppb.New(payment.CreatedAt),
+			Provider:       payment.Provider,
+			RefundedAmount: payment.RefundedAmount,
+		})
+	}
+	if order.UpdatedAt.Valid {
+		data.UpdatedAt = timestamppb.New(order.UpdatedAt.Time)
+	}
+	if order.CompletedAt.Valid {
+		data.CompletedAt = timestamppb.New(order.CompletedAt.Time)
+	}
+	if order.VoidedAt.Valid {
+		data.VoidedAt = timestamppb.New(order.VoidedAt.Time)
+	}
+	return data
+}
+
+// toOrderResponse converts an order into a response
+func toOrderResponse(message string, order *domain.Order) *pbOrder.OrderResponse {
+	return &pbOrder.OrderResponse{
+		Success: true,
+		Message: message,
+		Data:    toOrderData(order),
+	}
+}
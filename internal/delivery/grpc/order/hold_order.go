@@ -0,0 +1,27 @@
+package order
+
+import (
+	"context"
+	pbOrder "github/kijunpos/gen/proto/order"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// HoldOrder handles parking a cart
+func (h *Handler) HoldOrder(ctx context.Context, req *pbOrder.HoldOrderRequest) (*pbOrder.OrderResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.order.HoldOrder")
+	defer span.End()
+
+	orderID, err := errors.ParseID("order_id", req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	order, err := h.orderUseCase.HoldOrder(ctx, orderID, req.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOrderResponse("Order held successfully", order), nil
+}
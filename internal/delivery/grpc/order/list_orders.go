@@ -0,0 +1,41 @@
+package order
+
+import (
+	"context"
+	pbOrder "github/kijunpos/gen/proto/order"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// ListOrders handles listing the orders of the selected outlet
+func (h *Handler) ListOrders(ctx context.Context, req *pbOrder.ListOrdersRequest) (*pbOrder.ListOrdersResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.order.ListOrders")
+	defer span.End()
+
+	filter := domain.OrderFilter{Status: domain.OrderStatus(req.Status)}
+	if req.CashierId != "" {
+		var err error
+		if filter.CashierID, err = errors.ParseID("cashier_id", req.CashierId); err != nil {
+			return nil, err
+		}
+	}
+
+	// Call use case
+	page, err := h.orderUseCase.ListOrders(ctx, filter, int(req.PageSize), req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]*pbOrder.OrderData, 0, len(page.Orders))
+	for _, order := range page.Orders {
+		orders = append(orders, toOrderData(order))
+	}
+
+	return &pbOrder.ListOrdersResponse{
+		Success:    true,
+		Message:    "Orders retrieved successfully",
+		Orders:     orders,
+		NextCursor: page.NextCursor,
+	}, nil
+}
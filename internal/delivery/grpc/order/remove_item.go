@@ -0,0 +1,31 @@
+package order
+
+import (
+	"context"
+	pbOrder "github/kijunpos/gen/proto/order"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// RemoveItem handles removing an item from a cart
+func (h *Handler) RemoveItem(ctx context.Context, req *pbOrder.RemoveItemRequest) (*pbOrder.OrderResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.order.RemoveItem")
+	defer span.End()
+
+	orderID, err := errors.ParseID("order_id", req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	itemID, err := errors.ParseID("item_id", req.ItemId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	order, err := h.orderUseCase.RemoveItem(ctx, orderID, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOrderResponse("Item removed successfully", order), nil
+}
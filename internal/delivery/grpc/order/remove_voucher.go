@@ -0,0 +1,27 @@
+package order
+
+import (
+	"context"
+	pbOrder "github/kijunpos/gen/proto/order"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// RemoveVoucher handles detaching the voucher of a cart
+func (h *Handler) RemoveVoucher(ctx context.Context, req *pbOrder.RemoveVoucherRequest) (*pbOrder.OrderResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.order.RemoveVoucher")
+	defer span.End()
+
+	orderID, err := errors.ParseID("order_id", req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	order, err := h.orderUseCase.RemoveVoucher(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOrderResponse("Voucher removed successfully", order), nil
+}
@@ -0,0 +1,27 @@
+package order
+
+import (
+	"context"
+	pbOrder "github/kijunpos/gen/proto/order"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// ResumeOrder handles reopening a held cart
+func (h *Handler) ResumeOrder(ctx context.Context, req *pbOrder.ResumeOrderRequest) (*pbOrder.OrderResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.order.ResumeOrder")
+	defer span.End()
+
+	orderID, err := errors.ParseID("order_id", req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	order, err := h.orderUseCase.ResumeOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOrderResponse("Order resumed successfully", order), nil
+}
@@ -0,0 +1,31 @@
+package order
+
+import (
+	"context"
+	pbOrder "github/kijunpos/gen/proto/order"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// SetDiscount handles setting the discount of a whole cart
+func (h *Handler) SetDiscount(ctx context.Context, req *pbOrder.SetDiscountRequest) (*pbOrder.OrderResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.order.SetDiscount")
+	defer span.End()
+
+	orderID, err := errors.ParseID("order_id", req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	discount, err := fromDiscount(req.Discount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	order, err := h.orderUseCase.SetDiscount(ctx, orderID, discount)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOrderResponse("Discount set successfully", order), nil
+}
@@ -0,0 +1,35 @@
+package order
+
+import (
+	"context"
+	pbOrder "github/kijunpos/gen/proto/order"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// UpdateItem handles changing an item of a cart
+func (h *Handler) UpdateItem(ctx context.Context, req *pbOrder.UpdateItemRequest) (*pbOrder.OrderResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.order.UpdateItem")
+	defer span.End()
+
+	orderID, err := errors.ParseID("order_id", req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	itemID, err := errors.ParseID("item_id", req.ItemId)
+	if err != nil {
+		return nil, err
+	}
+	discount, err := fromDiscount(req.Discount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	order, err := h.orderUseCase.UpdateItem(ctx, orderID, itemID, req.Quantity, discount)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOrderResponse("Item updated successfully", order), nil
+}
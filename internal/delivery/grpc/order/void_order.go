@@ -0,0 +1,27 @@
+package order
+
+import (
+	"context"
+	pbOrder "github/kijunpos/gen/proto/order"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// VoidOrder handles cancelling a cart or a sale
+func (h *Handler) VoidOrder(ctx context.Context, req *pbOrder.VoidOrderRequest) (*pbOrder.OrderResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.order.VoidOrder")
+	defer span.End()
+
+	orderID, err := errors.ParseID("order_id", req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	order, err := h.orderUseCase.VoidOrder(ctx, orderID, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return toOrderResponse("Order voided successfully", order), nil
+}
@@ -0,0 +1,33 @@
+package payment
+
+import (
+	"context"
+	pbPayment "github/kijunpos/gen/proto/payment"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// AddPayment handles starting a payment for a cart
+func (h *Handler) AddPayment(ctx context.Context, req *pbPayment.AddPaymentRequest) (*pbPayment.PaymentResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.payment.AddPayment")
+	defer span.End()
+
+	orderID, err := errors.ParseID("order_id", req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	payment, err := h.paymentUseCase.AddPayment(ctx, orderID, &domain.Payment{
+		Method:    domain.PaymentMethod(req.Method),
+		Amount:    req.Amount,
+		Tendered:  req.Tendered,
+		Reference: req.Reference,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toPaymentResponse("Payment added successfully", payment), nil
+}
@@ -0,0 +1,27 @@
+package payment
+
+import (
+	"context"
+	pbPayment "github/kijunpos/gen/proto/payment"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// CancelPayment handles cancelling a payment of a cart
+func (h *Handler) CancelPayment(ctx context.Context, req *pbPayment.CancelPaymentRequest) (*pbPayment.PaymentResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.payment.CancelPayment")
+	defer span.End()
+
+	paymentID, err := errors.ParseID("payment_id", req.PaymentId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	payment, err := h.paymentUseCase.CancelPayment(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPaymentResponse("Payment cancelled successfully", payment), nil
+}
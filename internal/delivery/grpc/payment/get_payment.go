@@ -0,0 +1,27 @@
+package payment
+
+import (
+	"context"
+	pbPayment "github/kijunpos/gen/proto/payment"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// GetPayment handles retrieving a payment
+func (h *Handler) GetPayment(ctx context.Context, req *pbPayment.GetPaymentRequest) (*pbPayment.PaymentResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.payment.GetPayment")
+	defer span.End()
+
+	paymentID, err := errors.ParseID("payment_id", req.PaymentId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	payment, err := h.paymentUseCase.GetPayment(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPaymentResponse("Payment retrieved successfully", payment), nil
+}
@@ -0,0 +1,53 @@
+package payment
+
+import (
+	pbPayment "github/kijunpos/gen/proto/payment"
+	"github/kijunpos/internal/domain"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Handler handles gRPC requests for payment service
+type Handler struct {
+	pbPayment.UnimplementedPaymentServiceServer
+	paymentUseCase domain.PaymentUseCase
+}
+
+// NewHandler creates a new payment handler
+func NewHandler(paymentUseCase domain.PaymentUseCase) *Handler {
+	return &Handler{
+		paymentUseCase: paymentUseCase,
+	}
+}
+
+// toPaymentResponse converts a payment into a response
+func toPaymentResponse(message string, payment *domain.Payment) *pbPayment.PaymentResponse {
+	data := &pbPayment.PaymentData{
+		Id:             payment.ID.String(),
+		OrderId:        payment.OrderID.String(),
+		Method:         string(payment.Method),
+		Status:         string(payment.Status),
+		Amount:         payment.Amount,
+		RefundedAmount: payment.RefundedAmount,
+		Tendered:       payment.Tendered,
+		Change:         payment.Change,
+		Reference:      payment.Reference,
+		Provider:       payment.Provider,
+		CreatedAt:      timestamppb.New(payment.CreatedAt),
+	}
+	if payment.Status.IsOpen() {
+		data.QrString = payment.QRString
+	}
+	if payment.ExpiresAt.Valid {
+		data.ExpiresAt = timestamppb.New(payment.ExpiresAt.Time)
+	}
+	if payment.UpdatedAt.Valid {
+		data.UpdatedAt = timestamppb.New(payment.UpdatedAt.Time)
+	}
+
+	return &pbPayment.PaymentResponse{
+		Success: true,
+		Message: message,
+		Data:    data,
+	}
+}
@@ -0,0 +1,33 @@
+package promotion
+
+import (
+	"context"
+	pbPromotion "github/kijunpos/gen/proto/promotion"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// CreatePromotion handles adding a promotion to the selected merchant
+func (h *Handler) CreatePromotion(ctx context.Context, req *pbPromotion.CreatePromotionRequest) (*pbPromotion.PromotionResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.promotion.CreatePromotion")
+	defer span.End()
+
+	promotion, err := fromPromotionRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	promotion.Type = domain.PromotionType(req.Type)
+	promotion.IsActive = true
+
+	// Call use case
+	promotion, err = h.promotionUseCase.CreatePromotion(ctx, promotion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbPromotion.PromotionResponse{
+		Success:   true,
+		Message:   "Promotion created successfully",
+		Promotion: toPromotionData(promotion),
+	}, nil
+}
@@ -0,0 +1,36 @@
+package promotion
+
+import (
+	"context"
+	pbPromotion "github/kijunpos/gen/proto/promotion"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// GenerateVouchers handles creating vouchers for a promotion
+func (h *Handler) GenerateVouchers(ctx context.Context, req *pbPromotion.GenerateVouchersRequest) (*pbPromotion.GenerateVouchersResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.promotion.GenerateVouchers")
+	defer span.End()
+
+	promotionID, err := errors.ParseID("promotion_id", req.PromotionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	vouchers, err := h.promotionUseCase.GenerateVouchers(ctx, promotionID, int(req.Count))
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*pbPromotion.VoucherData, 0, len(vouchers))
+	for _, voucher := range vouchers {
+		data = append(data, toVoucherData(voucher))
+	}
+
+	return &pbPromotion.GenerateVouchersResponse{
+		Success:  true,
+		Message:  "Vouchers generated successfully",
+		Vouchers: data,
+	}, nil
+}
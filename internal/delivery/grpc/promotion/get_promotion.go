@@ -0,0 +1,31 @@
+package promotion
+
+import (
+	"context"
+	pbPromotion "github/kijunpos/gen/proto/promotion"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// GetPromotion handles retrieving a promotion
+func (h *Handler) GetPromotion(ctx context.Context, req *pbPromotion.GetPromotionRequest) (*pbPromotion.PromotionResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.promotion.GetPromotion")
+	defer span.End()
+
+	id, err := errors.ParseID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	promotion, err := h.promotionUseCase.GetPromotion(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbPromotion.PromotionResponse{
+		Success:   true,
+		Message:   "Promotion retrieved successfully",
+		Promotion: toPromotionData(promotion),
+	}, nil
+}
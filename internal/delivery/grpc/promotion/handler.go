@@ -0,0 +1,158 @@
+package promotion
+
+import (
+	"database/sql"
+	pbPromotion "github/kijunpos/gen/proto/promotion"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Handler handles gRPC requests for promotion service
+type Handler struct {
+	pbPromotion.UnimplementedPromotionServiceServer
+	promotionUseCase domain.PromotionUseCase
+}
+
+// NewHandler creates a new promotion handler
+func NewHandler(promotionUseCase domain.PromotionUseCase) *Handler {
+	return &Handler{
+		promotionUseCase: promotionUseCase,
+	}
+}
+
+// promotionRequest holds the fields the create and update requests share
+type promotionRequest interface {
+	GetOutletId() string
+	GetName() string
+	GetCategoryId() string
+	GetProductId() string
+	GetDiscountType() string
+	GetDiscountValue() string
+	GetBuyQuantity() int64
+	GetGetQuantity() int64
+	GetMinSpend() int64
+	GetStartsAt() *timestamppb.Timestamp
+	GetEndsAt() *timestamppb.Timestamp
+	GetWeekdays() []int32
+	GetDailyFrom() string
+	GetDailyUntil() string
+	GetPriority() int32
+	GetStackable() bool
+	GetRequiresVoucher() bool
+	GetUsageLimit() int64
+}
+
+// fromPromotionRequest converts the shared fields of a request into a promotion
+func fromPromotionRequest(req promotionRequest) (*domain.Promotion, error) {
+	outletID, err := errors.ParseOptionalID("outlet_id", req.GetOutletId())
+	if err != nil {
+		return nil, err
+	}
+	categoryID, err := errors.ParseOptionalID("category_id", req.GetCategoryId())
+	if err != nil {
+		return nil, err
+	}
+	productID, err := errors.ParseOptionalID("product_id", req.GetProductId())
+	if err != nil {
+		return nil, err
+	}
+	value, err := decimal.NewFromString(req.GetDiscountValue())
+	if err != nil {
+		return nil, errors.NewFieldValidationError("discount_value", "invalid discount value")
+	}
+
+	promotion := &domain.Promotion{
+		OutletID:        outletID,
+		Name:            req.GetName(),
+		CategoryID:      categoryID,
+		ProductID:       productID,
+		DiscountType:    domain.DiscountType(req.GetDiscountType()),
+		DiscountValue:   value,
+		BuyQuantity:     req.GetBuyQuantity(),
+		GetQuantity:     req.GetGetQuantity(),
+		MinSpend:        req.GetMinSpend(),
+		StartsAt:        req.GetStartsAt().AsTime(),
+		DailyFrom:       req.GetDailyFrom(),
+		DailyUntil:      req.GetDailyUntil(),
+		Priority:        int(req.GetPriority()),
+		Stackable:       req.GetStackable(),
+		RequiresVoucher: req.GetRequiresVoucher(),
+	}
+	if req.GetEndsAt() != nil {
+		promotion.EndsAt = sql.NullTime{Time: req.GetEndsAt().AsTime(), Valid: true}
+	}
+	for _, day := range req.GetWeekdays() {
+		promotion.Weekdays |= 1 << day
+	}
+	if req.GetUsageLimit() > 0 {
+		promotion.UsageLimit = sql.NullInt64{Int64: req.GetUsageLimit(), Valid: true}
+	}
+	return promotion, nil
+}
+
+// toPromotionData converts a promotion into its protobuf representation
+func toPromotionData(promotion *domain.Promotion) *pbPromotion.PromotionData {
+	data := &pbPromotion.PromotionData{
+		Id:              promotion.ID.String(),
+		Name:            promotion.Name,
+		Type:            string(promotion.Type),
+		DiscountType:    string(promotion.DiscountType),
+		DiscountValue:   promotion.DiscountValue.String(),
+		BuyQuantity:     promotion.BuyQuantity,
+		GetQuantity:     promotion.GetQuantity,
+		MinSpend:        promotion.MinSpend,
+		StartsAt:        timestamppb.New(promotion.StartsAt),
+		Weekdays:        []int32{},
+		DailyFrom:       promotion.DailyFrom,
+		DailyUntil:      promotion.DailyUntil,
+		Priority:        int32(promotion.Priority),
+		Stackable:       promotion.Stackable,
+		RequiresVoucher: promotion.RequiresVoucher,
+		UsageLimit:      promotion.UsageLimit.Int64,
+		UsageCount:      promotion.UsageCount,
+		IsActive:        promotion.IsActive,
+		CreatedAt:       timestamppb.New(promotion.CreatedAt),
+	}
+	if promotion.OutletID.Valid {
+		data.OutletId = promotion.OutletID.UUID.String()
+	}
+	if promotion.CategoryID.Valid {
+		data.CategoryId = promotion.CategoryID.UUID.String()
+	}
+	if promotion.ProductID.Valid {
+		data.ProductId = promotion.ProductID.UUID.String()
+	}
+	if promotion.EndsAt.Valid {
+		data.EndsAt = timestamppb.New(promotion.EndsAt.Time)
+	}
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if promotion.Weekdays&(1<<day) != 0 {
+			data.Weekdays = append(data.Weekdays, int32(day))
+		}
+	}
+	if promotion.UpdatedAt.Valid {
+		data.UpdatedAt = timestamppb.New(promotion.UpdatedAt.Time)
+	}
+	return data
+}
+
+// toVoucherData converts a voucher into its protobuf representation
+func toVoucherData(voucher *domain.Voucher) *pbPromotion.VoucherData {
+	data := &pbPromotion.VoucherData{
+		Id:          voucher.ID.String(),
+		PromotionId: voucher.PromotionID.String(),
+		Code:        voucher.Code,
+		CreatedAt:   timestamppb.New(voucher.CreatedAt),
+	}
+	if voucher.OrderID.Valid {
+		data.OrderId = voucher.OrderID.UUID.String()
+	}
+	if voucher.RedeemedAt.Valid {
+		data.RedeemedAt = timestamppb.New(voucher.RedeemedAt.Time)
+	}
+	return data
+}
@@ -0,0 +1,30 @@
+package promotion
+
+import (
+	"context"
+	pbPromotion "github/kijunpos/gen/proto/promotion"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// ListPromotions handles listing the promotions of the selected merchant
+func (h *Handler) ListPromotions(ctx context.Context, req *pbPromotion.ListPromotionsRequest) (*pbPromotion.ListPromotionsResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.promotion.ListPromotions")
+	defer span.End()
+
+	// Call use case
+	promotions, err := h.promotionUseCase.ListPromotions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*pbPromotion.PromotionData, 0, len(promotions))
+	for _, promotion := range promotions {
+		data = append(data, toPromotionData(promotion))
+	}
+
+	return &pbPromotion.ListPromotionsResponse{
+		Success:    true,
+		Message:    "Promotions retrieved successfully",
+		Promotions: data,
+	}, nil
+}
@@ -0,0 +1,37 @@
+package promotion
+
+import (
+	"context"
+	pbPromotion "github/kijunpos/gen/proto/promotion"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// ListVouchers handles listing the vouchers of a promotion
+func (h *Handler) ListVouchers(ctx context.Context, req *pbPromotion.ListVouchersRequest) (*pbPromotion.ListVouchersResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.promotion.ListVouchers")
+	defer span.End()
+
+	promotionID, err := errors.ParseID("promotion_id", req.PromotionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	page, err := h.promotionUseCase.ListVouchers(ctx, promotionID, int(req.PageSize), req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*pbPromotion.VoucherData, 0, len(page.Vouchers))
+	for _, voucher := range page.Vouchers {
+		data = append(data, toVoucherData(voucher))
+	}
+
+	return &pbPromotion.ListVouchersResponse{
+		Success:    true,
+		Message:    "Vouchers retrieved successfully",
+		Vouchers:   data,
+		NextCursor: page.NextCursor,
+	}, nil
+}
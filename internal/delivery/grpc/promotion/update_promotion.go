@@ -0,0 +1,37 @@
+package promotion
+
+import (
+	"context"
+	pbPromotion "github/kijunpos/gen/proto/promotion"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// UpdatePromotion handles changing a promotion
+func (h *Handler) UpdatePromotion(ctx context.Context, req *pbPromotion.UpdatePromotionRequest) (*pbPromotion.PromotionResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.promotion.UpdatePromotion")
+	defer span.End()
+
+	id, err := errors.ParseID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	promotion, err := fromPromotionRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	promotion.ID = id
+	promotion.IsActive = req.IsActive
+
+	// Call use case
+	promotion, err = h.promotionUseCase.UpdatePromotion(ctx, promotion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbPromotion.PromotionResponse{
+		Success:   true,
+		Message:   "Promotion updated successfully",
+		Promotion: toPromotionData(promotion),
+	}, nil
+}
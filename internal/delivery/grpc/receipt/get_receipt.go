@@ -0,0 +1,37 @@
+package receipt
+
+import (
+	"context"
+	pbReceipt "github/kijunpos/gen/proto/receipt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// GetReceipt handles rendering the receipt of a sale
+func (h *Handler) GetReceipt(ctx context.Context, req *pbReceipt.GetReceiptRequest) (*pbReceipt.ReceiptResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.receipt.GetReceipt")
+	defer span.End()
+
+	orderID, err := errors.ParseID("order_id", req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	document, err := h.receiptUseCase.GetReceipt(ctx, orderID, domain.ReceiptFormat(req.Format), domain.PaperWidth(req.PaperWidth))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbReceipt.ReceiptResponse{
+		Success: true,
+		Message: "Receipt rendered successfully",
+		Data: &pbReceipt.ReceiptData{
+			Format:      string(document.Format),
+			ContentType: document.ContentType,
+			FileName:    document.FileName,
+			Content:     document.Content,
+		},
+	}, nil
+}
@@ -0,0 +1,25 @@
+package receipt
+
+import (
+	"context"
+	pbReceipt "github/kijunpos/gen/proto/receipt"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// GetReceiptSettings handles retrieving the receipt settings of the selected outlet
+func (h *Handler) GetReceiptSettings(ctx context.Context, req *pbReceipt.GetReceiptSettingsRequest) (*pbReceipt.ReceiptSettingsResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.receipt.GetReceiptSettings")
+	defer span.End()
+
+	// Call use case
+	settings, err := h.receiptUseCase.GetReceiptSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbReceipt.ReceiptSettingsResponse{
+		Success: true,
+		Message: "Receipt settings retrieved successfully",
+		Data:    toReceiptSettingsData(settings),
+	}, nil
+}
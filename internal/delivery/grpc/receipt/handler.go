@@ -0,0 +1,35 @@
+package receipt
+
+import (
+	pbReceipt "github/kijunpos/gen/proto/receipt"
+	"github/kijunpos/internal/domain"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Handler handles gRPC requests for receipt service
+type Handler struct {
+	pbReceipt.UnimplementedReceiptServiceServer
+	receiptUseCase domain.ReceiptUseCase
+}
+
+// NewHandler creates a new receipt handler
+func NewHandler(receiptUseCase domain.ReceiptUseCase) *Handler {
+	return &Handler{
+		receiptUseCase: receiptUseCase,
+	}
+}
+
+// toReceiptSettingsData converts receipt settings into their protobuf representation
+func toReceiptSettingsData(settings *domain.ReceiptSettings) *pbReceipt.ReceiptSettingsData {
+	data := &pbReceipt.ReceiptSettingsData{
+		Header: settings.Header,
+		Footer: settings.Footer,
+		TaxId:  settings.TaxID,
+		Logo:   settings.Logo,
+	}
+	if settings.UpdatedAt.Valid {
+		data.UpdatedAt = timestamppb.New(settings.UpdatedAt.Time)
+	}
+	return data
+}
@@ -0,0 +1,43 @@
+package receipt
+
+import (
+	"context"
+	pbReceipt "github/kijunpos/gen/proto/receipt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// SendReceipt handles sending the receipt of a sale by email or WhatsApp
+func (h *Handler) SendReceipt(ctx context.Context, req *pbReceipt.SendReceiptRequest) (*pbReceipt.SendReceiptResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.receipt.SendReceipt")
+	defer span.End()
+
+	orderID, err := errors.ParseID("order_id", req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	var channel domain.ReceiptChannel
+	var recipient string
+	switch {
+	case req.Email != "" && req.WhatsappNumber != "":
+		return nil, errors.NewValidationError("either email or whatsapp_number is required, not both", nil)
+	case req.Email != "":
+		channel, recipient = domain.ReceiptChannelEmail, req.Email
+	case req.WhatsappNumber != "":
+		channel, recipient = domain.ReceiptChannelWhatsApp, req.WhatsappNumber
+	default:
+		return nil, errors.NewValidationError("either email or whatsapp_number is required", nil)
+	}
+
+	// Call use case
+	if err := h.receiptUseCase.SendReceipt(ctx, orderID, channel, recipient); err != nil {
+		return nil, err
+	}
+
+	return &pbReceipt.SendReceiptResponse{
+		Success: true,
+		Message: "Receipt sent successfully",
+	}, nil
+}
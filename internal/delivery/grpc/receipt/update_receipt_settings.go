@@ -0,0 +1,31 @@
+package receipt
+
+import (
+	"context"
+	pbReceipt "github/kijunpos/gen/proto/receipt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// UpdateReceiptSettings handles replacing the receipt settings of the selected outlet
+func (h *Handler) UpdateReceiptSettings(ctx context.Context, req *pbReceipt.UpdateReceiptSettingsRequest) (*pbReceipt.ReceiptSettingsResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.receipt.UpdateReceiptSettings")
+	defer span.End()
+
+	// Call use case
+	settings, err := h.receiptUseCase.UpdateReceiptSettings(ctx, &domain.ReceiptSettings{
+		Header: req.Header,
+		Footer: req.Footer,
+		TaxID:  req.TaxId,
+		Logo:   req.Logo,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbReceipt.ReceiptSettingsResponse{
+		Success: true,
+		Message: "Receipt settings updated successfully",
+		Data:    toReceiptSettingsData(settings),
+	}, nil
+}
@@ -0,0 +1,31 @@
+package refund
+
+import (
+	"context"
+	pbRefund "github/kijunpos/gen/proto/refund"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// GetRefund handles retrieving a refund
+func (h *Handler) GetRefund(ctx context.Context, req *pbRefund.GetRefundRequest) (*pbRefund.RefundResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.refund.GetRefund")
+	defer span.End()
+
+	refundID, err := errors.ParseID("refund_id", req.RefundId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	refund, err := h.refundUseCase.GetRefund(ctx, refundID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbRefund.RefundResponse{
+		Success: true,
+		Message: "Refund retrieved successfully",
+		Data:    toRefundData(refund),
+	}, nil
+}
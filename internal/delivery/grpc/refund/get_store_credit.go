@@ -0,0 +1,25 @@
+package refund
+
+import (
+	"context"
+	pbRefund "github/kijunpos/gen/proto/refund"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// GetStoreCredit handles looking up a store credit by its code
+func (h *Handler) GetStoreCredit(ctx context.Context, req *pbRefund.GetStoreCreditRequest) (*pbRefund.StoreCreditResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.refund.GetStoreCredit")
+	defer span.End()
+
+	// Call use case
+	credit, err := h.refundUseCase.GetStoreCredit(ctx, req.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbRefund.StoreCreditResponse{
+		Success: true,
+		Message: "Store credit retrieved successfully",
+		Data:    toStoreCreditData(credit),
+	}, nil
+}
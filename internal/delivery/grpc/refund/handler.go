@@ -0,0 +1,79 @@
+package refund
+
+import (
+	pbRefund "github/kijunpos/gen/proto/refund"
+	"github/kijunpos/internal/domain"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Handler handles gRPC requests for refund service
+type Handler struct {
+	pbRefund.UnimplementedRefundServiceServer
+	refundUseCase domain.RefundUseCase
+}
+
+// NewHandler creates a new refund handler
+func NewHandler(refundUseCase domain.RefundUseCase) *Handler {
+	return &Handler{
+		refundUseCase: refundUseCase,
+	}
+}
+
+// toRefundData converts a refund into its protobuf representation
+func toRefundData(refund *domain.Refund) *pbRefund.RefundData {
+	data := &pbRefund.RefundData{
+		Id:             refund.ID.String(),
+		OrderId:        refund.OrderID.String(),
+		Status:         string(refund.Status),
+		Tender:         string(refund.Tender),
+		Reason:         refund.Reason,
+		Amount:         refund.Amount,
+		CreatedBy:      refund.CreatedBy.String(),
+		PointsTaken:    refund.PointsTaken,
+		PointsReturned: refund.PointsReturned,
+		Items:          make([]*pbRefund.RefundItemData, 0, len(refund.Items)),
+		Payments:       make([]*pbRefund.RefundPaymentData, 0, len(refund.Payments)),
+		CreatedAt:      timestamppb.New(refund.CreatedAt),
+	}
+	if refund.ShiftID.Valid {
+		data.ShiftId = refund.ShiftID.UUID.String()
+	}
+	if refund.ApprovedBy.Valid {
+		data.ApprovedBy = refund.ApprovedBy.UUID.String()
+	}
+	for _, item := range refund.Items {
+		data.Items = append(data.Items, &pbRefund.RefundItemData{
+			OrderItemId: item.OrderItemID.String(),
+			VariantId:   item.VariantID.String(),
+			Quantity:    item.Quantity,
+			Amount:      item.Amount,
+			Restock:     item.Restock,
+		})
+	}
+	for _, payment := range refund.Payments {
+		data.Payments = append(data.Payments, &pbRefund.RefundPaymentData{
+			PaymentId: payment.PaymentID.String(),
+			Method:    string(payment.Method),
+			Amount:    payment.Amount,
+			Status:    string(payment.Status),
+		})
+	}
+	if refund.StoreCredit != nil {
+		data.StoreCredit = toStoreCreditData(refund.StoreCredit)
+	}
+	if refund.CompletedAt.Valid {
+		data.CompletedAt = timestamppb.New(refund.CompletedAt.Time)
+	}
+	return data
+}
+
+// toStoreCreditData converts a store credit into its protobuf representation
+func toStoreCreditData(credit *domain.StoreCredit) *pbRefund.StoreCreditData {
+	return &pbRefund.StoreCreditData{
+		Id:      credit.ID.String(),
+		Code:    credit.Code,
+		Amount:  credit.Amount,
+		Balance: credit.Balance,
+	}
+}
@@ -0,0 +1,36 @@
+package refund
+
+import (
+	"context"
+	pbRefund "github/kijunpos/gen/proto/refund"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// ListRefunds handles listing the refunds of a sale
+func (h *Handler) ListRefunds(ctx context.Context, req *pbRefund.ListRefundsRequest) (*pbRefund.ListRefundsResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.refund.ListRefunds")
+	defer span.End()
+
+	orderID, err := errors.ParseID("order_id", req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	refunds, err := h.refundUseCase.ListRefunds(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*pbRefund.RefundData, 0, len(refunds))
+	for _, refund := range refunds {
+		data = append(data, toRefundData(refund))
+	}
+
+	return &pbRefund.ListRefundsResponse{
+		Success: true,
+		Message: "Refunds retrieved successfully",
+		Refunds: data,
+	}, nil
+}
@@ -0,0 +1,49 @@
+package refund
+
+import (
+	"context"
+	"fmt"
+	pbRefund "github/kijunpos/gen/proto/refund"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// Refund handles refunding items of a completed sale
+func (h *Handler) Refund(ctx context.Context, req *pbRefund.RefundRequest) (*pbRefund.RefundResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.refund.Refund")
+	defer span.End()
+
+	orderID, err := errors.ParseID("order_id", req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*domain.RefundItem, 0, len(req.Items))
+	for i, item := range req.Items {
+		orderItemID, err := errors.ParseID(fmt.Sprintf("items[%d].order_item_id", i), item.OrderItemId)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, &domain.RefundItem{
+			OrderItemID: orderItemID,
+			Quantity:    item.Quantity,
+			Restock:     !item.Damaged,
+		})
+	}
+
+	// Call use case
+	refund, err := h.refundUseCase.Refund(ctx, orderID, &domain.Refund{
+		Tender: domain.RefundTender(req.Tender),
+		Reason: req.Reason,
+		Items:  items,
+	}, req.IdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbRefund.RefundResponse{
+		Success: true,
+		Message: "Refund completed successfully",
+		Data:    toRefundData(refund),
+	}, nil
+}
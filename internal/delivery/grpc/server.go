@@ -3,16 +3,46 @@ package grpc
 import (
 	"fmt"
 	"github/kijunpos/config"
+	pbCatalog "github/kijunpos/gen/proto/catalog"
+	pbInventory "github/kijunpos/gen/proto/inventory"
+	pbLoyalty "github/kijunpos/gen/proto/loyalty"
+	pbMerchant "github/kijunpos/gen/proto/merchant"
+	pbOrder "github/kijunpos/gen/proto/order"
+	pbPayment "github/kijunpos/gen/proto/payment"
+	pbPromotion "github/kijunpos/gen/proto/promotion"
+	pbReceipt "github/kijunpos/gen/proto/receipt"
+	pbRefund "github/kijunpos/gen/proto/refund"
+	pbShift "github/kijunpos/gen/proto/shift"
+	pbTax "github/kijunpos/gen/proto/tax"
 	pbUser "github/kijunpos/gen/proto/user"
+	"github/kijunpos/internal/delivery/grpc/interceptor"
+	"github/kijunpos/internal/domain"
 	"log"
 	"net"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
+	reflectionV1 "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionV1Alpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 )
 
+// publicMethods lists the RPCs that can be called without an access token
+var publicMethods = []string{
+	pbUser.UserService_Register_FullMethodName,
+	pbUser.UserService_VerifyWhatsApp_FullMethodName,
+	pbUser.UserService_Login_FullMethodName,
+	pbUser.UserService_RefreshToken_FullMethodName,
+	pbUser.UserService_Logout_FullMethodName,
+	pbUser.UserService_ResetPassword_FullMethodName,
+	pbUser.UserService_VerifyPasswordReset_FullMethodName,
+	pbUser.UserService_RequestPINReset_FullMethodName,
+	pbUser.UserService_VerifyPINReset_FullMethodName,
+	reflectionV1.ServerReflection_ServerReflectionInfo_FullMethodName,
+	reflectionV1Alpha.ServerReflection_ServerReflectionInfo_FullMethodName,
+}
+
 // StartGRPCServer starts the gRPC server
-func StartGRPCServer(cfg *config.Config, userHandler UserHandler) {
+func StartGRPCServer(cfg *config.Config, tokenService domain.TokenService, authorizationService domain.AuthorizationService, tenantResolver domain.TenantResolver, handlers Handlers) {
 	address := fmt.Sprintf(":%d", cfg.App.Port)
 	listener, err := net.Listen("tcp", address)
 	if err != nil {
@@ -20,10 +50,50 @@ func StartGRPCServer(cfg *config.Config, userHandler UserHandler) {
 	}
 
 	// Create gRPC server
-	grpcServer := grpc.NewServer()
+	// The error interceptor runs outside of the auth interceptor so that
+	// authentication failures also carry the request ID
+	requestIDInterceptor := interceptor.NewRequestIDInterceptor()
+	errorInterceptor := interceptor.NewErrorInterceptor()
+	clientIPInterceptor := interceptor.NewClientIPInterceptor(cfg.App.TrustProxyHeaders)
+	authInterceptor := interceptor.NewAuthInterceptor(tokenService, publicMethods...)
+	tenantInterceptor := interceptor.NewTenantInterceptor(tenantResolver)
+	authorizationInterceptor := interceptor.NewAuthorizationInterceptor(authorizationService)
+	validationInterceptor := interceptor.NewValidationInterceptor()
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			requestIDInterceptor.Unary(),
+			errorInterceptor.Unary(),
+			clientIPInterceptor.Unary(),
+			authInterceptor.Unary(),
+			tenantInterceptor.Unary(),
+			authorizationInterceptor.Unary(),
+			validationInterceptor.Unary(),
+		),
+		grpc.ChainStreamInterceptor(
+			requestIDInterceptor.Stream(),
+			errorInterceptor.Stream(),
+			clientIPInterceptor.Stream(),
+			authInterceptor.Stream(),
+			tenantInterceptor.Stream(),
+			authorizationInterceptor.Stream(),
+			validationInterceptor.Stream(),
+		),
+	)
 
 	// Register services
-	pbUser.RegisterUserServiceServer(grpcServer, userHandler)
+	pbUser.RegisterUserServiceServer(grpcServer, handlers.User)
+	pbUser.RegisterAdminUserServiceServer(grpcServer, handlers.AdminUser)
+	pbMerchant.RegisterMerchantServiceServer(grpcServer, handlers.Merchant)
+	pbCatalog.RegisterCatalogServiceServer(grpcServer, handlers.Catalog)
+	pbInventory.RegisterInventoryServiceServer(grpcServer, handlers.Inventory)
+	pbOrder.RegisterOrderServiceServer(grpcServer, handlers.Order)
+	pbPayment.RegisterPaymentServiceServer(grpcServer, handlers.Payment)
+	pbRefund.RegisterRefundServiceServer(grpcServer, handlers.Refund)
+	pbShift.RegisterShiftServiceServer(grpcServer, handlers.Shift)
+	pbReceipt.RegisterReceiptServiceServer(grpcServer, handlers.Receipt)
+	pbTax.RegisterTaxServiceServer(grpcServer, handlers.Tax)
+	pbPromotion.RegisterPromotionServiceServer(grpcServer, handlers.Promotion)
+	pbLoyalty.RegisterLoyaltyServiceServer(grpcServer, handlers.Loyalty)
 
 	// Register reflection service on gRPC server
 	reflection.Register(grpcServer)
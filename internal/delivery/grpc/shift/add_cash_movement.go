@@ -0,0 +1,26 @@
+package shift
+
+import (
+	"context"
+	pbShift "github/kijunpos/gen/proto/shift"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// AddCashMovement handles recording cash put into or taken out of the drawer
+func (h *Handler) AddCashMovement(ctx context.Context, req *pbShift.AddCashMovementRequest) (*pbShift.CashMovementResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.shift.AddCashMovement")
+	defer span.End()
+
+	// Call use case
+	movement, err := h.shiftUseCase.AddCashMovement(ctx, domain.CashMovementType(req.Type), req.Amount, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbShift.CashMovementResponse{
+		Success: true,
+		Message: "Cash movement recorded successfully",
+		Data:    toCashMovementData(movement),
+	}, nil
+}
@@ -0,0 +1,31 @@
+package shift
+
+import (
+	"context"
+	pbShift "github/kijunpos/gen/proto/shift"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// CloseShift handles closing a shift with the counted cash
+func (h *Handler) CloseShift(ctx context.Context, req *pbShift.CloseShiftRequest) (*pbShift.ShiftReportResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.shift.CloseShift")
+	defer span.End()
+
+	shiftID, err := errors.ParseID("shift_id", req.ShiftId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	report, err := h.shiftUseCase.CloseShift(ctx, shiftID, req.CountedCash, req.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbShift.ShiftReportResponse{
+		Success: true,
+		Message: "Shift closed successfully",
+		Data:    toShiftReportData(report),
+	}, nil
+}
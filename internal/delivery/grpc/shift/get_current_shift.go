@@ -0,0 +1,25 @@
+package shift
+
+import (
+	"context"
+	pbShift "github/kijunpos/gen/proto/shift"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// GetCurrentShift handles retrieving the open shift of the caller
+func (h *Handler) GetCurrentShift(ctx context.Context, req *pbShift.GetCurrentShiftRequest) (*pbShift.ShiftResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.shift.GetCurrentShift")
+	defer span.End()
+
+	// Call use case
+	shift, err := h.shiftUseCase.GetCurrentShift(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbShift.ShiftResponse{
+		Success: true,
+		Message: "Shift retrieved successfully",
+		Data:    toShiftData(shift),
+	}, nil
+}
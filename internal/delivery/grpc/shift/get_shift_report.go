@@ -0,0 +1,31 @@
+package shift
+
+import (
+	"context"
+	pbShift "github/kijunpos/gen/proto/shift"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// GetShiftReport handles retrieving the X or Z report of a shift
+func (h *Handler) GetShiftReport(ctx context.Context, req *pbShift.GetShiftReportRequest) (*pbShift.ShiftReportResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.shift.GetShiftReport")
+	defer span.End()
+
+	shiftID, err := errors.ParseID("shift_id", req.ShiftId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	report, err := h.shiftUseCase.GetShiftReport(ctx, shiftID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbShift.ShiftReportResponse{
+		Success: true,
+		Message: "Shift report retrieved successfully",
+		Data:    toShiftReportData(report),
+	}, nil
+}
@@ -0,0 +1,91 @@
+package shift
+
+import (
+	pbShift "github/kijunpos/gen/proto/shift"
+	"github/kijunpos/internal/domain"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Handler handles gRPC requests for shift service
+type Handler struct {
+	pbShift.UnimplementedShiftServiceServer
+	shiftUseCase domain.ShiftUseCase
+}
+
+// NewHandler creates a new shift handler
+func NewHandler(shiftUseCase domain.ShiftUseCase) *Handler {
+	return &Handler{
+		shiftUseCase: shiftUseCase,
+	}
+}
+
+// toShiftData converts a shift into its protobuf representation
+func toShiftData(shift *domain.Shift) *pbShift.ShiftData {
+	data := &pbShift.ShiftData{
+		Id:           shift.ID.String(),
+		OutletId:     shift.OutletID.String(),
+		UserId:       shift.UserID.String(),
+		Status:       string(shift.Status),
+		OpeningFloat: shift.OpeningFloat,
+		ExpectedCash: shift.ExpectedCash.Int64,
+		CountedCash:  shift.CountedCash.Int64,
+		Variance:     shift.Variance.Int64,
+		OpeningNote:  shift.OpeningNote,
+		ClosingNote:  shift.ClosingNote,
+		OpenedAt:     timestamppb.New(shift.OpenedAt),
+	}
+	if shift.ClosedAt.Valid {
+		data.ClosedAt = timestamppb.New(shift.ClosedAt.Time)
+	}
+	if shift.ClosedBy.Valid {
+		data.ClosedBy = shift.ClosedBy.UUID.String()
+	}
+	return data
+}
+
+// toCashMovementData converts a cash movement into its protobuf representation
+func toCashMovementData(movement *domain.CashMovement) *pbShift.CashMovementData {
+	return &pbShift.CashMovementData{
+		Id:        movement.ID.String(),
+		ShiftId:   movement.ShiftID.String(),
+		Type:      string(movement.Type),
+		Amount:    movement.Amount,
+		Reason:    movement.Reason,
+		CreatedBy: movement.CreatedBy.String(),
+		CreatedAt: timestamppb.New(movement.CreatedAt),
+	}
+}
+
+// toShiftReportData converts a shift report into its protobuf representation
+func toShiftReportData(report *domain.ShiftReport) *pbShift.ShiftReportData {
+	data := &pbShift.ShiftReportData{
+		Shift:              toShiftData(report.Shift),
+		Final:              report.IsFinal(),
+		SalesCount:         report.SalesCount,
+		Subtotal:           report.Subtotal,
+		DiscountTotal:      report.DiscountTotal,
+		TaxTotal:           report.TaxTotal,
+		ServiceChargeTotal: report.ServiceChargeTotal,
+		Total:              report.Total,
+		VoidedCount:        report.VoidedCount,
+		VoidedTotal:        report.VoidedTotal,
+		RefundCount:        report.RefundCount,
+		RefundTotal:        report.RefundTotal,
+		Tenders:            make([]*pbShift.TenderSummary, 0, len(report.Tenders)),
+		CashSales:          report.CashSales,
+		CashRefunds:        report.CashRefunds,
+		CashVoids:          report.CashVoids,
+		CashIn:             report.CashIn,
+		CashOut:            report.CashOut,
+		ExpectedCash:       report.ExpectedCash,
+	}
+	for _, tender := range report.Tenders {
+		data.Tenders = append(data.Tenders, &pbShift.TenderSummary{
+			Method: string(tender.Method),
+			Count:  tender.Count,
+			Amount: tender.Amount,
+		})
+	}
+	return data
+}
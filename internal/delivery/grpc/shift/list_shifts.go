@@ -0,0 +1,41 @@
+package shift
+
+import (
+	"context"
+	pbShift "github/kijunpos/gen/proto/shift"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// ListShifts handles listing the shifts of the selected outlet
+func (h *Handler) ListShifts(ctx context.Context, req *pbShift.ListShiftsRequest) (*pbShift.ListShiftsResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.shift.ListShifts")
+	defer span.End()
+
+	filter := domain.ShiftFilter{Status: domain.ShiftStatus(req.Status)}
+	if req.UserId != "" {
+		var err error
+		if filter.UserID, err = errors.ParseID("user_id", req.UserId); err != nil {
+			return nil, err
+		}
+	}
+
+	// Call use case
+	page, err := h.shiftUseCase.ListShifts(ctx, filter, int(req.PageSize), req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	shifts := make([]*pbShift.ShiftData, 0, len(page.Shifts))
+	for _, shift := range page.Shifts {
+		shifts = append(shifts, toShiftData(shift))
+	}
+
+	return &pbShift.ListShiftsResponse{
+		Success:    true,
+		Message:    "Shifts retrieved successfully",
+		Shifts:     shifts,
+		NextCursor: page.NextCursor,
+	}, nil
+}
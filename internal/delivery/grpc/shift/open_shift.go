@@ -0,0 +1,25 @@
+package shift
+
+import (
+	"context"
+	pbShift "github/kijunpos/gen/proto/shift"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// OpenShift handles opening a shift for the caller
+func (h *Handler) OpenShift(ctx context.Context, req *pbShift.OpenShiftRequest) (*pbShift.ShiftResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.shift.OpenShift")
+	defer span.End()
+
+	// Call use case
+	shift, err := h.shiftUseCase.OpenShift(ctx, req.OpeningFloat, req.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbShift.ShiftResponse{
+		Success: true,
+		Message: "Shift opened successfully",
+		Data:    toShiftData(shift),
+	}, nil
+}
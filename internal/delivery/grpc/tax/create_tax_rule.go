@@ -0,0 +1,48 @@
+package tax
+
+import (
+	"context"
+	pbTax "github/kijunpos/gen/proto/tax"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// CreateTaxRule handles adding a tax rule to the selected merchant or one of its outlets
+func (h *Handler) CreateTaxRule(ctx context.Context, req *pbTax.CreateTaxRuleRequest) (*pbTax.TaxRuleResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.tax.CreateTaxRule")
+	defer span.End()
+
+	var outletID uuid.NullUUID
+	if req.OutletId != "" {
+		id, err := errors.ParseID("outlet_id", req.OutletId)
+		if err != nil {
+			return nil, err
+		}
+		outletID = uuid.NullUUID{UUID: id, Valid: true}
+	}
+	rate, err := parseRate(req.Rate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	rule, err := h.taxUseCase.CreateTaxRule(ctx, &domain.TaxRule{
+		OutletID:  outletID,
+		Type:      domain.TaxType(req.Type),
+		Name:      req.Name,
+		Rate:      rate,
+		Inclusive: req.Inclusive,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbTax.TaxRuleResponse{
+		Success: true,
+		Message: "Tax rule created successfully",
+		TaxRule: toTaxRuleData(rule),
+	}, nil
+}
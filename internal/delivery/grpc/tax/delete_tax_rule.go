@@ -0,0 +1,29 @@
+package tax
+
+import (
+	"context"
+	pbTax "github/kijunpos/gen/proto/tax"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// DeleteTaxRule handles removing a tax rule
+func (h *Handler) DeleteTaxRule(ctx context.Context, req *pbTax.DeleteTaxRuleRequest) (*pbTax.TaxResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.tax.DeleteTaxRule")
+	defer span.End()
+
+	id, err := errors.ParseID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	if err := h.taxUseCase.DeleteTaxRule(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return &pbTax.TaxResponse{
+		Success: true,
+		Message: "Tax rule deleted successfully",
+	}, nil
+}
@@ -0,0 +1,52 @@
+package tax
+
+import (
+	pbTax "github/kijunpos/gen/proto/tax"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/errors"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Handler handles gRPC requests for tax service
+type Handler struct {
+	pbTax.UnimplementedTaxServiceServer
+	taxUseCase domain.TaxUseCase
+}
+
+// NewHandler creates a new tax handler
+func NewHandler(taxUseCase domain.TaxUseCase) *Handler {
+	return &Handler{
+		taxUseCase: taxUseCase,
+	}
+}
+
+// parseRate parses the rate of a request, its format is checked by the
+// validation rules
+func parseRate(value string) (decimal.Decimal, error) {
+	rate, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero, errors.NewFieldValidationError("rate", "invalid rate")
+	}
+	return rate, nil
+}
+
+// toTaxRuleData converts a tax rule into its protobuf representation
+func toTaxRuleData(rule *domain.TaxRule) *pbTax.TaxRuleData {
+	data := &pbTax.TaxRuleData{
+		Id:        rule.ID.String(),
+		Type:      string(rule.Type),
+		Name:      rule.Name,
+		Rate:      rule.Rate.String(),
+		Inclusive: rule.Inclusive,
+		CreatedAt: timestamppb.New(rule.CreatedAt),
+	}
+	if rule.OutletID.Valid {
+		data.OutletId = rule.OutletID.UUID.String()
+	}
+	if rule.UpdatedAt.Valid {
+		data.UpdatedAt = timestamppb.New(rule.UpdatedAt.Time)
+	}
+	return data
+}
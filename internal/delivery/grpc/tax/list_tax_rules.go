@@ -0,0 +1,30 @@
+package tax
+
+import (
+	"context"
+	pbTax "github/kijunpos/gen/proto/tax"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// ListTaxRules handles listing the tax rules of the selected merchant
+func (h *Handler) ListTaxRules(ctx context.Context, req *pbTax.ListTaxRulesRequest) (*pbTax.ListTaxRulesResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.tax.ListTaxRules")
+	defer span.End()
+
+	// Call use case
+	rules, err := h.taxUseCase.ListTaxRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*pbTax.TaxRuleData, 0, len(rules))
+	for _, rule := range rules {
+		data = append(data, toTaxRuleData(rule))
+	}
+
+	return &pbTax.ListTaxRulesResponse{
+		Success:  true,
+		Message:  "Tax rules retrieved successfully",
+		TaxRules: data,
+	}, nil
+}
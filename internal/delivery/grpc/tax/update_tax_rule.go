@@ -0,0 +1,41 @@
+package tax
+
+import (
+	"context"
+	pbTax "github/kijunpos/gen/proto/tax"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// UpdateTaxRule handles changing a tax rule
+func (h *Handler) UpdateTaxRule(ctx context.Context, req *pbTax.UpdateTaxRuleRequest) (*pbTax.TaxRuleResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.tax.UpdateTaxRule")
+	defer span.End()
+
+	id, err := errors.ParseID("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	rate, err := parseRate(req.Rate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	rule, err := h.taxUseCase.UpdateTaxRule(ctx, &domain.TaxRule{
+		ID:        id,
+		Name:      req.Name,
+		Rate:      rate,
+		Inclusive: req.Inclusive,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbTax.TaxRuleResponse{
+		Success: true,
+		Message: "Tax rule updated successfully",
+		TaxRule: toTaxRuleData(rule),
+	}, nil
+}
@@ -0,0 +1,30 @@
+package user
+
+import (
+	"context"
+	pbUser "github/kijunpos/gen/proto/user"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// AssignRole handles assigning a role to a user
+func (h *AdminHandler) AssignRole(ctx context.Context, req *pbUser.UserRoleRequest) (*pbUser.UserRolesResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.user.AssignRole")
+	defer span.End()
+
+	userID, err := parseUserID(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	roles, err := h.adminUserUseCase.AssignRole(ctx, userID, req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbUser.UserRolesResponse{
+		Success: true,
+		Message: "Role assigned successfully",
+		Roles:   roles,
+	}, nil
+}
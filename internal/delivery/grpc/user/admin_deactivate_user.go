@@ -0,0 +1,26 @@
+package user
+
+import (
+	"context"
+	pbUser "github/kijunpos/gen/proto/user"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// DeactivateUser handles deactivating a user account
+func (h *AdminHandler) DeactivateUser(ctx context.Context, req *pbUser.AdminUserRequest) (*pbUser.GeneralResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.user.DeactivateUser")
+	defer span.End()
+
+	userID, err := parseUserID(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	if err := h.adminUserUseCase.DeactivateUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return errors.NewSuccessResponse("User deactivated successfully"), nil
+}
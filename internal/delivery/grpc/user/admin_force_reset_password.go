@@ -0,0 +1,31 @@
+package user
+
+import (
+	"context"
+	pbUser "github/kijunpos/gen/proto/user"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// ForceResetPassword handles resetting the password of a user on their behalf
+func (h *AdminHandler) ForceResetPassword(ctx context.Context, req *pbUser.AdminUserRequest) (*pbUser.ResetPasswordResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.user.ForceResetPassword")
+	defer span.End()
+
+	userID, err := parseUserID(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	verificationCode, err := h.adminUserUseCase.ForceResetPassword(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The code is only set in development mode
+	return &pbUser.ResetPasswordResponse{
+		Success:          true,
+		Message:          "Password reset, a verification code has been sent to the user's email",
+		VerificationCode: verificationCode,
+	}, nil
+}
@@ -0,0 +1,30 @@
+package user
+
+import (
+	"context"
+	pbUser "github/kijunpos/gen/proto/user"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// GetUser handles returning the account details of any user
+func (h *AdminHandler) GetUser(ctx context.Context, req *pbUser.AdminUserRequest) (*pbUser.AdminUserResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.user.GetUser")
+	defer span.End()
+
+	userID, err := parseUserID(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	user, err := h.adminUserUseCase.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbUser.AdminUserResponse{
+		Success: true,
+		Message: "User retrieved successfully",
+		User:    toAdminUserData(user),
+	}, nil
+}
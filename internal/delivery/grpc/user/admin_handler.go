@@ -0,0 +1,56 @@
+package user
+
+import (
+	"database/sql"
+	pbUser "github/kijunpos/gen/proto/user"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AdminHandler handles gRPC requests for the back office user service
+type AdminHandler struct {
+	pbUser.UnimplementedAdminUserServiceServer
+	adminUserUseCase domain.AdminUserUseCase
+}
+
+// NewAdminHandler creates a new back office user handler
+func NewAdminHandler(adminUserUseCase domain.AdminUserUseCase) *AdminHandler {
+	return &AdminHandler{
+		adminUserUseCase: adminUserUseCase,
+	}
+}
+
+// parseUserID parses the user ID of a request, its format is checked by the validation rules
+func parseUserID(userID string) (uuid.UUID, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, errors.NewFieldValidationError("user_id", "invalid user id")
+	}
+	return id, nil
+}
+
+// toAdminUserData converts a user into the account details shown in the back office
+func toAdminUserData(user *domain.User) *pbUser.AdminUserData {
+	if user == nil {
+		return nil
+	}
+	return &pbUser.AdminUserData{
+		User:                toUserData(user),
+		Active:              user.IsActive,
+		LockedUntil:         toTimestamp(user.LockedUntil),
+		FailedLoginAttempts: int32(user.FailedLoginAttempts),
+		LastLoginAt:         toTimestamp(user.LastLoginAt),
+		DeletedAt:           toTimestamp(user.DeletedAt),
+	}
+}
+
+// toTimestamp converts a nullable time, NULL becomes an unset timestamp
+func toTimestamp(t sql.NullTime) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
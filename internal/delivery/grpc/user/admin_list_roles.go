@@ -0,0 +1,38 @@
+package user
+
+import (
+	"context"
+	pbUser "github/kijunpos/gen/proto/user"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// ListRoles handles listing the roles and their permissions
+func (h *AdminHandler) ListRoles(ctx context.Context, req *pbUser.ListRolesRequest) (*pbUser.ListRolesResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.user.ListRoles")
+	defer span.End()
+
+	// Call use case
+	roles, err := h.adminUserUseCase.ListRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]*pbUser.RoleData, 0, len(roles))
+	for _, role := range roles {
+		permissions := make([]string, 0, len(role.Permissions))
+		for _, permission := range role.Permissions {
+			permissions = append(permissions, string(permission))
+		}
+		data = append(data, &pbUser.RoleData{
+			Name:        role.Name,
+			Description: role.Description,
+			Permissions: permissions,
+		})
+	}
+
+	return &pbUser.ListRolesResponse{
+		Success: true,
+		Message: "Roles retrieved successfully",
+		Roles:   data,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package user
+
+import (
+	"context"
+	pbUser "github/kijunpos/gen/proto/user"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// ListUsers handles listing users for the back office
+func (h *AdminHandler) ListUsers(ctx context.Context, req *pbUser.ListUsersRequest) (*pbUser.ListUsersResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.user.ListUsers")
+	defer span.End()
+
+	filter := domain.UserFilter{
+		Search:    req.Search,
+		IsActive:  req.Active,
+		IsLocked:  req.Locked,
+		IsDeleted: req.Deleted,
+		AuthType:  domain.AuthType(req.AuthType),
+	}
+	if req.CreatedFrom != nil {
+		filter.CreatedAfter = req.CreatedFrom.AsTime()
+	}
+	if req.CreatedTo != nil {
+		filter.CreatedBefore = req.CreatedTo.AsTime()
+	}
+
+	// Call use case
+	page, err := h.adminUserUseCase.ListUsers(ctx, filter, int(req.PageSize), req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*pbUser.AdminUserData, 0, len(page.Users))
+	for _, user := range page.Users {
+		users = append(users, toAdminUserData(user))
+	}
+
+	return &pbUser.ListUsersResponse{
+		Success:    true,
+		Message:    "Users retrieved successfully",
+		Users:      users,
+		NextCursor: page.NextCursor,
+	}, nil
+}
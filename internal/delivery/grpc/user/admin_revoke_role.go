@@ -0,0 +1,30 @@
+package user
+
+import (
+	"context"
+	pbUser "github/kijunpos/gen/proto/user"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// RevokeRole handles revoking a role from a user
+func (h *AdminHandler) RevokeRole(ctx context.Context, req *pbUser.UserRoleRequest) (*pbUser.UserRolesResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.user.RevokeRole")
+	defer span.End()
+
+	userID, err := parseUserID(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Call use case
+	roles, err := h.adminUserUseCase.RevokeRole(ctx, userID, req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbUser.UserRolesResponse{
+		Success: true,
+		Message: "Role revoked successfully",
+		Roles:   roles,
+	}, nil
+}
@@ -0,0 +1,26 @@
+package user
+
+import (
+	"context"
+	pbUser "github/kijunpos/gen/proto/user"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// ChangePassword handles changing the password of the caller
+func (h *Handler) ChangePassword(ctx context.Context, req *pbUser.ChangePasswordRequest) (*pbUser.GeneralResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.user.ChangePassword")
+	defer span.End()
+
+	// Confirm the new password
+	if req.NewPassword != req.ConfirmationPassword {
+		return nil, errors.NewFieldValidationError("confirmation_password", "passwords do not match")
+	}
+
+	// Call use case
+	if err := h.userUseCase.ChangePassword(ctx, req.CurrentPassword, req.NewPassword); err != nil {
+		return nil, err
+	}
+
+	return errors.NewSuccessResponse("Password has been changed, please sign in again"), nil
+}
@@ -0,0 +1,26 @@
+package user
+
+import (
+	"context"
+	pbUser "github/kijunpos/gen/proto/user"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// ChangePIN handles changing the login PIN of the caller
+func (h *Handler) ChangePIN(ctx context.Context, req *pbUser.ChangePINRequest) (*pbUser.GeneralResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.user.ChangePIN")
+	defer span.End()
+
+	// Confirm the new PIN
+	if req.NewPin != req.ConfirmationPin {
+		return nil, errors.NewFieldValidationError("confirmation_pin", "PINs do not match")
+	}
+
+	// Call use case
+	if err := h.userUseCase.ChangePIN(ctx, req.CurrentPin, req.NewPin); err != nil {
+		return nil, err
+	}
+
+	return errors.NewSuccessResponse("PIN has been changed successfully"), nil
+}
@@ -0,0 +1,21 @@
+package user
+
+import (
+	"context"
+	pbUser "github/kijunpos/gen/proto/user"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// DeleteAccount handles deleting the account of the caller
+func (h *Handler) DeleteAccount(ctx context.Context, req *pbUser.DeleteAccountRequest) (*pbUser.GeneralResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.user.DeleteAccount")
+	defer span.End()
+
+	// Call use case
+	if err := h.userUseCase.DeleteAccount(ctx, req.Credential); err != nil {
+		return nil, err
+	}
+
+	return errors.NewSuccessResponse("Account deleted successfully"), nil
+}
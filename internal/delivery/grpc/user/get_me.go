@@ -0,0 +1,25 @@
+package user
+
+import (
+	"context"
+	pbUser "github/kijunpos/gen/proto/user"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// GetMe handles returning the account of the caller
+func (h *Handler) GetMe(ctx context.Context, req *pbUser.GetMeRequest) (*pbUser.UserResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.user.GetMe")
+	defer span.End()
+
+	// Call use case
+	user, err := h.userUseCase.GetMe(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbUser.UserResponse{
+		Success: true,
+		Message: "User retrieved successfully",
+		User:    toUserData(user),
+	}, nil
+}
@@ -5,56 +5,54 @@ import (
 	pbUser "github/kijunpos/gen/proto/user"
 	"github/kijunpos/internal/domain"
 	"github/kijunpos/internal/pkg/apm"
-	"github/kijunpos/internal/pkg/errors"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Login handles user authentication
-func (h *Handler) Login(ctx context.Context, req *pbUser.LoginRequest) (*pbUser.GeneralResponse, error) {
+func (h *Handler) Login(ctx context.Context, req *pbUser.LoginRequest) (*pbUser.LoginResponse, error) {
 	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.user.Login")
 	defer span.End()
 
-	// Determine auth type based on request
-	var authType domain.AuthType
-	switch req.AuthType {
-	case "email":
-		authType = domain.AuthTypeEmail
-	case "whatsapp":
-		authType = domain.AuthTypeWhatsApp
-	default:
-		return &pbUser.GeneralResponse{
-			Success: false,
-			Message: errors.NewBadRequestError("invalid auth type", nil).Error(),
-		}, nil
-	}
-
-	// Validate input
-	if req.Identifier == "" {
-		return &pbUser.GeneralResponse{
-			Success: false,
-			Message: errors.NewValidationError("identifier is required", nil).Error(),
-		}, nil
-	}
-	if req.Credential == "" {
-		return &pbUser.GeneralResponse{
-			Success: false,
-			Message: errors.NewValidationError("credential is required", nil).Error(),
-		}, nil
-	}
+	// The auth type is restricted to "email" or "whatsapp" by the validation rules
+	authType := domain.AuthType(req.AuthType)
 
 	// Call use case
-	_, err := h.userUseCase.Login(ctx, authType, req.Identifier, req.Credential)
+	authToken, err := h.userUseCase.Login(ctx, authType, req.Identifier, req.Credential)
 	if err != nil {
-		// Handle the error using the custom error package
-		errMsg := errors.HandleResponseError(ctx, span, err)
-		return &pbUser.GeneralResponse{
-			Success: false,
-			Message: errMsg,
-		}, nil
+		return nil, err
 	}
 
 	// Create response
-	return &pbUser.GeneralResponse{
-		Success: true,
-		Message: "Login successful",
-	}, nil
+	return toLoginResponse(authToken, "Login successful"), nil
+}
+
+// toLoginResponse maps an issued token pair to a LoginResponse
+func toLoginResponse(authToken *domain.AuthToken, message string) *pbUser.LoginResponse {
+	return &pbUser.LoginResponse{
+		Success:               true,
+		Message:               message,
+		AccessToken:           authToken.AccessToken,
+		AccessTokenExpiresAt:  timestamppb.New(authToken.AccessTokenExpiresAt),
+		RefreshToken:          authToken.RefreshToken,
+		RefreshTokenExpiresAt: timestamppb.New(authToken.RefreshTokenExpiresAt),
+		TokenType:             "Bearer",
+		User:                  toUserData(authToken.User),
+	}
+}
+
+// toUserData maps a user entity to the public UserData message
+func toUserData(user *domain.User) *pbUser.UserData {
+	if user == nil {
+		return nil
+	}
+	return &pbUser.UserData{
+		Id:                  user.ID.String(),
+		Username:            user.UserName,
+		Email:               user.Email,
+		PhoneNumber:         user.WhatsAppNumber,
+		EmailVerified:       user.EmailVerifiedAt.Valid,
+		PhoneNumberVerified: user.WhatsAppVerifiedAt.Valid,
+		CreatedAt:           timestamppb.New(user.CreatedAt),
+	}
 }
@@ -0,0 +1,22 @@
+package user
+
+import (
+	"context"
+	pbUser "github/kijunpos/gen/proto/user"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// Logout handles revoking a refresh token
+func (h *Handler) Logout(ctx context.Context, req *pbUser.LogoutRequest) (*pbUser.GeneralResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.user.Logout")
+	defer span.End()
+
+	// Call use case
+	err := h.userUseCase.Logout(ctx, req.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return errors.NewSuccessResponse("Logout successful"), nil
+}
@@ -0,0 +1,58 @@
+package user
+
+import (
+	"context"
+	pbUser "github/kijunpos/gen/proto/user"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// RequestPINReset handles "forgot PIN" requests
+func (h *Handler) RequestPINReset(ctx context.Context, req *pbUser.RequestPINResetRequest) (*pbUser.RequestPINResetResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.user.RequestPINReset")
+	defer span.End()
+
+	channel := domain.VerificationChannel(req.Channel)
+
+	// Call use case
+	channels, err := h.userUseCase.RequestPINReset(ctx, req.Identifier, channel)
+	if err != nil {
+		return nil, err
+	}
+
+	availableChannels := make([]string, 0, len(channels))
+	for _, c := range channels {
+		availableChannels = append(availableChannels, string(c))
+	}
+
+	message := "Choose a channel to receive the verification code"
+	if channel != "" {
+		message = "A verification code has been sent through the chosen channel"
+	}
+
+	return &pbUser.RequestPINResetResponse{
+		Success:           true,
+		Message:           message,
+		AvailableChannels: availableChannels,
+	}, nil
+}
+
+// VerifyPINReset handles PIN reset verification
+func (h *Handler) VerifyPINReset(ctx context.Context, req *pbUser.VerifyPINResetRequest) (*pbUser.GeneralResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.user.VerifyPINReset")
+	defer span.End()
+
+	// Confirm the new PIN
+	if req.NewPin != req.ConfirmationPin {
+		return nil, errors.NewFieldValidationError("confirmation_pin", "PINs do not match")
+	}
+
+	// Call use case
+	err := h.userUseCase.VerifyPINReset(ctx, req.Identifier, req.VerificationCode, req.NewPin)
+	if err != nil {
+		return nil, err
+	}
+
+	return errors.NewSuccessResponse("PIN has been reset successfully"), nil
+}
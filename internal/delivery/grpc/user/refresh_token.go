@@ -0,0 +1,21 @@
+package user
+
+import (
+	"context"
+	pbUser "github/kijunpos/gen/proto/user"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// RefreshToken handles exchanging a refresh token for a new token pair
+func (h *Handler) RefreshToken(ctx context.Context, req *pbUser.RefreshTokenRequest) (*pbUser.LoginResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.user.RefreshToken")
+	defer span.End()
+
+	// Call use case
+	authToken, err := h.userUseCase.RefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return toLoginResponse(authToken, "Token refreshed successfully"), nil
+}
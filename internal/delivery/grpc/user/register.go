@@ -27,19 +27,17 @@ func (h *Handler) Register(ctx context.Context, req *pbUser.RegisterRequest) (*p
 		params["email"] = req.Email
 		params["password"] = req.Password
 	} else {
-		return errors.NewErrorResponse("Either phone number or email and password are required"), nil
-	}
-
-	// Validate username
-	if req.Name == "" {
-		return errors.NewErrorResponse("Name is required"), nil
+		return nil, errors.NewValidationError("either phone number or email and password are required", nil)
 	}
 
 	// Call use case
 	_, err := h.userUseCase.Register(ctx, authType, req.Name, params)
 	if err != nil {
-		return errors.MapErrorToResponse(ctx, span, err)
+		return nil, err
 	}
 
+	if authType == domain.AuthTypeWhatsApp {
+		return errors.NewSuccessResponse("Verification code sent to your WhatsApp"), nil
+	}
 	return errors.NewSuccessResponse("User registered successfully"), nil
 }
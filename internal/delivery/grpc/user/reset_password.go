@@ -12,30 +12,17 @@ func (h *Handler) ResetPassword(ctx context.Context, req *pbUser.ResetPasswordRe
 	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.user.ResetPassword")
 	defer span.End()
 
-	// Validate input
-	if req.Email == "" {
-		return &pbUser.ResetPasswordResponse{
-			Success: false,
-			Message: errors.NewValidationError("email is required", nil).Error(),
-		}, nil
-	}
-
 	// Call use case
 	verificationCode, err := h.userUseCase.ResetPassword(ctx, req.Email)
 	if err != nil {
-		errMsg := errors.HandleResponseError(ctx, span, err)
-		return &pbUser.ResetPasswordResponse{
-			Success: false,
-			Message: errMsg,
-		}, nil
+		return nil, err
 	}
 
-	// In a real application, the verification code would be sent to the user via email
-	// and not returned in the response
+	// The same answer is given for unknown emails, the code is only set in development mode
 	return &pbUser.ResetPasswordResponse{
 		Success:          true,
-		Message:          "Verification code sent to your email",
-		VerificationCode: verificationCode, // Only for testing purposes
+		Message:          "If the email is registered, a verification code has been sent to it",
+		VerificationCode: verificationCode,
 	}, nil
 }
 
@@ -44,24 +31,15 @@ func (h *Handler) VerifyPasswordReset(ctx context.Context, req *pbUser.VerifyPas
 	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.user.VerifyPasswordReset")
 	defer span.End()
 
-	// Validate input
-	if req.Email == "" {
-		return errors.NewErrorResponse("email is required"), nil
-	}
-	if req.VerificationCode == "" {
-		return errors.NewErrorResponse("verification code is required"), nil
-	}
-	if req.NewPassword == "" {
-		return errors.NewErrorResponse("new password is required"), nil
-	}
+	// Confirm the new password
 	if req.NewPassword != req.ConfirmationPassword {
-		return errors.NewErrorResponse("passwords do not match"), nil
+		return nil, errors.NewFieldValidationError("confirmation_password", "passwords do not match")
 	}
 
 	// Call use case
 	err := h.userUseCase.VerifyPasswordReset(ctx, req.Email, req.VerificationCode, req.NewPassword)
 	if err != nil {
-		return errors.MapErrorToResponse(ctx, span, err)
+		return nil, err
 	}
 
 	return errors.NewSuccessResponse("Password has been reset successfully"), nil
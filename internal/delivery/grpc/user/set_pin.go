@@ -0,0 +1,26 @@
+package user
+
+import (
+	"context"
+	pbUser "github/kijunpos/gen/proto/user"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// SetPIN handles setting the first login PIN of the caller
+func (h *Handler) SetPIN(ctx context.Context, req *pbUser.SetPINRequest) (*pbUser.GeneralResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.user.SetPIN")
+	defer span.End()
+
+	// Confirm the PIN
+	if req.Pin != req.ConfirmationPin {
+		return nil, errors.NewFieldValidationError("confirmation_pin", "PINs do not match")
+	}
+
+	// Call use case
+	if err := h.userUseCase.SetPIN(ctx, req.Pin); err != nil {
+		return nil, err
+	}
+
+	return errors.NewSuccessResponse("PIN has been set successfully"), nil
+}
@@ -0,0 +1,29 @@
+package user
+
+import (
+	"context"
+	pbUser "github/kijunpos/gen/proto/user"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// UnlockUser handles clearing the lockout of a user account
+func (h *Handler) UnlockUser(ctx context.Context, req *pbUser.UnlockUserRequest) (*pbUser.GeneralResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.user.UnlockUser")
+	defer span.End()
+
+	// The user ID format is checked by the validation rules
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, errors.NewFieldValidationError("user_id", "invalid user id")
+	}
+
+	// Call use case
+	if err := h.userUseCase.UnlockUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return errors.NewSuccessResponse("User unlocked successfully"), nil
+}
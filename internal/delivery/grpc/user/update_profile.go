@@ -0,0 +1,35 @@
+package user
+
+import (
+	"context"
+	pbUser "github/kijunpos/gen/proto/user"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// UpdateProfile handles changing the profile of the caller
+func (h *Handler) UpdateProfile(ctx context.Context, req *pbUser.UpdateProfileRequest) (*pbUser.UserResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.user.UpdateProfile")
+	defer span.End()
+
+	// Call use case
+	user, err := h.userUseCase.UpdateProfile(ctx, domain.ProfileUpdate{
+		UserName:       req.Username,
+		Email:          req.Email,
+		WhatsAppNumber: req.PhoneNumber,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	message := "Profile updated successfully"
+	if (req.Email != nil && !user.EmailVerifiedAt.Valid) || (req.PhoneNumber != nil && !user.WhatsAppVerifiedAt.Valid) {
+		message = "Profile updated, please confirm the verification code sent to your new contact"
+	}
+
+	return &pbUser.UserResponse{
+		Success: true,
+		Message: message,
+		User:    toUserData(user),
+	}, nil
+}
@@ -0,0 +1,21 @@
+package user
+
+import (
+	"context"
+	pbUser "github/kijunpos/gen/proto/user"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/errors"
+)
+
+// VerifyEmail handles confirming the email address of the caller
+func (h *Handler) VerifyEmail(ctx context.Context, req *pbUser.VerifyEmailRequest) (*pbUser.GeneralResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.user.VerifyEmail")
+	defer span.End()
+
+	// Call use case
+	if err := h.userUseCase.VerifyEmail(ctx, req.VerificationCode); err != nil {
+		return nil, err
+	}
+
+	return errors.NewSuccessResponse("Email address verified successfully"), nil
+}
@@ -0,0 +1,21 @@
+package user
+
+import (
+	"context"
+	pbUser "github/kijunpos/gen/proto/user"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// VerifyWhatsApp handles confirming the WhatsApp registration code
+func (h *Handler) VerifyWhatsApp(ctx context.Context, req *pbUser.VerifyWhatsAppRequest) (*pbUser.LoginResponse, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "delivery.grpc.user.VerifyWhatsApp")
+	defer span.End()
+
+	// Call use case
+	authToken, err := h.userUseCase.VerifyWhatsApp(ctx, req.PhoneNumber, req.VerificationCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return toLoginResponse(authToken, "WhatsApp number verified successfully"), nil
+}
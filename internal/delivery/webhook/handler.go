@@ -0,0 +1,42 @@
+package webhook
+
+import (
+	stdErrors "errors"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/errors"
+	"net/http"
+)
+
+// maxPayloadSize limits the size of a notification
+const maxPayloadSize = 64 << 10
+
+// Handler handles notifications sent by third parties over HTTP
+type Handler struct {
+	paymentUseCase domain.PaymentUseCase
+}
+
+// NewHandler creates a new webhook handler
+func NewHandler(paymentUseCase domain.PaymentUseCase) *Handler {
+	return &Handler{
+		paymentUseCase: paymentUseCase,
+	}
+}
+
+// statusOf returns the HTTP status answering err. Senders retry
+// notifications answered with a server error.
+func statusOf(err error) int {
+	var appErr *errors.AppError
+	if !stdErrors.As(err, &appErr) {
+		return http.StatusInternalServerError
+	}
+	switch appErr.Category {
+	case errors.CategoryValidation, errors.CategoryBadRequest:
+		return http.StatusBadRequest
+	case errors.CategoryUnauthorized:
+		return http.StatusUnauthorized
+	case errors.CategoryNotFound:
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,33 @@
+package webhook
+
+import (
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/logger"
+	"github/kijunpos/internal/pkg/payment"
+	"io"
+	"net/http"
+)
+
+// PaymentNotification handles a notification a payment gateway sent about a payment
+func (h *Handler) PaymentNotification(w http.ResponseWriter, r *http.Request) {
+	ctx, span := apm.GetTracer().Start(r.Context(), "delivery.webhook.PaymentNotification")
+	defer span.End()
+
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPayloadSize))
+	if err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	// Call use case
+	provider := r.PathValue("provider")
+	err = h.paymentUseCase.HandleNotification(ctx, provider, payload, r.Header.Get(payment.SignatureHeader))
+	if err != nil {
+		status := statusOf(err)
+		logger.GetLogger().WithContext(ctx).Warnf("payment notification of %s rejected with status %d: %v", provider, status, err)
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,26 @@
+package webhook
+
+import (
+	"fmt"
+	"github/kijunpos/config"
+	"log"
+	"net/http"
+	"time"
+)
+
+// StartWebhookServer starts the HTTP server receiving webhooks
+func StartWebhookServer(cfg *config.Config, handler *Handler) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /webhooks/payments/{provider}", handler.PaymentNotification)
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", cfg.App.HTTPPort),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	log.Printf("webhook server listening on %s", server.Addr)
+	if err := server.ListenAndServe(); err != nil {
+		log.Fatalf("failed to serve webhooks: %v", err)
+	}
+}
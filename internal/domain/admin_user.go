@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserFilter narrows down the users listed by the back office, zero values
+// and nil pointers leave the corresponding field unfiltered
+type UserFilter struct {
+	// Search matches a substring of the username, email or WhatsApp number
+	Search   string
+	IsActive *bool
+	IsLocked *bool
+	// IsDeleted lists soft deleted accounts when true, nil lists accounts that are not deleted
+	IsDeleted *bool
+	// AuthType lists the accounts that can sign in with the given method
+	AuthType      AuthType
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+}
+
+// UserPage is one page of listed users
+type UserPage struct {
+	Users []*User
+	// NextCursor is empty on the last page
+	NextCursor string
+}
+
+// AdminUserUseCase represents the back office user management contract,
+// every method checks the permissions of the caller
+type AdminUserUseCase interface {
+	ListUsers(ctx context.Context, filter UserFilter, pageSize int, cursor string) (*UserPage, error)
+	// GetUser returns the user with the given ID, soft deleted accounts included
+	GetUser(ctx context.Context, id uuid.UUID) (*User, error)
+	// DeactivateUser disables the account and signs out all of its sessions
+	DeactivateUser(ctx context.Context, id uuid.UUID) error
+	ReactivateUser(ctx context.Context, id uuid.UUID) error
+	// RestoreUser undoes the soft delete of an account
+	RestoreUser(ctx context.Context, id uuid.UUID) error
+	// ForceResetPassword invalidates the password, signs out all sessions and
+	// sends a password reset code to the email of the account. The code is only
+	// returned in development mode.
+	ForceResetPassword(ctx context.Context, id uuid.UUID) (string, error)
+
+	ListRoles(ctx context.Context) ([]*Role, error)
+	// AssignRole and RevokeRole change the roles of a user and return the roles
+	// the user has afterwards. The caller needs every permission of the role.
+	AssignRole(ctx context.Context, userID uuid.UUID, role string) ([]string, error)
+	RevokeRole(ctx context.Context, userID uuid.UUID, role string) ([]string, error)
+}
@@ -6,4 +6,6 @@ import "context"
 type EmailService interface {
 	// SendVerificationCode sends a verification code to the specified email address
 	SendVerificationCode(ctx context.Context, email, code string) error
+	// SendReceipt sends a receipt to the specified email address
+	SendReceipt(ctx context.Context, email string, receipt *ReceiptMessage) error
 }
@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StockMovementType is the reason stock changed
+type StockMovementType string
+
+const (
+	// StockMovementSale is stock leaving with a sold item
+	StockMovementSale StockMovementType = "sale"
+	// StockMovementReturn is stock coming back with a returned item
+	StockMovementReturn StockMovementType = "return"
+	// StockMovementPurchaseReceipt is stock received from a supplier
+	StockMovementPurchaseReceipt StockMovementType = "purchase_receipt"
+	// StockMovementAdjustment is a manual correction, e.g. for damaged goods
+	StockMovementAdjustment StockMovementType = "adjustment"
+	// StockMovementTransferIn is stock received from another outlet
+	StockMovementTransferIn StockMovementType = "transfer_in"
+	// StockMovementTransferOut is stock sent to another outlet
+	StockMovementTransferOut StockMovementType = "transfer_out"
+	// StockMovementStockTake sets the stock to the quantity counted on the shelf
+	StockMovementStockTake StockMovementType = "stock_take"
+)
+
+// StockMovement is an entry of the stock ledger of an outlet. The ledger is
+// append-only, the on-hand quantity of a variant is the sum of its movements.
+// Quantities are whole numbers of the unit of the product, products sold by
+// weight or volume use g or ml.
+type StockMovement struct {
+	ID         uuid.UUID         `db:"id"`
+	MerchantID uuid.UUID         `db:"merchant_id"`
+	OutletID   uuid.UUID         `db:"outlet_id"`
+	VariantID  uuid.UUID         `db:"variant_id"`
+	Type       StockMovementType `db:"type"`
+	// Quantity is the change of the stock, negative when stock leaves
+	Quantity int64 `db:"quantity"`
+	// BalanceAfter is the on-hand quantity after the movement
+	BalanceAfter int64 `db:"balance_after"`
+	// ReferenceID points to the transfer, order or refund behind the movement
+	ReferenceID uuid.NullUUID `db:"reference_id"`
+	Note        string        `db:"note"`
+	CreatedBy   uuid.NullUUID `db:"created_by"`
+	CreatedAt   time.Time     `db:"created_at"`
+}
+
+// StockLevel is the on-hand quantity of a variant at an outlet
+type StockLevel struct {
+	MerchantID  uuid.UUID `db:"merchant_id"`
+	OutletID    uuid.UUID `db:"outlet_id"`
+	VariantID   uuid.UUID `db:"variant_id"`
+	SKU         string    `db:"sku"`
+	VariantName string    `db:"variant_name"`
+	ProductName string    `db:"product_name"`
+	OnHand      int64     `db:"on_hand"`
+	// LowStockThreshold marks the stock as low once OnHand drops to it
+	LowStockThreshold sql.NullInt64 `db:"low_stock_threshold"`
+	UpdatedAt         sql.NullTime  `db:"updated_at"`
+}
+
+// IsLow reports whether the stock dropped to its low stock threshold
+func (l *StockLevel) IsLow() bool {
+	return l.LowStockThreshold.Valid && l.OnHand <= l.LowStockThreshold.Int64
+}
+
+// StockTransfer moves stock from one outlet of a merchant to another
+type StockTransfer struct {
+	ID           uuid.UUID     `db:"id"`
+	MerchantID   uuid.UUID     `db:"merchant_id"`
+	FromOutletID uuid.UUID     `db:"from_outlet_id"`
+	ToOutletID   uuid.UUID     `db:"to_outlet_id"`
+	Note         string        `db:"note"`
+	CreatedBy    uuid.NullUUID `db:"created_by"`
+	CreatedAt    time.Time     `db:"created_at"`
+
+	Items []*StockItem `db:"-"`
+}
+
+// StockItem is a quantity of a variant, used by requests changing the stock
+// of several variants at once
+type StockItem struct {
+	VariantID uuid.UUID `db:"variant_id"`
+	Quantity  int64     `db:"quantity"`
+}
+
+// StockLevelFilter narrows down a stock listing
+type StockLevelFilter struct {
+	// VariantIDs limits the listing to the variants, empty lists every variant
+	VariantIDs []uuid.UUID
+	// LowStockOnly lists the variants that dropped to their threshold
+	LowStockOnly bool
+}
+
+// StockMovementPage is one page of the stock ledger
+type StockMovementPage struct {
+	Movements []*StockMovement
+	// NextCursor is empty on the last page
+	NextCursor string
+}
+
+// InsufficientStockError is returned when a movement would make the stock of
+// an outlet that does not allow negative stock drop below zero
+type InsufficientStockError struct {
+	OutletID  uuid.UUID
+	VariantID uuid.UUID
+	OnHand    int64
+	Requested int64
+}
+
+// Error implements the error interface
+func (e *InsufficientStockError) Error() string {
+	return fmt.Sprintf("insufficient stock of variant %s: %d on hand, %d requested", e.VariantID, e.OnHand, e.Requested)
+}
+
+// InventoryRepository represents the inventory repository contract, every
+// query is scoped to a merchant
+type InventoryRepository interface {
+	// RecordMovements appends the movements to the ledger and updates the
+	// stock levels in one transaction. Stock levels are locked while they
+	// change, a movement that would make the stock negative at an outlet that
+	// does not allow it fails with *InsufficientStockError. Stock takes carry
+	// the counted quantity in BalanceAfter, their Quantity is filled in.
+	RecordMovements(ctx context.Context, movements []*StockMovement) error
+	// CreateTransfer stores the transfer and records its movements in one transaction
+	CreateTransfer(ctx context.Context, transfer *StockTransfer, movements []*StockMovement) error
+	ListLevels(ctx context.Context, merchantID, outletID uuid.UUID, filter StockLevelFilter) ([]*StockLevel, error)
+	// SetLowStockThreshold sets the threshold of a variant at an outlet, nil removes it
+	SetLowStockThreshold(ctx context.Context, merchantID, outletID, variantID uuid.UUID, threshold *int64) error
+	// ListMovements lists the ledger of an outlet newest first, optionally of
+	// one variant, starting after the cursor
+	ListMovements(ctx context.Context, merchantID, outletID, variantID uuid.UUID, after *PageCursor, limit int) ([]*StockMovement, error)
+}
+
+// InventoryUseCase represents the inventory use case contract, every method
+// acts on the outlet of the tenant
+type InventoryUseCase interface {
+	ListStock(ctx context.Context, filter StockLevelFilter) ([]*StockLevel, error)
+	// AdjustStock changes the stock of a variant by quantity, which is negative
+	// for stock leaving the outlet
+	AdjustStock(ctx context.Context, variantID uuid.UUID, quantity int64, note string) (*StockMovement, error)
+	ReceiveStock(ctx context.Context, items []*StockItem, note string) ([]*StockMovement, error)
+	// StockTake sets the stock of the variants to the counted quantities
+	StockTake(ctx context.Context, items []*StockItem, note string) ([]*StockMovement, error)
+	TransferStock(ctx context.Context, toOutletID uuid.UUID, items []*StockItem, note string) (*StockTransfer, error)
+	SetLowStockThreshold(ctx context.Context, variantID uuid.UUID, threshold *int64) error
+	ListMovements(ctx context.Context, variantID uuid.UUID, pageSize int, cursor string) (*StockMovementPage, error)
+}
@@ -0,0 +1,239 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Customer is a member of the loyalty program of a merchant, known by their
+// WhatsApp number. A customer is linked to the user account registered with
+// the same verified WhatsApp number.
+type Customer struct {
+	ID             uuid.UUID     `db:"id"`
+	MerchantID     uuid.UUID     `db:"merchant_id"`
+	UserID         uuid.NullUUID `db:"user_id"`
+	Name           string        `db:"name"`
+	WhatsAppNumber string        `db:"whatsapp_number"`
+	Email          string        `db:"email"`
+	PointsBalance  int64         `db:"points_balance"`
+	// TotalSpend and VisitCount sum up the completed sales of the customer
+	// after refunds, the tier is reached by TotalSpend
+	TotalSpend     int64        `db:"total_spend"`
+	VisitCount     int64        `db:"visit_count"`
+	LastPurchaseAt sql.NullTime `db:"last_purchase_at"`
+	CreatedAt      time.Time    `db:"created_at"`
+	UpdatedAt      sql.NullTime `db:"updated_at"`
+
+	// Tier is the tier of the customer, nil below the lowest tier
+	Tier *LoyaltyTier `db:"-"`
+}
+
+// LoyaltyProgram holds the points rules of a merchant. A sale earns
+// EarnPoints for every EarnSpend of its total, times the multiplier of the
+// tier of the customer. A point pays PointValue off a later sale.
+type LoyaltyProgram struct {
+	MerchantID uuid.UUID `db:"merchant_id"`
+	IsActive   bool      `db:"is_active"`
+	EarnSpend  int64     `db:"earn_spend"`
+	EarnPoints int64     `db:"earn_points"`
+	PointValue int64     `db:"point_value"`
+	// MinRedeemPoints is the fewest points redeemed at once, MaxRedeemPercent
+	// the largest part of a sale after discounts paid with points
+	MinRedeemPoints  int64           `db:"min_redeem_points"`
+	MaxRedeemPercent decimal.Decimal `db:"max_redeem_percent"`
+	// ExpiryDays is the number of days points stay valid, zero never expires
+	ExpiryDays int          `db:"expiry_days"`
+	CreatedAt  time.Time    `db:"created_at"`
+	UpdatedAt  sql.NullTime `db:"updated_at"`
+
+	// Tiers are ordered by their minimum spend
+	Tiers []*LoyaltyTier `db:"-"`
+}
+
+// LoyaltyTier is a membership level reached by a total spend
+type LoyaltyTier struct {
+	ID         uuid.UUID `db:"id"`
+	MerchantID uuid.UUID `db:"merchant_id"`
+	Name       string    `db:"name"`
+	MinSpend   int64     `db:"min_spend"`
+	// Multiplier multiplies the points earned, at least 1
+	Multiplier decimal.Decimal `db:"multiplier"`
+}
+
+// TierFor returns the highest tier reached by a total spend, nil when none is
+func (p *LoyaltyProgram) TierFor(totalSpend int64) *LoyaltyTier {
+	var tier *LoyaltyTier
+	for _, t := range p.Tiers {
+		if t.MinSpend <= totalSpend && (tier == nil || t.MinSpend > tier.MinSpend) {
+			tier = t
+		}
+	}
+	return tier
+}
+
+// SortTiers orders the tiers by their minimum spend
+func (p *LoyaltyProgram) SortTiers() {
+	sort.Slice(p.Tiers, func(i, j int) bool { return p.Tiers[i].MinSpend < p.Tiers[j].MinSpend })
+}
+
+// EarnedPoints returns the points a sale of amount earns a customer of the
+// tier, rounded down
+func (p *LoyaltyProgram) EarnedPoints(amount int64, tier *LoyaltyTier) int64 {
+	if !p.IsActive || amount <= 0 {
+		return 0
+	}
+	points := decimal.NewFromInt(amount / p.EarnSpend * p.EarnPoints)
+	if tier != nil {
+		points = points.Mul(tier.Multiplier)
+	}
+	return points.Floor().IntPart()
+}
+
+// MaxRedeemAmount returns the most of an order paid with points, a part of
+// what is left after the other discounts
+func (p *LoyaltyProgram) MaxRedeemAmount(order *Order) int64 {
+	return percentOf(order.Subtotal-order.DiscountTotal+order.PointsDiscount, p.MaxRedeemPercent)
+}
+
+// ExpiresAt returns the time points earned at a time expire, invalid when
+// points never expire
+func (p *LoyaltyProgram) ExpiresAt(earnedAt time.Time) sql.NullTime {
+	if p.ExpiryDays == 0 {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: earnedAt.AddDate(0, 0, p.ExpiryDays), Valid: true}
+}
+
+// PointEntryType is the reason of a change of the points of a customer
+type PointEntryType string
+
+const (
+	// PointEntryEarn is points earned by a sale
+	PointEntryEarn PointEntryType = "earn"
+	// PointEntryRedeem is points paying for a sale
+	PointEntryRedeem PointEntryType = "redeem"
+	// PointEntryExpire is earned points that were not redeemed in time
+	PointEntryExpire PointEntryType = "expire"
+	// PointEntryVoid gives back the points redeemed by a voided sale and takes
+	// back the points it earned, as far as they were not spent
+	PointEntryVoid PointEntryType = "void"
+	// PointEntryRefund gives back the share of the points redeemed by a sale
+	// and takes back the share it earned for a refund of a part of it, as far
+	// as they were not spent
+	PointEntryRefund PointEntryType = "refund"
+	// PointEntryAdjust is a manual correction
+	PointEntryAdjust PointEntryType = "adjust"
+)
+
+// PointEntry is a line of the points ledger of a customer. Points added are
+// spent and expire in the order of their expiry, Remaining is the part of an
+// entry adding points that is neither spent nor expired.
+type PointEntry struct {
+	ID         uuid.UUID      `db:"id"`
+	MerchantID uuid.UUID      `db:"merchant_id"`
+	CustomerID uuid.UUID      `db:"customer_id"`
+	OrderID    uuid.NullUUID  `db:"order_id"`
+	Type       PointEntryType `db:"type"`
+	// Points is the change of the balance, negative when points are taken
+	Points       int64         `db:"points"`
+	BalanceAfter int64         `db:"balance_after"`
+	Remaining    int64         `db:"remaining"`
+	ExpiresAt    sql.NullTime  `db:"expires_at"`
+	Note         string        `db:"note"`
+	CreatedBy    uuid.NullUUID `db:"created_by"`
+	CreatedAt    time.Time     `db:"created_at"`
+}
+
+// CustomerPage is one page of customers
+type CustomerPage struct {
+	Customers []*Customer
+	// NextCursor is empty on the last page
+	NextCursor string
+}
+
+// PointEntryPage is one page of the points ledger of a customer
+type PointEntryPage struct {
+	Entries []*PointEntry
+	// NextCursor is empty on the last page
+	NextCursor string
+}
+
+var (
+	// ErrCustomerExists is returned when a customer with the same WhatsApp
+	// number already exists for the merchant
+	ErrCustomerExists = errors.New("customer already exists")
+	// ErrInsufficientPoints is returned when a customer has fewer points than
+	// are taken
+	ErrInsufficientPoints = errors.New("insufficient points")
+)
+
+// LoyaltyRepository represents the customer and points repository contract,
+// every query is scoped to a merchant. Customers are returned with their
+// spend and visits.
+type LoyaltyRepository interface {
+	// GetProgram retrieves the program of a merchant with its tiers, nil when
+	// it was never saved
+	GetProgram(ctx context.Context, merchantID uuid.UUID) (*LoyaltyProgram, error)
+	// SaveProgram stores the program and replaces its tiers
+	SaveProgram(ctx context.Context, program *LoyaltyProgram) error
+	// CreateCustomer stores a new customer, it fails with ErrCustomerExists
+	// when the WhatsApp number is taken
+	CreateCustomer(ctx context.Context, customer *Customer) error
+	GetCustomer(ctx context.Context, merchantID, id uuid.UUID) (*Customer, error)
+	GetCustomerByWhatsApp(ctx context.Context, merchantID uuid.UUID, whatsAppNumber string) (*Customer, error)
+	// ListCustomers lists the customers of a merchant newest first, starting
+	// after the cursor. A search matches the name or the WhatsApp number.
+	ListCustomers(ctx context.Context, merchantID uuid.UUID, search string, after *PageCursor, limit int) ([]*Customer, error)
+	// UpdateCustomer stores the name, the WhatsApp number, the email and the
+	// user of a customer, it fails with ErrCustomerExists when the WhatsApp
+	// number is taken
+	UpdateCustomer(ctx context.Context, customer *Customer) error
+	// LinkUser links the unlinked customers of every merchant with the
+	// WhatsApp number to a user
+	LinkUser(ctx context.Context, userID uuid.UUID, whatsAppNumber string) error
+	// ListCustomersByUser lists the customers linked to a user across merchants
+	ListCustomersByUser(ctx context.Context, userID uuid.UUID) ([]*Customer, error)
+	// ExpirePoints records the expiry of the points of a customer due at a time
+	ExpirePoints(ctx context.Context, merchantID, customerID uuid.UUID, at time.Time) error
+	// AdjustPoints records a manual entry, it fails with ErrInsufficientPoints
+	// when it takes more points than the customer has
+	AdjustPoints(ctx context.Context, entry *PointEntry) error
+	// ListPointEntries lists the points ledger of a customer newest first,
+	// starting after the cursor
+	ListPointEntries(ctx context.Context, merchantID, customerID uuid.UUID, after *PageCursor, limit int) ([]*PointEntry, error)
+}
+
+// LoyaltyUseCase represents the loyalty use case contract, every method acts
+// on the merchant of the tenant unless noted otherwise
+type LoyaltyUseCase interface {
+	GetProgram(ctx context.Context) (*LoyaltyProgram, error)
+	// UpdateProgram replaces the rules and the tiers of the program
+	UpdateProgram(ctx context.Context, program *LoyaltyProgram) (*LoyaltyProgram, error)
+	// CreateCustomer registers a customer, linked to the user with the same
+	// verified WhatsApp number
+	CreateCustomer(ctx context.Context, customer *Customer) (*Customer, error)
+	GetCustomer(ctx context.Context, id uuid.UUID) (*Customer, error)
+	FindCustomer(ctx context.Context, whatsAppNumber string) (*Customer, error)
+	ListCustomers(ctx context.Context, search string, pageSize int, cursor string) (*CustomerPage, error)
+	UpdateCustomer(ctx context.Context, customer *Customer) (*Customer, error)
+	// ListPurchases lists the completed and voided sales of a customer
+	// across the outlets of the merchant
+	ListPurchases(ctx context.Context, customerID uuid.UUID, pageSize int, cursor string) (*OrderPage, error)
+	ListPointEntries(ctx context.Context, customerID uuid.UUID, pageSize int, cursor string) (*PointEntryPage, error)
+	AdjustPoints(ctx context.Context, customerID uuid.UUID, points int64, note string) (*Customer, error)
+	// AttachCustomer sets the customer of a cart of the outlet of the tenant,
+	// found by WhatsApp number, an empty number detaches the customer
+	AttachCustomer(ctx context.Context, orderID uuid.UUID, whatsAppNumber string) (*Order, error)
+	// RedeemPoints pays part of a cart of the outlet of the tenant with
+	// points of its customer, taken at checkout. Zero points cancel the
+	// redemption, changing the cart cancels it as well.
+	RedeemPoints(ctx context.Context, orderID uuid.UUID, points int64) (*Order, error)
+	// ListMemberships lists the customers linked to the caller at every merchant
+	ListMemberships(ctx context.Context) ([]*Customer, error)
+}
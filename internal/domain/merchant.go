@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Merchant represents a business using the POS, the tenant all of its data belongs to
+type Merchant struct {
+	ID          uuid.UUID    `db:"id"`
+	Name        string       `db:"name"`
+	OwnerUserID uuid.UUID    `db:"owner_user_id"`
+	CreatedAt   time.Time    `db:"created_at"`
+	UpdatedAt   sql.NullTime `db:"updated_at"`
+}
+
+// Outlet represents a store of a merchant
+type Outlet struct {
+	ID          uuid.UUID `db:"id"`
+	MerchantID  uuid.UUID `db:"merchant_id"`
+	Name        string    `db:"name"`
+	Address     string    `db:"address"`
+	PhoneNumber string    `db:"phone_number"`
+	IsActive    bool      `db:"is_active"`
+	// AllowNegativeStock lets sales go ahead when the stock of the outlet runs out
+	AllowNegativeStock bool `db:"allow_negative_stock"`
+	// Timezone is the IANA time zone of the outlet, promotion schedules run
+	// on its local time
+	Timezone  string       `db:"timezone"`
+	CreatedAt time.Time    `db:"created_at"`
+	UpdatedAt sql.NullTime `db:"updated_at"`
+}
+
+// DefaultTimezone is the time zone of outlets created without one
+const DefaultTimezone = "Asia/Jakarta"
+
+// Location returns the time zone of the outlet
+func (o *Outlet) Location() (*time.Location, error) {
+	return time.LoadLocation(o.Timezone)
+}
+
+// MerchantMember links a user to a merchant with a role within that merchant
+type MerchantMember struct {
+	MerchantID uuid.UUID `db:"merchant_id"`
+	UserID     uuid.UUID `db:"user_id"`
+	Role       string    `db:"role"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+// MerchantRepository represents the merchant repository contract
+type MerchantRepository interface {
+	// Create stores the merchant and makes its owner a member with the owner role
+	Create(ctx context.Context, merchant *Merchant) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Merchant, error)
+	// ListByUserID returns the merchants the user is a member of
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]*Merchant, error)
+	GetMember(ctx context.Context, merchantID, userID uuid.UUID) (*MerchantMember, error)
+	// SaveMember adds the user to the merchant or changes the role of an existing member
+	SaveMember(ctx context.Context, member *MerchantMember) error
+}
+
+// OutletRepository represents the outlet repository contract, every query is
+// scoped to a merchant
+type OutletRepository interface {
+	Create(ctx context.Context, outlet *Outlet) error
+	GetByID(ctx context.Context, merchantID, id uuid.UUID) (*Outlet, error)
+	ListByMerchantID(ctx context.Context, merchantID uuid.UUID) ([]*Outlet, error)
+	AddStaff(ctx context.Context, outletID, userID uuid.UUID) error
+	IsStaff(ctx context.Context, outletID, userID uuid.UUID) (bool, error)
+}
+
+// MerchantUseCase represents the merchant use case contract. Except for
+// CreateMerchant and ListMyMerchants every method acts on the tenant of the request.
+type MerchantUseCase interface {
+	TenantResolver
+
+	// CreateMerchant creates a merchant owned by the caller
+	CreateMerchant(ctx context.Context, name string) (*Merchant, error)
+	ListMyMerchants(ctx context.Context) ([]*Merchant, error)
+	GetMerchant(ctx context.Context) (*Merchant, error)
+	AddOutlet(ctx context.Context, outlet *Outlet) (*Outlet, error)
+	ListOutlets(ctx context.Context) ([]*Outlet, error)
+	// InviteStaff adds the user with the given email or WhatsApp number to the
+	// merchant with the role and assigns the user to the outlet
+	InviteStaff(ctx context.Context, outletID uuid.UUID, identifier, role string) (*MerchantMember, error)
+}
@@ -0,0 +1,308 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// OrderStatus is the state of an order
+type OrderStatus string
+
+const (
+	// OrderStatusOpen is a cart the cashier is still adding items to
+	OrderStatusOpen OrderStatus = "open"
+	// OrderStatusHeld is a cart parked aside to serve another customer
+	OrderStatusHeld OrderStatus = "held"
+	// OrderStatusCompleted is a paid sale
+	OrderStatusCompleted OrderStatus = "completed"
+	// OrderStatusVoided is a cancelled cart or sale
+	OrderStatusVoided OrderStatus = "voided"
+)
+
+// DiscountType tells how the value of a discount is applied
+type DiscountType string
+
+const (
+	// DiscountTypeAmount takes a fixed amount off
+	DiscountTypeAmount DiscountType = "amount"
+	// DiscountTypePercent takes a percentage off
+	DiscountTypePercent DiscountType = "percent"
+)
+
+// Discount is a manual discount on an order item or on a whole order, the
+// zero value is no discount
+type Discount struct {
+	Type DiscountType
+	// Value is an amount in the smallest unit of the currency or a percentage
+	Value decimal.Decimal
+}
+
+// discountAmount returns the discount taken off base, never more than base
+func discountAmount(discountType DiscountType, value decimal.Decimal, base int64) int64 {
+	var amount int64
+	switch discountType {
+	case DiscountTypeAmount:
+		amount = value.Round(0).IntPart()
+	case DiscountTypePercent:
+		amount = percentOf(base, value)
+	}
+	if amount > base {
+		return base
+	}
+	if amount < 0 {
+		return 0
+	}
+	return amount
+}
+
+// percentOf returns percent percent of amount rounded half up to a whole amount
+func percentOf(amount int64, percent decimal.Decimal) int64 {
+	return decimal.NewFromInt(amount).Mul(percent).Div(decimal.NewFromInt(100)).Round(0).IntPart()
+}
+
+// shareOf returns part/whole of amount rounded half up to a whole amount
+func shareOf(amount, part, whole int64) int64 {
+	if whole == 0 {
+		return 0
+	}
+	return decimal.NewFromInt(amount).Mul(decimal.NewFromInt(part)).Div(decimal.NewFromInt(whole)).Round(0).IntPart()
+}
+
+// Order is a sale at an outlet. It starts as a cart and becomes a sale at
+// checkout. Amounts are in the smallest unit of the currency.
+type Order struct {
+	ID         uuid.UUID `db:"id"`
+	MerchantID uuid.UUID `db:"merchant_id"`
+	OutletID   uuid.UUID `db:"outlet_id"`
+	CashierID  uuid.UUID `db:"cashier_id"`
+	// ShiftID is the shift the sale was checked out in
+	ShiftID uuid.NullUUID `db:"shift_id"`
+	Status  OrderStatus   `db:"status"`
+	// Number is the sequence number of the sale at the outlet, assigned at checkout
+	Number sql.NullInt64 `db:"number"`
+	Note   string        `db:"note"`
+	// The order discount is taken off after the item discounts and the promotions
+	DiscountType  DiscountType    `db:"discount_type"`
+	DiscountValue decimal.Decimal `db:"discount_value"`
+	// VoucherID is the voucher entered for the cart, redeemed at checkout
+	VoucherID   uuid.NullUUID `db:"voucher_id"`
+	VoucherCode string        `db:"voucher_code"`
+	// CustomerID is the loyalty member buying, PointsRedeemed the points they
+	// pay PointsDiscount with, taken off last, and PointsEarned the points
+	// the sale earns them at checkout
+	CustomerID     uuid.NullUUID `db:"customer_id"`
+	PointsRedeemed int64         `db:"points_redeemed"`
+	PointsDiscount int64         `db:"points_discount"`
+	PointsEarned   int64         `db:"points_earned"`
+
+	Subtotal int64 `db:"subtotal"`
+	// DiscountTotal covers the manual discounts, the promotions and the
+	// redeemed points, PromotionTotal the promotions alone
+	DiscountTotal      int64 `db:"discount_total"`
+	PromotionTotal     int64 `db:"promotion_total"`
+	ServiceChargeTotal int64 `db:"service_charge_total"`
+	// TaxTotal is the VAT of the order, for inclusive VAT the part of the
+	// total that is tax
+	TaxTotal int64 `db:"tax_total"`
+	Total    int64 `db:"total"`
+	// RefundedTotal is the part of the total given back by refunds
+	RefundedTotal int64 `db:"refunded_total"`
+
+	// IdempotencyKey is the key the client sent with the checkout
+	IdempotencyKey sql.NullString `db:"idempotency_key"`
+	VoidReason     string         `db:"void_reason"`
+	// Version changes on every update, updates of a stale order are rejected
+	Version     int64        `db:"version"`
+	CreatedAt   time.Time    `db:"created_at"`
+	UpdatedAt   sql.NullTime `db:"updated_at"`
+	CompletedAt sql.NullTime `db:"completed_at"`
+	VoidedAt    sql.NullTime `db:"voided_at"`
+
+	Items    []*OrderItem `db:"-"`
+	Payments []*Payment   `db:"-"`
+	// Taxes are the tax rules applied to the order with their totals
+	Taxes []*OrderTax `db:"-"`
+	// Promotions are the discounts of promotions, item by item
+	Promotions []*OrderPromotion `db:"-"`
+}
+
+// OrderItem is a line of an order. Product details are copied into the item
+// so later catalog changes leave the order untouched. RefundedQuantity counts
+// the units given back by refunds.
+type OrderItem struct {
+	ID            uuid.UUID       `db:"id"`
+	OrderID       uuid.UUID       `db:"order_id"`
+	ProductID     uuid.UUID       `db:"product_id"`
+	VariantID     uuid.UUID       `db:"variant_id"`
+	CategoryID    uuid.NullUUID   `db:"category_id"`
+	SKU           string          `db:"sku"`
+	Name          string          `db:"name"`
+	Unit          string          `db:"unit"`
+	Quantity      int64           `db:"quantity"`
+	UnitPrice     int64           `db:"unit_price"`
+	DiscountType  DiscountType    `db:"discount_type"`
+	DiscountValue decimal.Decimal `db:"discount_value"`
+	// TaxExempt items are charged no VAT
+	TaxExempt bool `db:"tax_exempt"`
+	// Subtotal is the unit price times the quantity and Total the subtotal
+	// minus the manual item discount and the promotions of the item
+	Subtotal          int64 `db:"subtotal"`
+	DiscountAmount    int64 `db:"discount_amount"`
+	PromotionDiscount int64 `db:"promotion_discount"`
+	Total             int64 `db:"total"`
+	// OrderDiscount is the share of the order discount taken off the item
+	OrderDiscount int64 `db:"order_discount"`
+	ServiceCharge int64 `db:"service_charge"`
+	// TaxBase is the amount VAT is levied on and TaxAmount the VAT
+	TaxBase   int64 `db:"tax_base"`
+	TaxAmount int64 `db:"tax_amount"`
+	// GrandTotal is what the customer pays for the item with service charge
+	// and VAT, the grand totals add up to the total of the order
+	GrandTotal       int64     `db:"grand_total"`
+	RefundedQuantity int64     `db:"refunded_quantity"`
+	CreatedAt        time.Time `db:"created_at"`
+}
+
+// Recalculate computes the amounts of the items and of the order with the
+// promotions last applied. Item discounts and item promotions come first,
+// order promotions and then the manual order discount are taken off the
+// remaining subtotal and split across the items by their totals, then the
+// taxes of the order are calculated per item. Every amount is rounded half up
+// to a whole amount once, so the totals always add up.
+func (o *Order) Recalculate() {
+	var subtotal, itemDiscounts int64
+	totals := make([]int64, len(o.Items))
+	for i, item := range o.Items {
+		item.Subtotal = item.UnitPrice * item.Quantity
+		item.DiscountAmount = discountAmount(item.DiscountType, item.DiscountValue, item.Subtotal)
+		item.Total = item.Subtotal - item.DiscountAmount - item.PromotionDiscount
+
+		subtotal += item.Subtotal
+		itemDiscounts += item.DiscountAmount + item.PromotionDiscount
+		totals[i] = item.Total
+	}
+
+	var promotions, orderPromotions int64
+	for _, p := range o.Promotions {
+		promotions += p.Amount
+		if !p.ItemID.Valid {
+			orderPromotions += p.Amount
+		}
+	}
+	remaining := subtotal - itemDiscounts
+	orderDiscount := orderPromotions + discountAmount(o.DiscountType, o.DiscountValue, remaining-orderPromotions)
+	orderDiscount += min(o.PointsDiscount, remaining-orderDiscount)
+	// The items cannot take more than their totals, what they take is the discount
+	shares := allocate(orderDiscount, totals)
+	orderDiscount = 0
+	for i, share := range shares {
+		o.Items[i].OrderDiscount = share
+		orderDiscount += share
+	}
+	calculateTaxes(o.Items, o.Taxes)
+
+	o.Subtotal = subtotal
+	o.DiscountTotal = itemDiscounts + orderDiscount
+	o.PromotionTotal = promotions
+	o.ServiceChargeTotal, o.TaxTotal, o.Total = 0, 0, 0
+	for _, item := range o.Items {
+		o.ServiceChargeTotal += item.ServiceCharge
+		o.TaxTotal += item.TaxAmount
+		o.Total += item.GrandTotal
+	}
+}
+
+// IsEditable reports whether items and discounts of the order can still change
+func (o *Order) IsEditable() bool {
+	return o.Status == OrderStatusOpen
+}
+
+// OrderFilter narrows down an order listing, zero values leave the
+// corresponding field unfiltered
+type OrderFilter struct {
+	Status    OrderStatus
+	CashierID uuid.UUID
+}
+
+// OrderPage is one page of orders
+type OrderPage struct {
+	Orders []*Order
+	// NextCursor is empty on the last page
+	NextCursor string
+}
+
+var (
+	// ErrOrderChanged is returned when an order was changed since it was read
+	ErrOrderChanged = errors.New("order was changed by another request")
+	// ErrDuplicateIdempotencyKey is returned when the idempotency key of a
+	// checkout was already used for another order
+	ErrDuplicateIdempotencyKey = errors.New("idempotency key was already used")
+)
+
+// OrderRepository represents the order repository contract, every query is
+// scoped to a merchant. Orders are returned with their items and payments.
+type OrderRepository interface {
+	Create(ctx context.Context, order *Order) error
+	GetByID(ctx context.Context, merchantID, id uuid.UUID) (*Order, error)
+	GetByIdempotencyKey(ctx context.Context, merchantID uuid.UUID, key string) (*Order, error)
+	// List lists the orders of an outlet newest first, starting after the cursor
+	List(ctx context.Context, merchantID, outletID uuid.UUID, filter OrderFilter, after *PageCursor, limit int) ([]*Order, error)
+	// ListByCustomer lists the completed and voided sales of a customer at
+	// every outlet newest first, starting after the cursor
+	ListByCustomer(ctx context.Context, merchantID, customerID uuid.UUID, after *PageCursor, limit int) ([]*Order, error)
+	// Update stores the order with its items and increments its version. It
+	// fails with ErrOrderChanged when the stored version differs.
+	Update(ctx context.Context, order *Order) error
+	// Checkout completes the order in one transaction: it assigns the order
+	// number, stores the order and the new payments, debits its store credit
+	// payments and records the stock movements, the points entries of its
+	// customer and the cash received in the shift of the order, cash is nil
+	// without cash payments. It fails
+	// with ErrOrderChanged when the stored version differs, with
+	// ErrDuplicateIdempotencyKey when the key is taken, with
+	// ErrPaymentChanged when the captured payments no longer pay the total or
+	// a payment is still pending, with ErrShiftClosed when the shift was
+	// closed, with ErrInsufficientPoints when the customer no longer has
+	// the points redeemed and with ErrInsufficientStoreCredit when a store
+	// credit does not cover its payment.
+	Checkout(ctx context.Context, order *Order, payments []*Payment, movements []*StockMovement, points []*PointEntry, cash *CashMovement) error
+	// Void stores the voided order, credits its store credit payments back,
+	// releases its voucher and the uses of its promotions and records the
+	// stock movements, the points entries and the cash given back in one
+	// transaction, its payments are settled beforehand
+	Void(ctx context.Context, order *Order, movements []*StockMovement, points []*PointEntry, cash *CashMovement) error
+}
+
+// OrderUseCase represents the order use case contract, every method acts on
+// the outlet of the tenant
+type OrderUseCase interface {
+	CreateOrder(ctx context.Context, note string) (*Order, error)
+	GetOrder(ctx context.Context, id uuid.UUID) (*Order, error)
+	ListOrders(ctx context.Context, filter OrderFilter, pageSize int, cursor string) (*OrderPage, error)
+	// AddItem adds a variant to the cart at the price of the outlet, adding
+	// to the quantity when the variant is already in the cart without a discount
+	AddItem(ctx context.Context, orderID, variantID uuid.UUID, quantity int64, discount Discount) (*Order, error)
+	// UpdateItem changes the quantity and the discount of an item
+	UpdateItem(ctx context.Context, orderID, itemID uuid.UUID, quantity int64, discount Discount) (*Order, error)
+	RemoveItem(ctx context.Context, orderID, itemID uuid.UUID) (*Order, error)
+	SetDiscount(ctx context.Context, orderID uuid.UUID, discount Discount) (*Order, error)
+	// ApplyVoucher attaches the unredeemed voucher with a code to a cart, the
+	// voucher is redeemed at checkout
+	ApplyVoucher(ctx context.Context, orderID uuid.UUID, code string) (*Order, error)
+	RemoveVoucher(ctx context.Context, orderID uuid.UUID) (*Order, error)
+	HoldOrder(ctx context.Context, orderID uuid.UUID, note string) (*Order, error)
+	ResumeOrder(ctx context.Context, orderID uuid.UUID) (*Order, error)
+	// Checkout pays and completes the order with the payments added before
+	// and the given payments, which must be settled at once, e.g. cash. The
+	// sale is attributed to the open shift of the caller, which is required.
+	// Repeating a checkout with the same idempotency key returns the completed order.
+	Checkout(ctx context.Context, orderID uuid.UUID, payments []*Payment, idempotencyKey string) (*Order, error)
+	// VoidOrder cancels a cart or a completed sale without refunds. Captured
+	// payments are refunded and the stock of a sale is returned.
+	VoidOrder(ctx context.Context, orderID uuid.UUID, reason string) (*Order, error)
+}
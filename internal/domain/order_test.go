@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestOrderRecalculate(t *testing.T) {
+	value := func(v string) decimal.Decimal { return decimal.RequireFromString(v) }
+	serviceCharge := &TaxRule{Type: TaxTypeServiceCharge, Name: "Service", Rate: value("5")}
+	exclusiveVAT := &TaxRule{Type: TaxTypeVAT, Name: "PPN", Rate: value("11")}
+	inclusiveVAT := &TaxRule{Type: TaxTypeVAT, Name: "PPN", Rate: value("11"), Inclusive: true}
+
+	type totals struct {
+		subtotal, discountTotal, promotionTotal, serviceChargeTotal, taxTotal, total int64
+	}
+	tests := []struct {
+		name  string
+		order *Order
+		rules []*TaxRule
+		want  totals
+		// wantOrderDiscounts are the shares of the order discount of the items
+		wantOrderDiscounts []int64
+	}{
+		{
+			name: "item discounts",
+			order: &Order{Items: []*OrderItem{
+				{UnitPrice: 10000, Quantity: 2, DiscountType: DiscountTypePercent, DiscountValue: value("10")},
+				{UnitPrice: 5000, Quantity: 1, DiscountType: DiscountTypeAmount, DiscountValue: value("7000")},
+			}},
+			want:               totals{subtotal: 25000, discountTotal: 7000, total: 18000},
+			wantOrderDiscounts: []int64{0, 0},
+		},
+		{
+			name: "order discount split by item totals",
+			order: &Order{
+				DiscountType:  DiscountTypeAmount,
+				DiscountValue: value("4000"),
+				Items:         []*OrderItem{{UnitPrice: 30000, Quantity: 1}, {UnitPrice: 10000, Quantity: 1}},
+			},
+			want:               totals{subtotal: 40000, discountTotal: 4000, total: 36000},
+			wantOrderDiscounts: []int64{3000, 1000},
+		},
+		{
+			name: "order discount with a zero-priced line",
+			order: &Order{
+				DiscountType:  DiscountTypePercent,
+				DiscountValue: value("10"),
+				Items:         []*OrderItem{{UnitPrice: 0, Quantity: 1}, {UnitPrice: 10000, Quantity: 1}},
+			},
+			want:               totals{subtotal: 10000, discountTotal: 1000, total: 9000},
+			wantOrderDiscounts: []int64{0, 1000},
+		},
+		{
+			name: "order discount above the sale is capped",
+			order: &Order{
+				DiscountType:  DiscountTypeAmount,
+				DiscountValue: value("8000"),
+				Items:         []*OrderItem{{UnitPrice: 5000, Quantity: 1}},
+			},
+			want:               totals{subtotal: 5000, discountTotal: 5000, total: 0},
+			wantOrderDiscounts: []int64{5000},
+		},
+		{
+			name: "points are taken off last",
+			order: &Order{
+				DiscountType:   DiscountTypePercent,
+				DiscountValue:  value("10"),
+				PointsDiscount: 500,
+				Items:          []*OrderItem{{UnitPrice: 10000, Quantity: 1}},
+			},
+			want:               totals{subtotal: 10000, discountTotal: 1500, total: 8500},
+			wantOrderDiscounts: []int64{1500},
+		},
+		{
+			name: "points are capped at what is left",
+			order: &Order{
+				PointsDiscount: 20000,
+				Items:          []*OrderItem{{UnitPrice: 10000, Quantity: 1}},
+			},
+			want:               totals{subtotal: 10000, discountTotal: 10000, total: 0},
+			wantOrderDiscounts: []int64{10000},
+		},
+		{
+			name: "promotions",
+			order: &Order{
+				Items: []*OrderItem{{UnitPrice: 20000, Quantity: 2, PromotionDiscount: 4000}},
+				Promotions: []*OrderPromotion{
+					{ItemID: uuid.NullUUID{UUID: uuid.New(), Valid: true}, Amount: 4000},
+					{Amount: 3600},
+				},
+			},
+			want:               totals{subtotal: 40000, discountTotal: 7600, promotionTotal: 7600, total: 32400},
+			wantOrderDiscounts: []int64{3600},
+		},
+		{
+			name: "service charge and exclusive VAT with an exempt item",
+			order: &Order{
+				DiscountType:  DiscountTypeAmount,
+				DiscountValue: value("12000"),
+				Items:         []*OrderItem{{UnitPrice: 100000, Quantity: 1}, {UnitPrice: 20000, Quantity: 1, TaxExempt: true}},
+			},
+			rules:              []*TaxRule{serviceCharge, exclusiveVAT},
+			want:               totals{subtotal: 120000, discountTotal: 12000, serviceChargeTotal: 5400, taxTotal: 10395, total: 123795},
+			wantOrderDiscounts: []int64{10000, 2000},
+		},
+		{
+			name:               "inclusive VAT",
+			order:              &Order{Items: []*OrderItem{{UnitPrice: 111000, Quantity: 1}}},
+			rules:              []*TaxRule{inclusiveVAT},
+			want:               totals{subtotal: 111000, taxTotal: 11000, total: 111000},
+			wantOrderDiscounts: []int64{0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := tt.order
+			o.Taxes = NewOrderTaxes(o.ID, tt.rules)
+			o.Recalculate()
+
+			got := totals{o.Subtotal, o.DiscountTotal, o.PromotionTotal, o.ServiceChargeTotal, o.TaxTotal, o.Total}
+			if got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+
+			var grandTotals int64
+			for i, item := range o.Items {
+				if item.OrderDiscount != tt.wantOrderDiscounts[i] {
+					t.Errorf("item %d order discount = %d, want %d", i, item.OrderDiscount, tt.wantOrderDiscounts[i])
+				}
+				grandTotals += item.GrandTotal
+			}
+			if grandTotals != o.Total {
+				t.Errorf("item grand totals add up to %d, order total is %d", grandTotals, o.Total)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PageCursor is the position after which the next page of a list starts,
+// lists paged by cursor are sorted from the newest to the oldest entry
+type PageCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
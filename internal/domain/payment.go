@@ -0,0 +1,178 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PaymentMethod is the tender a payment was made with
+type PaymentMethod string
+
+const (
+	// PaymentMethodCash is paid in cash at the till
+	PaymentMethodCash PaymentMethod = "cash"
+	// PaymentMethodCard is paid with a debit or credit card
+	PaymentMethodCard PaymentMethod = "card"
+	// PaymentMethodQRIS is paid by scanning a QRIS code
+	PaymentMethodQRIS PaymentMethod = "qris"
+	// PaymentMethodEWallet is paid from an e-wallet
+	PaymentMethodEWallet PaymentMethod = "ewallet"
+	// PaymentMethodStoreCredit is paid from the balance of a store credit,
+	// the reference is the code of the credit
+	PaymentMethodStoreCredit PaymentMethod = "store_credit"
+)
+
+// PaymentStatus is the state of a payment
+type PaymentStatus string
+
+const (
+	// PaymentStatusPending is waiting for the customer, e.g. to scan a QR code
+	PaymentStatusPending PaymentStatus = "pending"
+	// PaymentStatusAuthorized is approved by the gateway but not captured yet
+	PaymentStatusAuthorized PaymentStatus = "authorized"
+	// PaymentStatusCaptured is a payment that was received
+	PaymentStatusCaptured PaymentStatus = "captured"
+	// PaymentStatusFailed was declined or expired before it was paid
+	PaymentStatusFailed PaymentStatus = "failed"
+	// PaymentStatusVoided was cancelled before it was paid
+	PaymentStatusVoided PaymentStatus = "voided"
+	// PaymentStatusRefunded was captured and given back
+	PaymentStatusRefunded PaymentStatus = "refunded"
+)
+
+// IsOpen reports whether the payment still waits for the gateway
+func (s PaymentStatus) IsOpen() bool {
+	return s == PaymentStatusPending || s == PaymentStatusAuthorized
+}
+
+// Payment is a tender received for an order
+type Payment struct {
+	ID         uuid.UUID     `db:"id"`
+	OrderID    uuid.UUID     `db:"order_id"`
+	MerchantID uuid.UUID     `db:"merchant_id"`
+	Method     PaymentMethod `db:"method"`
+	Status     PaymentStatus `db:"status"`
+	// Amount is the part of the order total paid with this tender
+	Amount int64 `db:"amount"`
+	// RefundedAmount is the part of the amount given back by refunds of the order
+	RefundedAmount int64 `db:"refunded_amount"`
+	// Tendered is the cash handed over, Change is given back
+	Tendered int64 `db:"tendered"`
+	Change   int64 `db:"change_amount"`
+	// Reference is entered by the cashier, e.g. the approval code of a card
+	Reference string `db:"reference"`
+	// Provider is the name of the gateway that handled the payment and
+	// ProviderReference the ID of the payment there
+	Provider          string `db:"provider"`
+	ProviderReference string `db:"provider_reference"`
+	// QRString is the content of the QR code the customer scans to pay
+	QRString  string       `db:"qr_string"`
+	ExpiresAt sql.NullTime `db:"expires_at"`
+	CreatedAt time.Time    `db:"created_at"`
+	UpdatedAt sql.NullTime `db:"updated_at"`
+}
+
+// CalculateChange computes the change of a cash payment from the tendered
+// cash, which defaults to the amount. Other tenders pay the amount exactly. It
+// reports false when the tendered cash does not cover the amount.
+func (p *Payment) CalculateChange() bool {
+	if p.Method != PaymentMethodCash {
+		p.Tendered = p.Amount
+		p.Change = 0
+		return true
+	}
+	if p.Tendered == 0 {
+		p.Tendered = p.Amount
+	}
+	if p.Tendered < p.Amount {
+		return false
+	}
+	p.Change = p.Tendered - p.Amount
+	return true
+}
+
+// PaymentRequest asks a gateway to collect a payment
+type PaymentRequest struct {
+	PaymentID uuid.UUID
+	OrderID   uuid.UUID
+	Method    PaymentMethod
+	Amount    int64
+}
+
+// PaymentResult is the state of a payment at a gateway
+type PaymentResult struct {
+	Status PaymentStatus
+	// Reference identifies the payment at the gateway
+	Reference string
+	QRString  string
+	ExpiresAt time.Time
+}
+
+// PaymentNotification is an asynchronous status update sent by a gateway
+type PaymentNotification struct {
+	Reference string
+	Status    PaymentStatus
+}
+
+// PaymentGateway collects payments through a payment provider. Gateways that
+// confirm payments asynchronously return pending payments from Authorize and
+// report the outcome through Status and notifications.
+type PaymentGateway interface {
+	// Name identifies the gateway in stored payments and webhook URLs
+	Name() string
+	Authorize(ctx context.Context, req *PaymentRequest) (*PaymentResult, error)
+	Capture(ctx context.Context, reference string, amount int64) (*PaymentResult, error)
+	Refund(ctx context.Context, reference string, amount int64) (*PaymentResult, error)
+	Status(ctx context.Context, reference string) (*PaymentResult, error)
+}
+
+// PaymentNotifier is implemented by gateways sending notifications to a webhook
+type PaymentNotifier interface {
+	// ParseNotification checks the signature of a notification and parses it
+	ParseNotification(payload []byte, signature string) (*PaymentNotification, error)
+}
+
+// PaymentGateways looks up the gateway of a payment
+type PaymentGateways interface {
+	ForMethod(method PaymentMethod) (PaymentGateway, bool)
+	ByName(name string) (PaymentGateway, bool)
+}
+
+var (
+	// ErrPaymentChanged is returned when a payment was changed since it was read
+	ErrPaymentChanged = errors.New("payment was changed by another request")
+	// ErrInvalidNotificationSignature is returned for a notification that was
+	// not signed by the gateway
+	ErrInvalidNotificationSignature = errors.New("invalid notification signature")
+)
+
+// PaymentRepository represents the payment repository contract
+type PaymentRepository interface {
+	Create(ctx context.Context, payment *Payment) error
+	GetByID(ctx context.Context, merchantID, id uuid.UUID) (*Payment, error)
+	// GetByProviderReference retrieves the payment of any merchant a gateway
+	// refers to, it serves notifications which carry no tenant
+	GetByProviderReference(ctx context.Context, provider, reference string) (*Payment, error)
+	// UpdateStatus stores the status of the payment when its stored status is
+	// still from, otherwise it fails with ErrPaymentChanged. The order of the
+	// payment is locked meanwhile so a checkout never sees half a change.
+	UpdateStatus(ctx context.Context, payment *Payment, from PaymentStatus) error
+}
+
+// PaymentUseCase represents the payment use case contract
+type PaymentUseCase interface {
+	// AddPayment starts a payment for a cart of the outlet of the tenant,
+	// payments confirmed asynchronously are returned pending
+	AddPayment(ctx context.Context, orderID uuid.UUID, payment *Payment) (*Payment, error)
+	// GetPayment returns a payment, asking the gateway for the status of a
+	// pending payment
+	GetPayment(ctx context.Context, id uuid.UUID) (*Payment, error)
+	// CancelPayment cancels a payment of a cart, captured payments are refunded
+	CancelPayment(ctx context.Context, id uuid.UUID) (*Payment, error)
+	// HandleNotification applies a notification a gateway sent to its webhook
+	HandleNotification(ctx context.Context, provider string, payload []byte, signature string) error
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Principal represents the authenticated caller of a request. It carries no
+// outlet: staff can work at several outlets of several merchants, so the
+// outlet is selected per request with the x-outlet-id header and resolved
+// into the Tenant of the request, see tenancy.RequireOutlet.
+type Principal struct {
+	UserID   uuid.UUID
+	UserName string
+	AuthType AuthType
+	Roles    []string
+}
+
+// HasRole reports whether the principal has the given role
+func (p *Principal) HasRole(role string) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+type principalContextKey struct{}
+
+// ContextWithPrincipal returns a copy of ctx carrying the authenticated principal
+func ContextWithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFromContext returns the authenticated principal carried by ctx, if any
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
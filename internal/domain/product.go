@@ -0,0 +1,193 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Category groups the products of a merchant, categories can be nested once
+// or more through ParentID
+type Category struct {
+	ID         uuid.UUID     `db:"id"`
+	MerchantID uuid.UUID     `db:"merchant_id"`
+	ParentID   uuid.NullUUID `db:"parent_id"`
+	Name       string        `db:"name"`
+	CreatedAt  time.Time     `db:"created_at"`
+	UpdatedAt  sql.NullTime  `db:"updated_at"`
+}
+
+// Product represents an item sold by a merchant. Prices are amounts in the
+// smallest unit of the currency of the merchant.
+type Product struct {
+	ID          uuid.UUID     `db:"id"`
+	MerchantID  uuid.UUID     `db:"merchant_id"`
+	CategoryID  uuid.NullUUID `db:"category_id"`
+	Name        string        `db:"name"`
+	Description string        `db:"description"`
+	// Unit is the unit of measure the product is sold in, e.g. pcs or kg
+	Unit      string `db:"unit"`
+	BasePrice int64  `db:"base_price"`
+	IsActive  bool   `db:"is_active"`
+	// TaxExempt products are sold without VAT
+	TaxExempt bool         `db:"tax_exempt"`
+	CreatedAt time.Time    `db:"created_at"`
+	UpdatedAt sql.NullTime `db:"updated_at"`
+	DeletedAt sql.NullTime `db:"deleted_at"`
+
+	Variants []*ProductVariant `db:"-"`
+}
+
+// ProductVariant is a sellable version of a product, such as a size or color,
+// identified by its SKU and barcode. Every product has at least one variant.
+type ProductVariant struct {
+	ID         uuid.UUID `db:"id"`
+	ProductID  uuid.UUID `db:"product_id"`
+	MerchantID uuid.UUID `db:"merchant_id"`
+	SKU        string    `db:"sku"`
+	Barcode    string    `db:"barcode"`
+	Name       string    `db:"name"`
+	// Attributes describe the variant, e.g. size: L and color: red
+	Attributes VariantAttributes `db:"attributes"`
+	// Price overrides the base price of the product when set
+	Price     sql.NullInt64 `db:"price"`
+	IsActive  bool          `db:"is_active"`
+	CreatedAt time.Time     `db:"created_at"`
+	UpdatedAt sql.NullTime  `db:"updated_at"`
+	DeletedAt sql.NullTime  `db:"deleted_at"`
+
+	// OutletPrices override the price at single outlets
+	OutletPrices []*OutletPrice `db:"-"`
+}
+
+// VariantAttributes holds the attributes of a variant, stored as a JSON object
+type VariantAttributes map[string]string
+
+// Value implements driver.Valuer
+func (a VariantAttributes) Value() (driver.Value, error) {
+	if a == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(a)
+}
+
+// Scan implements sql.Scanner
+func (a *VariantAttributes) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*a = VariantAttributes{}
+		return nil
+	case []byte:
+		return json.Unmarshal(v, a)
+	case string:
+		return json.Unmarshal([]byte(v), a)
+	default:
+		return fmt.Errorf("unsupported variant attributes type %T", src)
+	}
+}
+
+// OutletPrice is the price of a variant at one outlet
+type OutletPrice struct {
+	VariantID uuid.UUID `db:"variant_id"`
+	OutletID  uuid.UUID `db:"outlet_id"`
+	Price     int64     `db:"price"`
+}
+
+// PriceAt returns the price of the variant at the outlet: the outlet price
+// when there is one, otherwise the variant price, otherwise the base price.
+// A nil outletID returns the price without outlet overrides.
+func (v *ProductVariant) PriceAt(product *Product, outletID uuid.UUID) int64 {
+	if outletID != uuid.Nil {
+		for _, outletPrice := range v.OutletPrices {
+			if outletPrice.OutletID == outletID {
+				return outletPrice.Price
+			}
+		}
+	}
+	if v.Price.Valid {
+		return v.Price.Int64
+	}
+	return product.BasePrice
+}
+
+// ProductFilter narrows down a product search, zero values leave the
+// corresponding field unfiltered
+type ProductFilter struct {
+	// Query matches a substring of the product name, or a variant SKU or barcode
+	Query           string
+	CategoryID      uuid.UUID
+	IncludeInactive bool
+}
+
+// ProductPage is one page of found products
+type ProductPage struct {
+	Products []*Product
+	// NextCursor is empty on the last page
+	NextCursor string
+}
+
+// CategoryRepository represents the category repository contract, every
+// query is scoped to a merchant
+type CategoryRepository interface {
+	Create(ctx context.Context, category *Category) error
+	GetByID(ctx context.Context, merchantID, id uuid.UUID) (*Category, error)
+	List(ctx context.Context, merchantID uuid.UUID) ([]*Category, error)
+	Update(ctx context.Context, category *Category) error
+	// Delete removes the category, its products and subcategories become uncategorized
+	Delete(ctx context.Context, merchantID, id uuid.UUID) error
+}
+
+// ProductRepository represents the product repository contract, every query
+// is scoped to a merchant. Products are returned with their active variants
+// and the outlet prices of those variants.
+type ProductRepository interface {
+	// Create stores the product together with its variants
+	Create(ctx context.Context, product *Product) error
+	GetByID(ctx context.Context, merchantID, id uuid.UUID) (*Product, error)
+	// GetByBarcode returns the product with only the variant carrying the barcode
+	GetByBarcode(ctx context.Context, merchantID uuid.UUID, barcode string) (*Product, error)
+	Search(ctx context.Context, merchantID uuid.UUID, filter ProductFilter, after *PageCursor, limit int) ([]*Product, error)
+	Update(ctx context.Context, product *Product) error
+	// Delete soft deletes the product and its variants
+	Delete(ctx context.Context, merchantID, id uuid.UUID) error
+	// FindVariantByCode returns a variant of the merchant with the SKU, or with
+	// the barcode when it is not empty
+	FindVariantByCode(ctx context.Context, merchantID uuid.UUID, sku, barcode string) (*ProductVariant, error)
+
+	AddVariant(ctx context.Context, variant *ProductVariant) error
+	GetVariant(ctx context.Context, merchantID, id uuid.UUID) (*ProductVariant, error)
+	UpdateVariant(ctx context.Context, variant *ProductVariant) error
+	DeleteVariant(ctx context.Context, merchantID, id uuid.UUID) error
+	SetOutletPrice(ctx context.Context, price *OutletPrice) error
+	DeleteOutletPrice(ctx context.Context, variantID, outletID uuid.UUID) error
+}
+
+// CatalogUseCase represents the catalog use case contract, every method acts
+// on the merchant of the tenant
+type CatalogUseCase interface {
+	CreateCategory(ctx context.Context, category *Category) (*Category, error)
+	ListCategories(ctx context.Context) ([]*Category, error)
+	UpdateCategory(ctx context.Context, category *Category) (*Category, error)
+	DeleteCategory(ctx context.Context, id uuid.UUID) error
+
+	CreateProduct(ctx context.Context, product *Product) (*Product, error)
+	GetProduct(ctx context.Context, id uuid.UUID) (*Product, error)
+	// GetProductByBarcode returns the product with only the scanned variant
+	GetProductByBarcode(ctx context.Context, barcode string) (*Product, error)
+	SearchProducts(ctx context.Context, filter ProductFilter, pageSize int, cursor string) (*ProductPage, error)
+	UpdateProduct(ctx context.Context, product *Product) (*Product, error)
+	DeleteProduct(ctx context.Context, id uuid.UUID) error
+
+	// AddVariant and UpdateVariant return the product of the variant
+	AddVariant(ctx context.Context, variant *ProductVariant) (*Product, error)
+	UpdateVariant(ctx context.Context, variant *ProductVariant) (*Product, error)
+	DeleteVariant(ctx context.Context, id uuid.UUID) error
+	// SetOutletPrice overrides the price of a variant at an outlet, a nil price
+	// removes the override
+	SetOutletPrice(ctx context.Context, variantID, outletID uuid.UUID, price *int64) error
+}
@@ -0,0 +1,333 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PromotionType is how a promotion discounts a sale
+type PromotionType string
+
+const (
+	// PromotionTypeItemDiscount takes a percentage off the matching items or an
+	// amount off each of their units, e.g. 20% off a category or happy-hour prices
+	PromotionTypeItemDiscount PromotionType = "item_discount"
+	// PromotionTypeBuyXGetY gives a percentage off GetQuantity of every
+	// BuyQuantity plus GetQuantity matching units, the cheapest units first,
+	// e.g. buy 2 get 1 free
+	PromotionTypeBuyXGetY PromotionType = "buy_x_get_y"
+	// PromotionTypeOrderDiscount takes an amount or a percentage off a sale of
+	// at least MinSpend, e.g. a minimum-spend voucher
+	PromotionTypeOrderDiscount PromotionType = "order_discount"
+)
+
+// Promotion is a discount rule of a merchant applied to carts automatically,
+// or only to carts with one of its vouchers when it requires a voucher.
+// Amounts are in the smallest unit of the currency.
+type Promotion struct {
+	ID         uuid.UUID `db:"id"`
+	MerchantID uuid.UUID `db:"merchant_id"`
+	// OutletID limits the promotion to one outlet, empty for every outlet
+	OutletID uuid.NullUUID `db:"outlet_id"`
+	// Name is printed on receipts
+	Name string        `db:"name"`
+	Type PromotionType `db:"type"`
+	// CategoryID or ProductID limit item promotions to the products of a
+	// category or to one product, neither applies to every item
+	CategoryID    uuid.NullUUID   `db:"category_id"`
+	ProductID     uuid.NullUUID   `db:"product_id"`
+	DiscountType  DiscountType    `db:"discount_type"`
+	DiscountValue decimal.Decimal `db:"discount_value"`
+	BuyQuantity   int64           `db:"buy_quantity"`
+	GetQuantity   int64           `db:"get_quantity"`
+	// MinSpend is the least a sale must come to after item discounts for an
+	// order discount
+	MinSpend int64 `db:"min_spend"`
+
+	// The promotion runs from StartsAt until EndsAt, on the Weekdays and
+	// between DailyFrom and DailyUntil of every day. Weekdays is a bit mask
+	// with bit n set for time.Weekday n, zero is every day. The daily window
+	// is in 15:04 format, empty is all day, and may cross midnight. Weekdays
+	// and the daily window are in the local time of the outlet.
+	StartsAt   time.Time    `db:"starts_at"`
+	EndsAt     sql.NullTime `db:"ends_at"`
+	Weekdays   int          `db:"weekdays"`
+	DailyFrom  string       `db:"daily_from"`
+	DailyUntil string       `db:"daily_until"`
+
+	// Promotions of higher priority are evaluated first. A promotion that is
+	// not stackable only discounts items no promotion discounted before it,
+	// and no promotion discounts them after it.
+	Priority        int  `db:"priority"`
+	Stackable       bool `db:"stackable"`
+	RequiresVoucher bool `db:"requires_voucher"`
+	// UsageLimit is the number of sales the promotion can be redeemed in,
+	// UsageCount the number of sales it was redeemed in
+	UsageLimit sql.NullInt64 `db:"usage_limit"`
+	UsageCount int64         `db:"usage_count"`
+	IsActive   bool          `db:"is_active"`
+	CreatedAt  time.Time     `db:"created_at"`
+	UpdatedAt  sql.NullTime  `db:"updated_at"`
+}
+
+// RunsAt reports whether the promotion applies at an outlet in the time zone
+// loc at a time
+func (p *Promotion) RunsAt(outletID uuid.UUID, loc *time.Location, at time.Time) bool {
+	if !p.IsActive || at.Before(p.StartsAt) || (p.EndsAt.Valid && !at.Before(p.EndsAt.Time)) {
+		return false
+	}
+	if p.OutletID.Valid && p.OutletID.UUID != outletID {
+		return false
+	}
+	if p.UsageLimit.Valid && p.UsageCount >= p.UsageLimit.Int64 {
+		return false
+	}
+	local := at.In(loc)
+	if p.Weekdays != 0 && p.Weekdays&(1<<local.Weekday()) == 0 {
+		return false
+	}
+	if p.DailyFrom != "" {
+		clock := local.Format("15:04")
+		if p.DailyFrom <= p.DailyUntil {
+			return clock >= p.DailyFrom && clock < p.DailyUntil
+		}
+		return clock >= p.DailyFrom || clock < p.DailyUntil
+	}
+	return true
+}
+
+// matches reports whether an item promotion applies to an item
+func (p *Promotion) matches(item *OrderItem) bool {
+	if p.ProductID.Valid {
+		return item.ProductID == p.ProductID.UUID
+	}
+	if p.CategoryID.Valid {
+		return item.CategoryID.Valid && item.CategoryID.UUID == p.CategoryID.UUID
+	}
+	return true
+}
+
+// itemDiscounts returns the discount of an item promotion on each of the
+// given items, before capping at what is left of them
+func (p *Promotion) itemDiscounts(items []*OrderItem, remaining []int64) []int64 {
+	discounts := make([]int64, len(items))
+	switch p.Type {
+	case PromotionTypeItemDiscount:
+		for i, item := range items {
+			if p.DiscountType == DiscountTypeAmount {
+				discounts[i] = p.DiscountValue.Round(0).IntPart() * item.Quantity
+			} else {
+				discounts[i] = percentOf(remaining[i], p.DiscountValue)
+			}
+		}
+	case PromotionTypeBuyXGetY:
+		var units int64
+		for _, item := range items {
+			units += item.Quantity
+		}
+		free := units / (p.BuyQuantity + p.GetQuantity) * p.GetQuantity
+
+		// The cheapest units are discounted
+		order := make([]int, len(items))
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(a, b int) bool {
+			return items[order[a]].UnitPrice < items[order[b]].UnitPrice
+		})
+		for _, i := range order {
+			if free == 0 {
+				break
+			}
+			quantity := min(free, items[i].Quantity)
+			discounts[i] = percentOf(items[i].UnitPrice*quantity, p.DiscountValue)
+			free -= quantity
+		}
+	}
+	return discounts
+}
+
+// Voucher is a code unlocking a promotion that requires a voucher. A voucher
+// is redeemed by exactly one sale and stays redeemed when the sale is voided.
+type Voucher struct {
+	ID          uuid.UUID `db:"id"`
+	MerchantID  uuid.UUID `db:"merchant_id"`
+	PromotionID uuid.UUID `db:"promotion_id"`
+	Code        string    `db:"code"`
+	// OrderID is the sale the voucher was redeemed with
+	OrderID    uuid.NullUUID `db:"order_id"`
+	RedeemedAt sql.NullTime  `db:"redeemed_at"`
+	CreatedAt  time.Time     `db:"created_at"`
+}
+
+// OrderPromotion is the discount of a promotion on an item of an order, or on
+// the whole order for an order discount
+type OrderPromotion struct {
+	OrderID     uuid.UUID `db:"order_id"`
+	PromotionID uuid.UUID `db:"promotion_id"`
+	// ItemID is empty for an order discount
+	ItemID uuid.NullUUID `db:"item_id"`
+	Name   string        `db:"name"`
+	Amount int64         `db:"amount"`
+}
+
+// ApplyPromotions evaluates the promotions running at the outlet of the order,
+// in its time zone loc, at a time and recalculates the order. Promotions requiring a voucher only
+// apply with the unredeemed voucher of the order. Item promotions are
+// evaluated before order discounts, each in order of priority, and discount
+// what is left of the items after the manual item discounts.
+func (o *Order) ApplyPromotions(promotions []*Promotion, voucher *Voucher, loc *time.Location, at time.Time) {
+	if voucher != nil && voucher.RedeemedAt.Valid {
+		voucher = nil
+	}
+
+	var itemPromotions, orderPromotions []*Promotion
+	for _, p := range promotions {
+		if !p.RunsAt(o.OutletID, loc, at) {
+			continue
+		}
+		if p.RequiresVoucher && (voucher == nil || voucher.PromotionID != p.ID) {
+			continue
+		}
+		if p.Type == PromotionTypeOrderDiscount {
+			orderPromotions = append(orderPromotions, p)
+		} else {
+			itemPromotions = append(itemPromotions, p)
+		}
+	}
+	byPriority := func(list []*Promotion) {
+		sort.SliceStable(list, func(i, j int) bool { return list[i].Priority > list[j].Priority })
+	}
+	byPriority(itemPromotions)
+	byPriority(orderPromotions)
+
+	remaining := make([]int64, len(o.Items))
+	discounted := make([]bool, len(o.Items))
+	locked := make([]bool, len(o.Items))
+	for i, item := range o.Items {
+		subtotal := item.UnitPrice * item.Quantity
+		remaining[i] = subtotal - discountAmount(item.DiscountType, item.DiscountValue, subtotal)
+		item.PromotionDiscount = 0
+	}
+
+	o.Promotions = []*OrderPromotion{}
+	for _, p := range itemPromotions {
+		var lines []int
+		var items []*OrderItem
+		var left []int64
+		for i, item := range o.Items {
+			if locked[i] || (discounted[i] && !p.Stackable) || remaining[i] == 0 || !p.matches(item) {
+				continue
+			}
+			lines = append(lines, i)
+			items = append(items, item)
+			left = append(left, remaining[i])
+		}
+
+		for k, amount := range p.itemDiscounts(items, left) {
+			i := lines[k]
+			amount = min(amount, remaining[i])
+			if amount <= 0 {
+				continue
+			}
+			remaining[i] -= amount
+			o.Items[i].PromotionDiscount += amount
+			discounted[i] = true
+			locked[i] = !p.Stackable
+			o.Promotions = append(o.Promotions, &OrderPromotion{
+				OrderID:     o.ID,
+				PromotionID: p.ID,
+				ItemID:      uuid.NullUUID{UUID: o.Items[i].ID, Valid: true},
+				Name:        p.Name,
+				Amount:      amount,
+			})
+		}
+	}
+
+	var net int64
+	for _, amount := range remaining {
+		net += amount
+	}
+	for _, p := range orderPromotions {
+		if net == 0 || net < p.MinSpend {
+			continue
+		}
+		if !p.Stackable && len(o.Promotions) > 0 {
+			continue
+		}
+		amount := discountAmount(p.DiscountType, p.DiscountValue, net)
+		if amount == 0 {
+			continue
+		}
+		net -= amount
+		o.Promotions = append(o.Promotions, &OrderPromotion{
+			OrderID:     o.ID,
+			PromotionID: p.ID,
+			Name:        p.Name,
+			Amount:      amount,
+		})
+		if !p.Stackable {
+			break
+		}
+	}
+
+	o.Recalculate()
+}
+
+// VoucherPage is one page of vouchers
+type VoucherPage struct {
+	Vouchers []*Voucher
+	// NextCursor is empty on the last page
+	NextCursor string
+}
+
+var (
+	// ErrVoucherRedeemed is returned when a voucher was redeemed by another sale
+	ErrVoucherRedeemed = errors.New("voucher was already redeemed")
+	// ErrPromotionExhausted is returned when a promotion reached its usage limit
+	ErrPromotionExhausted = errors.New("promotion reached its usage limit")
+	// ErrVoucherCodeExists is returned when a voucher code is already used by
+	// the merchant
+	ErrVoucherCodeExists = errors.New("voucher code already exists")
+)
+
+// PromotionRepository represents the promotion repository contract, every
+// query is scoped to a merchant
+type PromotionRepository interface {
+	Create(ctx context.Context, promotion *Promotion) error
+	GetByID(ctx context.Context, merchantID, id uuid.UUID) (*Promotion, error)
+	// List lists every promotion of a merchant, newest first
+	List(ctx context.Context, merchantID uuid.UUID) ([]*Promotion, error)
+	// ListRunning lists the active promotions of a merchant that started and
+	// did not end at a time, the weekdays and daily windows are left to the caller
+	ListRunning(ctx context.Context, merchantID uuid.UUID, at time.Time) ([]*Promotion, error)
+	Update(ctx context.Context, promotion *Promotion) error
+	// CreateVouchers stores new vouchers at once, it fails with
+	// ErrVoucherCodeExists when a code is taken
+	CreateVouchers(ctx context.Context, vouchers []*Voucher) error
+	GetVoucher(ctx context.Context, merchantID, id uuid.UUID) (*Voucher, error)
+	GetVoucherByCode(ctx context.Context, merchantID uuid.UUID, code string) (*Voucher, error)
+	// ListVouchers lists the vouchers of a promotion newest first, starting
+	// after the cursor
+	ListVouchers(ctx context.Context, merchantID, promotionID uuid.UUID, after *PageCursor, limit int) ([]*Voucher, error)
+}
+
+// PromotionUseCase represents the promotion use case contract, every method
+// acts on the merchant of the tenant. Changes apply to carts when they change next.
+type PromotionUseCase interface {
+	CreatePromotion(ctx context.Context, promotion *Promotion) (*Promotion, error)
+	GetPromotion(ctx context.Context, id uuid.UUID) (*Promotion, error)
+	ListPromotions(ctx context.Context) ([]*Promotion, error)
+	// UpdatePromotion changes a promotion except for its type and usage count
+	UpdatePromotion(ctx context.Context, promotion *Promotion) (*Promotion, error)
+	// GenerateVouchers creates count single-use vouchers with random codes for
+	// a promotion that requires a voucher
+	GenerateVouchers(ctx context.Context, promotionID uuid.UUID, count int) ([]*Voucher, error)
+	ListVouchers(ctx context.Context, promotionID uuid.UUID, pageSize int, cursor string) (*VoucherPage, error)
+}
@@ -0,0 +1,303 @@
+package domain
+
+import (
+	"database/sql"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func TestPromotionRunsAt(t *testing.T) {
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Fatal(err)
+	}
+	outletID := uuid.New()
+	// Sunday 15:30 in Jakarta
+	at := time.Date(2026, 10, 18, 8, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		modify func(p *Promotion)
+		loc    *time.Location
+		at     time.Time
+		want   bool
+	}{
+		{name: "running", loc: jakarta, at: at, want: true},
+		{name: "inactive", modify: func(p *Promotion) { p.IsActive = false }, loc: jakarta, at: at, want: false},
+		{name: "not started", modify: func(p *Promotion) { p.StartsAt = at.Add(time.Minute) }, loc: jakarta, at: at, want: false},
+		{
+			name:   "ended",
+			modify: func(p *Promotion) { p.EndsAt = sql.NullTime{Time: at, Valid: true} },
+			loc:    jakarta, at: at, want: false,
+		},
+		{
+			name:   "another outlet",
+			modify: func(p *Promotion) { p.OutletID = uuid.NullUUID{UUID: uuid.New(), Valid: true} },
+			loc:    jakarta, at: at, want: false,
+		},
+		{
+			name:   "this outlet",
+			modify: func(p *Promotion) { p.OutletID = uuid.NullUUID{UUID: outletID, Valid: true} },
+			loc:    jakarta, at: at, want: true,
+		},
+		{
+			name:   "usage limit reached",
+			modify: func(p *Promotion) { p.UsageLimit, p.UsageCount = sql.NullInt64{Int64: 5, Valid: true}, 5 },
+			loc:    jakarta, at: at, want: false,
+		},
+		{
+			name:   "happy hour in the outlet time zone",
+			modify: func(p *Promotion) { p.DailyFrom, p.DailyUntil = "15:00", "17:00" },
+			loc:    jakarta, at: at, want: true,
+		},
+		{
+			name:   "happy hour in another time zone",
+			modify: func(p *Promotion) { p.DailyFrom, p.DailyUntil = "15:00", "17:00" },
+			loc:    time.UTC, at: at, want: false,
+		},
+		{
+			name:   "window across midnight",
+			modify: func(p *Promotion) { p.DailyFrom, p.DailyUntil = "22:00", "02:00" },
+			loc:    jakarta, at: time.Date(2026, 10, 18, 16, 30, 0, 0, time.UTC), want: true,
+		},
+		{
+			name:   "outside window across midnight",
+			modify: func(p *Promotion) { p.DailyFrom, p.DailyUntil = "22:00", "02:00" },
+			loc:    jakarta, at: at, want: false,
+		},
+		{
+			name:   "weekday in the outlet time zone",
+			modify: func(p *Promotion) { p.Weekdays = 1 << time.Sunday },
+			loc:    jakarta, at: time.Date(2026, 10, 17, 20, 0, 0, 0, time.UTC), want: true,
+		},
+		{
+			name:   "other weekday in the outlet time zone",
+			modify: func(p *Promotion) { p.Weekdays = 1 << time.Saturday },
+			loc:    jakarta, at: time.Date(2026, 10, 17, 20, 0, 0, 0, time.UTC), want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Promotion{IsActive: true, StartsAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+			if tt.modify != nil {
+				tt.modify(p)
+			}
+			if got := p.RunsAt(outletID, tt.loc, tt.at); got != tt.want {
+				t.Errorf("RunsAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPromotionItemDiscounts(t *testing.T) {
+	tests := []struct {
+		name      string
+		promotion *Promotion
+		items     []*OrderItem
+		remaining []int64
+		want      []int64
+	}{
+		{
+			name:      "amount off every unit",
+			promotion: &Promotion{Type: PromotionTypeItemDiscount, DiscountType: DiscountTypeAmount, DiscountValue: decimal.NewFromInt(2000)},
+			items:     []*OrderItem{{UnitPrice: 15000, Quantity: 3}},
+			remaining: []int64{45000},
+			want:      []int64{6000},
+		},
+		{
+			name:      "percentage of what is left",
+			promotion: &Promotion{Type: PromotionTypeItemDiscount, DiscountType: DiscountTypePercent, DiscountValue: decimal.NewFromInt(10)},
+			items:     []*OrderItem{{UnitPrice: 15000, Quantity: 3}, {UnitPrice: 0, Quantity: 1}},
+			remaining: []int64{40000, 0},
+			want:      []int64{4000, 0},
+		},
+		{
+			name:      "buy 2 get 1 free discounts the cheapest unit",
+			promotion: &Promotion{Type: PromotionTypeBuyXGetY, BuyQuantity: 2, GetQuantity: 1, DiscountType: DiscountTypePercent, DiscountValue: decimal.NewFromInt(100)},
+			items:     []*OrderItem{{UnitPrice: 10000, Quantity: 2}, {UnitPrice: 5000, Quantity: 1}},
+			remaining: []int64{20000, 5000},
+			want:      []int64{0, 5000},
+		},
+		{
+			name:      "buy 1 get 1 half price",
+			promotion: &Promotion{Type: PromotionTypeBuyXGetY, BuyQuantity: 1, GetQuantity: 1, DiscountType: DiscountTypePercent, DiscountValue: decimal.NewFromInt(50)},
+			items:     []*OrderItem{{UnitPrice: 8000, Quantity: 5}},
+			remaining: []int64{40000},
+			want:      []int64{8000},
+		},
+		{
+			name:      "free units spread over the cheapest items",
+			promotion: &Promotion{Type: PromotionTypeBuyXGetY, BuyQuantity: 1, GetQuantity: 1, DiscountType: DiscountTypePercent, DiscountValue: decimal.NewFromInt(100)},
+			items:     []*OrderItem{{UnitPrice: 9000, Quantity: 2}, {UnitPrice: 3000, Quantity: 1}, {UnitPrice: 6000, Quantity: 1}},
+			remaining: []int64{18000, 3000, 6000},
+			want:      []int64{0, 3000, 6000},
+		},
+		{
+			name:      "too few units",
+			promotion: &Promotion{Type: PromotionTypeBuyXGetY, BuyQuantity: 2, GetQuantity: 1, DiscountType: DiscountTypePercent, DiscountValue: decimal.NewFromInt(100)},
+			items:     []*OrderItem{{UnitPrice: 10000, Quantity: 2}},
+			remaining: []int64{20000},
+			want:      []int64{0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.promotion.itemDiscounts(tt.items, tt.remaining); !slices.Equal(got, tt.want) {
+				t.Errorf("itemDiscounts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderApplyPromotions(t *testing.T) {
+	coffeeID, cakeID := uuid.New(), uuid.New()
+	at := time.Date(2026, 10, 18, 8, 30, 0, 0, time.UTC)
+	running := func(p *Promotion) *Promotion {
+		p.ID = uuid.New()
+		p.IsActive = true
+		p.StartsAt = at.Add(-time.Hour)
+		return p
+	}
+	itemPercent := func(productID uuid.UUID, value int64, priority int, stackable bool) *Promotion {
+		return running(&Promotion{
+			Type: PromotionTypeItemDiscount, ProductID: uuid.NullUUID{UUID: productID, Valid: productID != uuid.Nil},
+			DiscountType: DiscountTypePercent, DiscountValue: decimal.NewFromInt(value), Priority: priority, Stackable: stackable,
+		})
+	}
+	itemAmount := func(productID uuid.UUID, value int64, priority int, stackable bool) *Promotion {
+		p := itemPercent(productID, 0, priority, stackable)
+		p.DiscountType, p.DiscountValue = DiscountTypeAmount, decimal.NewFromInt(value)
+		return p
+	}
+	orderDiscount := func(discountType DiscountType, value, minSpend int64, stackable bool) *Promotion {
+		return running(&Promotion{
+			Type: PromotionTypeOrderDiscount, DiscountType: discountType, DiscountValue: decimal.NewFromInt(value),
+			MinSpend: minSpend, Stackable: stackable,
+		})
+	}
+	voucherPromotion := orderDiscount(DiscountTypePercent, 10, 0, false)
+	voucherPromotion.RequiresVoucher = true
+	inactive := itemPercent(uuid.Nil, 50, 0, false)
+	inactive.IsActive = false
+
+	tests := []struct {
+		name       string
+		promotions []*Promotion
+		voucher    *Voucher
+		// wantAmounts are the amounts of the promotions applied, in order
+		wantAmounts []int64
+		wantTotal   int64
+	}{
+		{name: "no promotions", wantAmounts: []int64{}, wantTotal: 55000},
+		{
+			name:        "item percentage on a product",
+			promotions:  []*Promotion{itemPercent(coffeeID, 10, 0, false)},
+			wantAmounts: []int64{4000},
+			wantTotal:   51000,
+		},
+		{
+			name: "buy 2 get 1 free",
+			promotions: []*Promotion{running(&Promotion{
+				Type: PromotionTypeBuyXGetY, BuyQuantity: 2, GetQuantity: 1,
+				DiscountType: DiscountTypePercent, DiscountValue: decimal.NewFromInt(100),
+			})},
+			wantAmounts: []int64{15000},
+			wantTotal:   40000,
+		},
+		{
+			name:        "item discount capped at what is left",
+			promotions:  []*Promotion{itemAmount(cakeID, 25000, 0, false)},
+			wantAmounts: []int64{15000},
+			wantTotal:   40000,
+		},
+		{
+			name:        "order discount from the minimum spend",
+			promotions:  []*Promotion{orderDiscount(DiscountTypeAmount, 5000, 55000, false)},
+			wantAmounts: []int64{5000},
+			wantTotal:   50000,
+		},
+		{
+			name:        "order discount below the minimum spend",
+			promotions:  []*Promotion{orderDiscount(DiscountTypeAmount, 5000, 60000, false)},
+			wantAmounts: []int64{},
+			wantTotal:   55000,
+		},
+		{
+			name:        "non-stackable promotion locks its items",
+			promotions:  []*Promotion{itemAmount(uuid.Nil, 5000, 1, true), itemPercent(coffeeID, 10, 2, false)},
+			wantAmounts: []int64{4000, 5000},
+			wantTotal:   46000,
+		},
+		{
+			name:        "stackable promotions add up",
+			promotions:  []*Promotion{itemAmount(uuid.Nil, 1000, 1, true), itemPercent(coffeeID, 10, 2, true)},
+			wantAmounts: []int64{4000, 2000, 1000},
+			wantTotal:   48000,
+		},
+		{
+			name:        "non-stackable order discount after item promotions",
+			promotions:  []*Promotion{itemPercent(coffeeID, 10, 0, false), orderDiscount(DiscountTypePercent, 10, 0, false)},
+			wantAmounts: []int64{4000},
+			wantTotal:   51000,
+		},
+		{
+			name:        "stackable order discount after item promotions",
+			promotions:  []*Promotion{itemPercent(coffeeID, 10, 0, false), orderDiscount(DiscountTypePercent, 10, 0, true)},
+			wantAmounts: []int64{4000, 5100},
+			wantTotal:   45900,
+		},
+		{
+			name:        "voucher promotion without the voucher",
+			promotions:  []*Promotion{voucherPromotion},
+			wantAmounts: []int64{},
+			wantTotal:   55000,
+		},
+		{
+			name:        "voucher promotion with the voucher",
+			promotions:  []*Promotion{voucherPromotion},
+			voucher:     &Voucher{PromotionID: voucherPromotion.ID},
+			wantAmounts: []int64{5500},
+			wantTotal:   49500,
+		},
+		{
+			name:        "redeemed voucher",
+			promotions:  []*Promotion{voucherPromotion},
+			voucher:     &Voucher{PromotionID: voucherPromotion.ID, RedeemedAt: sql.NullTime{Time: at, Valid: true}},
+			wantAmounts: []int64{},
+			wantTotal:   55000,
+		},
+		{
+			name:        "promotion not running",
+			promotions:  []*Promotion{inactive},
+			wantAmounts: []int64{},
+			wantTotal:   55000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &Order{Items: []*OrderItem{
+				{ID: uuid.New(), ProductID: coffeeID, UnitPrice: 20000, Quantity: 2},
+				{ID: uuid.New(), ProductID: cakeID, UnitPrice: 15000, Quantity: 1},
+			}}
+			o.ApplyPromotions(tt.promotions, tt.voucher, time.UTC, at)
+
+			amounts := []int64{}
+			for _, p := range o.Promotions {
+				amounts = append(amounts, p.Amount)
+			}
+			if !slices.Equal(amounts, tt.wantAmounts) {
+				t.Errorf("promotion amounts = %v, want %v", amounts, tt.wantAmounts)
+			}
+			if o.Total != tt.wantTotal {
+				t.Errorf("total = %d, want %d", o.Total, tt.wantTotal)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// RateLimitRepository represents the rate limit counter repository contract.
+// Counters use fixed windows that start with the first hit of a key.
+type RateLimitRepository interface {
+	// Hit counts a hit for key and returns the number of hits in the current window
+	Hit(ctx context.Context, key string, window time.Duration) (int, error)
+}
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReceiptFormat is the output a receipt is rendered to
+type ReceiptFormat string
+
+const (
+	// ReceiptFormatText is plain text laid out for the paper width
+	ReceiptFormatText ReceiptFormat = "text"
+	// ReceiptFormatESCPOS is a byte stream of ESC/POS commands for thermal printers
+	ReceiptFormatESCPOS ReceiptFormat = "escpos"
+	// ReceiptFormatPDF is a PDF document one receipt wide
+	ReceiptFormatPDF ReceiptFormat = "pdf"
+)
+
+// PaperWidth is the width of thermal printer paper in millimetres
+type PaperWidth int
+
+const (
+	// PaperWidth58 is 58mm paper, 32 characters per line
+	PaperWidth58 PaperWidth = 58
+	// PaperWidth80 is 80mm paper, 48 characters per line
+	PaperWidth80 PaperWidth = 80
+)
+
+// ReceiptChannel is how a receipt is sent to a customer
+type ReceiptChannel string
+
+const (
+	// ReceiptChannelEmail sends the receipt by email with its PDF attached
+	ReceiptChannelEmail ReceiptChannel = "email"
+	// ReceiptChannelWhatsApp sends the receipt as a WhatsApp text message
+	ReceiptChannelWhatsApp ReceiptChannel = "whatsapp"
+)
+
+// ReceiptSettings is what an outlet prints on its receipts besides the sale
+type ReceiptSettings struct {
+	MerchantID uuid.UUID `db:"merchant_id"`
+	OutletID   uuid.UUID `db:"outlet_id"`
+	// Header and Footer are printed centered above and below the sale, one
+	// line per line break
+	Header string `db:"header"`
+	Footer string `db:"footer"`
+	// TaxID is the tax registration number of the seller, e.g. the NPWP
+	TaxID string `db:"tax_id"`
+	// Logo is a PNG or JPEG image printed at the top
+	Logo      []byte       `db:"logo"`
+	UpdatedAt sql.NullTime `db:"updated_at"`
+}
+
+// Receipt is everything printed on the receipt of a sale
+type Receipt struct {
+	MerchantName string
+	Outlet       *Outlet
+	Settings     *ReceiptSettings
+	Order        *Order
+	CashierName  string
+	// PrintedAt is the time the sale was completed, or created for a cart
+	PrintedAt time.Time
+}
+
+// ReceiptDocument is a rendered receipt
+type ReceiptDocument struct {
+	Format      ReceiptFormat
+	ContentType string
+	FileName    string
+	Content     []byte
+}
+
+// ReceiptMessage is a receipt sent to a customer, the text is the plain text
+// receipt and the attachment an optional PDF
+type ReceiptMessage struct {
+	Subject    string
+	Text       string
+	Attachment *ReceiptDocument
+}
+
+// ReceiptRepository represents the receipt settings repository contract,
+// every query is scoped to a merchant
+type ReceiptRepository interface {
+	// GetSettings retrieves the receipt settings of an outlet, nil when they
+	// were never saved
+	GetSettings(ctx context.Context, merchantID, outletID uuid.UUID) (*ReceiptSettings, error)
+	SaveSettings(ctx context.Context, settings *ReceiptSettings) error
+}
+
+// ReceiptUseCase represents the receipt use case contract, every method acts
+// on the outlet of the tenant
+type ReceiptUseCase interface {
+	// GetReceipt renders the receipt of an order
+	GetReceipt(ctx context.Context, orderID uuid.UUID, format ReceiptFormat, width PaperWidth) (*ReceiptDocument, error)
+	// SendReceipt sends the receipt of a completed sale to an email address or
+	// a WhatsApp number
+	SendReceipt(ctx context.Context, orderID uuid.UUID, channel ReceiptChannel, recipient string) error
+	GetReceiptSettings(ctx context.Context) (*ReceiptSettings, error)
+	UpdateReceiptSettings(ctx context.Context, settings *ReceiptSettings) (*ReceiptSettings, error)
+}
@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RefundTender is how the money of a refund is given back
+type RefundTender string
+
+const (
+	// RefundTenderOriginal gives the money back through the payments of the sale
+	RefundTenderOriginal RefundTender = "original"
+	// RefundTenderStoreCredit gives the money back as store credit
+	RefundTenderStoreCredit RefundTender = "store_credit"
+)
+
+// RefundStatus is the state of a refund
+type RefundStatus string
+
+const (
+	// RefundStatusPending has its amounts reserved but its money is not
+	// given back through every payment yet
+	RefundStatusPending RefundStatus = "pending"
+	// RefundStatusCompleted has its money given back and its items restocked
+	RefundStatusCompleted RefundStatus = "completed"
+)
+
+// Refund gives back money for items of a completed sale. Amounts are in the
+// smallest unit of the currency.
+type Refund struct {
+	ID         uuid.UUID `db:"id"`
+	MerchantID uuid.UUID `db:"merchant_id"`
+	OutletID   uuid.UUID `db:"outlet_id"`
+	OrderID    uuid.UUID `db:"order_id"`
+	// ShiftID is the shift the refund was completed in
+	ShiftID uuid.NullUUID `db:"shift_id"`
+	Status  RefundStatus  `db:"status"`
+	Tender  RefundTender  `db:"tender"`
+	Reason  string        `db:"reason"`
+	Amount  int64         `db:"amount"`
+	// PointsTaken is the share of the points the sale earned its customer
+	// taken back and PointsReturned the share of the points it redeemed given
+	// back
+	PointsTaken    int64 `db:"points_taken"`
+	PointsReturned int64 `db:"points_returned"`
+	// IdempotencyKey is the key the client sent with the refund
+	IdempotencyKey string    `db:"idempotency_key"`
+	CreatedBy      uuid.UUID `db:"created_by"`
+	// ApprovedBy is the manager who approved a refund above the approval threshold
+	ApprovedBy  uuid.NullUUID `db:"approved_by"`
+	CreatedAt   time.Time     `db:"created_at"`
+	CompletedAt sql.NullTime  `db:"completed_at"`
+
+	Items    []*RefundItem    `db:"-"`
+	Payments []*RefundPayment `db:"-"`
+	// StoreCredit is issued once a store credit refund completes
+	StoreCredit *StoreCredit `db:"-"`
+}
+
+// RefundItem is a quantity of an order item given back
+type RefundItem struct {
+	ID          uuid.UUID `db:"id"`
+	RefundID    uuid.UUID `db:"refund_id"`
+	OrderItemID uuid.UUID `db:"order_item_id"`
+	VariantID   uuid.UUID `db:"variant_id"`
+	Quantity    int64     `db:"quantity"`
+	Amount      int64     `db:"amount"`
+	// Restock returns the items to stock, damaged items are not
+	Restock bool `db:"restock"`
+}
+
+// RefundPayment is the part of a refund given back through a payment of the sale
+type RefundPayment struct {
+	ID        uuid.UUID     `db:"id"`
+	RefundID  uuid.UUID     `db:"refund_id"`
+	PaymentID uuid.UUID     `db:"payment_id"`
+	Method    PaymentMethod `db:"method"`
+	Amount    int64         `db:"amount"`
+	// Status is pending until the gateway of the payment gave the money back,
+	// then refunded
+	Status    PaymentStatus `db:"status"`
+	CreatedAt time.Time     `db:"created_at"`
+	UpdatedAt sql.NullTime  `db:"updated_at"`
+}
+
+// StoreCredit is money a merchant owes a customer, spent on later purchases
+type StoreCredit struct {
+	ID         uuid.UUID `db:"id"`
+	MerchantID uuid.UUID `db:"merchant_id"`
+	RefundID   uuid.UUID `db:"refund_id"`
+	// Code is handed to the customer to spend the credit
+	Code      string       `db:"code"`
+	Amount    int64        `db:"amount"`
+	Balance   int64        `db:"balance"`
+	CreatedAt time.Time    `db:"created_at"`
+	UpdatedAt sql.NullTime `db:"updated_at"`
+}
+
+// RefundAmount returns the part of the grand total of an item given back for
+// quantity more units. Every unit gets its share of the grand total, so the
+// refunds of every unit add up to the grand total exactly.
+func (o *Order) RefundAmount(item *OrderItem, quantity int64) int64 {
+	refunded := item.RefundedQuantity
+	return shareOf(item.GrandTotal, refunded+quantity, item.Quantity) - shareOf(item.GrandTotal, refunded, item.Quantity)
+}
+
+// RefundPoints returns the share of the points the sale earned taken back by a
+// refund of amount and the share of the points it redeemed given back, in
+// proportion to the total. Shares are counted from the refunded total before
+// the refund, so the refunds of the whole sale reverse the points exactly.
+func (o *Order) RefundPoints(amount int64) (taken, returned int64) {
+	before, after := o.RefundedTotal, o.RefundedTotal+amount
+	taken = shareOf(o.PointsEarned, after, o.Total) - shareOf(o.PointsEarned, before, o.Total)
+	returned = shareOf(o.PointsRedeemed, after, o.Total) - shareOf(o.PointsRedeemed, before, o.Total)
+	return taken, returned
+}
+
+var (
+	// ErrRefundChanged is returned when a refund was changed since it was read
+	ErrRefundChanged = errors.New("refund was changed by another request")
+	// ErrInsufficientStoreCredit is returned when a store credit does not
+	// exist or its balance does not cover a payment
+	ErrInsufficientStoreCredit = errors.New("insufficient store credit")
+)
+
+// RefundRepository represents the refund repository contract, every query is
+// scoped to a merchant. Refunds are returned with their items, payments and
+// store credit.
+type RefundRepository interface {
+	// Create stores a pending refund and reserves its quantities and amounts
+	// on the order and its payments in one transaction. It fails with
+	// ErrOrderChanged when the stored version of the order differs, with
+	// ErrPaymentChanged when a payment no longer covers its part and with
+	// ErrDuplicateIdempotencyKey when the key is taken.
+	Create(ctx context.Context, refund *Refund, order *Order) error
+	GetByID(ctx context.Context, merchantID, id uuid.UUID) (*Refund, error)
+	GetByIdempotencyKey(ctx context.Context, merchantID uuid.UUID, key string) (*Refund, error)
+	// ListByOrder lists the refunds of an order oldest first
+	ListByOrder(ctx context.Context, merchantID, orderID uuid.UUID) ([]*Refund, error)
+	// UpdatePayment stores the status of a part of a refund given back
+	UpdatePayment(ctx context.Context, payment *RefundPayment) error
+	// Complete stores the completed refund, issues its store credit, credits
+	// parts given back to store credit payments to their balance and records
+	// the stock movements, the points entries of the customer and the cash
+	// given back in its shift in one transaction, cash is nil when no cash is
+	// given back. It fails with
+	// ErrRefundChanged when the refund is no longer pending and with
+	// ErrShiftClosed when the shift was closed.
+	Complete(ctx context.Context, refund *Refund, movements []*StockMovement, points []*PointEntry, cash *CashMovement) error
+	// GetStoreCreditByCode retrieves a store credit of a merchant by its code
+	GetStoreCreditByCode(ctx context.Context, merchantID uuid.UUID, code string) (*StoreCredit, error)
+}
+
+// RefundUseCase represents the refund use case contract, every method acts on
+// the outlet of the tenant
+type RefundUseCase interface {
+	// Refund gives back the items of a completed sale, every remaining item
+	// when the refund has none. Repeating a refund with the same idempotency
+	// key returns the first one, finishing it when it is still pending.
+	Refund(ctx context.Context, orderID uuid.UUID, refund *Refund, idempotencyKey string) (*Refund, error)
+	GetRefund(ctx context.Context, id uuid.UUID) (*Refund, error)
+	ListRefunds(ctx context.Context, orderID uuid.UUID) ([]*Refund, error)
+	// GetStoreCredit returns a store credit of the merchant of the tenant by
+	// its code, e.g. to check its balance before paying with it
+	GetStoreCredit(ctx context.Context, code string) (*StoreCredit, error)
+}
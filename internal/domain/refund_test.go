@@ -0,0 +1,90 @@
+package domain
+
+import "testing"
+
+func TestOrderRefundAmount(t *testing.T) {
+	tests := []struct {
+		name     string
+		item     *OrderItem
+		quantity int64
+		want     int64
+	}{
+		{name: "whole item", item: &OrderItem{Quantity: 3, GrandTotal: 10000}, quantity: 3, want: 10000},
+		{name: "first unit", item: &OrderItem{Quantity: 3, GrandTotal: 10000}, quantity: 1, want: 3333},
+		{name: "second unit", item: &OrderItem{Quantity: 3, GrandTotal: 10000, RefundedQuantity: 1}, quantity: 1, want: 3334},
+		{name: "last unit", item: &OrderItem{Quantity: 3, GrandTotal: 10000, RefundedQuantity: 2}, quantity: 1, want: 3333},
+		{name: "two units", item: &OrderItem{Quantity: 3, GrandTotal: 10000}, quantity: 2, want: 6667},
+		{name: "zero-priced item", item: &OrderItem{Quantity: 2, GrandTotal: 0}, quantity: 1, want: 0},
+		{name: "nothing refunded", item: &OrderItem{Quantity: 2, GrandTotal: 5000}, quantity: 0, want: 0},
+		{name: "item without quantity", item: &OrderItem{Quantity: 0, GrandTotal: 5000}, quantity: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &Order{Items: []*OrderItem{tt.item}}
+			if got := o.RefundAmount(tt.item, tt.quantity); got != tt.want {
+				t.Errorf("RefundAmount(%d) = %d, want %d", tt.quantity, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderRefundAmountAddsUp(t *testing.T) {
+	tests := []struct {
+		name       string
+		quantity   int64
+		grandTotal int64
+		// batches are the quantities of the partial refunds
+		batches []int64
+	}{
+		{name: "unit by unit", quantity: 7, grandTotal: 10000, batches: []int64{1, 1, 1, 1, 1, 1, 1}},
+		{name: "uneven batches", quantity: 7, grandTotal: 9999, batches: []int64{2, 4, 1}},
+		{name: "discounted to one unit of currency", quantity: 3, grandTotal: 1, batches: []int64{1, 1, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := &OrderItem{Quantity: tt.quantity, GrandTotal: tt.grandTotal}
+			o := &Order{Items: []*OrderItem{item}}
+
+			var refunded int64
+			for _, quantity := range tt.batches {
+				amount := o.RefundAmount(item, quantity)
+				if amount < 0 {
+					t.Fatalf("refund of %d units is negative: %d", quantity, amount)
+				}
+				refunded += amount
+				item.RefundedQuantity += quantity
+			}
+			if refunded != tt.grandTotal {
+				t.Errorf("partial refunds add up to %d, want %d", refunded, tt.grandTotal)
+			}
+		})
+	}
+}
+
+func TestOrderRefundPoints(t *testing.T) {
+	tests := []struct {
+		name         string
+		order        *Order
+		amount       int64
+		wantTaken    int64
+		wantReturned int64
+	}{
+		{name: "whole sale", order: &Order{Total: 10000, PointsEarned: 10, PointsRedeemed: 4}, amount: 10000, wantTaken: 10, wantReturned: 4},
+		{name: "first third", order: &Order{Total: 9000, PointsEarned: 10, PointsRedeemed: 5}, amount: 3000, wantTaken: 3, wantReturned: 2},
+		{name: "second third", order: &Order{Total: 9000, PointsEarned: 10, PointsRedeemed: 5, RefundedTotal: 3000}, amount: 3000, wantTaken: 4, wantReturned: 1},
+		{name: "last third", order: &Order{Total: 9000, PointsEarned: 10, PointsRedeemed: 5, RefundedTotal: 6000}, amount: 3000, wantTaken: 3, wantReturned: 2},
+		{name: "no points", order: &Order{Total: 9000}, amount: 3000, wantTaken: 0, wantReturned: 0},
+		{name: "zero total", order: &Order{PointsEarned: 10}, amount: 0, wantTaken: 0, wantReturned: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taken, returned := tt.order.RefundPoints(tt.amount)
+			if taken != tt.wantTaken || returned != tt.wantReturned {
+				t.Errorf("RefundPoints(%d) = %d, %d, want %d, %d", tt.amount, taken, returned, tt.wantTaken, tt.wantReturned)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Default roles seeded in the database. Admin is assigned to users directly,
+// owner, manager and cashier are the roles of members within a merchant.
+const (
+	// RoleAdmin is the role of back-office administrators
+	RoleAdmin = "admin"
+	// RoleOwner is the role of the owner of a merchant
+	RoleOwner = "owner"
+	// RoleManager is the role of outlet managers
+	RoleManager = "manager"
+	// RoleCashier is the role of cashiers operating the POS
+	RoleCashier = "cashier"
+	// RoleCustomer is the role of customers without staff rights
+	RoleCustomer = "customer"
+)
+
+// Permission is a fine-grained right granted to roles
+type Permission string
+
+const (
+	// PermissionUserRead allows viewing the accounts of other users
+	PermissionUserRead Permission = "user.read"
+	// PermissionUserManage allows changing, deactivating and restoring the accounts of other users
+	PermissionUserManage Permission = "user.manage"
+	// PermissionRoleRead allows viewing the roles and their permissions
+	PermissionRoleRead Permission = "role.read"
+	// PermissionRoleAssign allows assigning roles to and revoking roles from users
+	PermissionRoleAssign Permission = "role.assign"
+	// PermissionMerchantManage allows changing the merchant of the tenant
+	PermissionMerchantManage Permission = "merchant.manage"
+	// PermissionOutletManage allows adding and changing the outlets of the tenant
+	PermissionOutletManage Permission = "outlet.manage"
+	// PermissionStaffManage allows inviting staff to the outlets of the tenant
+	PermissionStaffManage Permission = "staff.manage"
+	// PermissionCatalogRead allows viewing the product catalog of the tenant
+	PermissionCatalogRead Permission = "catalog.read"
+	// PermissionCatalogManage allows changing categories, products, variants and prices
+	PermissionCatalogManage Permission = "catalog.manage"
+	// PermissionInventoryRead allows viewing the stock of the outlets of the tenant
+	PermissionInventoryRead Permission = "inventory.read"
+	// PermissionInventoryManage allows adjusting, receiving, counting and transferring stock
+	PermissionInventoryManage Permission = "inventory.manage"
+	// PermissionOrderRead allows viewing the orders of the outlets of the tenant
+	PermissionOrderRead Permission = "order.read"
+	// PermissionOrderCreate allows ringing up carts and checking them out
+	PermissionOrderCreate Permission = "order.create"
+	// PermissionOrderDiscount allows giving manual discounts on items and orders
+	PermissionOrderDiscount Permission = "order.discount"
+	// PermissionOrderVoid allows voiding carts of other cashiers and completed sales
+	PermissionOrderVoid Permission = "order.void"
+	// PermissionOrderRefund allows refunding items of completed sales
+	PermissionOrderRefund Permission = "order.refund"
+	// PermissionOrderRefundApprove allows refunds above the approval threshold
+	PermissionOrderRefundApprove Permission = "order.refund_approve"
+	// PermissionShiftOperate allows opening and closing own shifts and recording cash in and out
+	PermissionShiftOperate Permission = "shift.operate"
+	// PermissionShiftManage allows viewing and closing the shifts of other cashiers
+	PermissionShiftManage Permission = "shift.manage"
+	// PermissionTaxManage allows configuring the VAT and service charge rules
+	PermissionTaxManage Permission = "tax.manage"
+	// PermissionPromotionManage allows configuring promotions and generating vouchers
+	PermissionPromotionManage Permission = "promotion.manage"
+	// PermissionCustomerManage allows registering customers and viewing their points and purchases
+	PermissionCustomerManage Permission = "customer.manage"
+	// PermissionLoyaltyManage allows configuring the points rules and tiers and adjusting points
+	PermissionLoyaltyManage Permission = "loyalty.manage"
+)
+
+// Role groups the permissions granted to the users it is assigned to
+type Role struct {
+	Name        string       `db:"name"`
+	Description string       `db:"description"`
+	Permissions []Permission `db:"-"`
+}
+
+// RoleRepository represents the role repository contract
+type RoleRepository interface {
+	// List returns every role with its permissions
+	List(ctx context.Context) ([]*Role, error)
+	GetByName(ctx context.Context, name string) (*Role, error)
+	// GetPermissions returns the permissions granted by a role
+	GetPermissions(ctx context.Context, role string) ([]Permission, error)
+	AssignToUser(ctx context.Context, userID uuid.UUID, role string) error
+	RevokeFromUser(ctx context.Context, userID uuid.UUID, role string) error
+}
+
+// AuthorizationService decides what the authenticated caller is allowed to do
+// based on the permissions of its roles
+type AuthorizationService interface {
+	// Authorize succeeds when the caller has all of the given permissions. It
+	// returns an unauthorized error without an authenticated caller and a
+	// forbidden error when a permission is missing.
+	Authorize(ctx context.Context, permissions ...Permission) error
+	// HasPermission reports whether the authenticated caller has the permission
+	HasPermission(ctx context.Context, permission Permission) (bool, error)
+}
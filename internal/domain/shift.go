@@ -0,0 +1,180 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ShiftStatus is the state of a shift
+type ShiftStatus string
+
+const (
+	// ShiftStatusOpen is a shift a cashier is working on
+	ShiftStatusOpen ShiftStatus = "open"
+	// ShiftStatusClosed is a shift whose cash drawer was counted
+	ShiftStatusClosed ShiftStatus = "closed"
+)
+
+// Shift is the time a cashier works the till of an outlet. Sales are
+// attributed to the shift they were checked out in. Amounts are in the
+// smallest unit of the currency.
+type Shift struct {
+	ID         uuid.UUID   `db:"id"`
+	MerchantID uuid.UUID   `db:"merchant_id"`
+	OutletID   uuid.UUID   `db:"outlet_id"`
+	UserID     uuid.UUID   `db:"user_id"`
+	Status     ShiftStatus `db:"status"`
+	// OpeningFloat is the cash put into the drawer when the shift opened
+	OpeningFloat int64 `db:"opening_float"`
+	// ExpectedCash is the cash that should be in the drawer at close, the
+	// opening float plus the cash movements of the shift
+	ExpectedCash sql.NullInt64 `db:"expected_cash"`
+	// CountedCash is the cash counted in the drawer at close and Variance the
+	// counted minus the expected cash
+	CountedCash sql.NullInt64 `db:"counted_cash"`
+	Variance    sql.NullInt64 `db:"variance"`
+	OpeningNote string        `db:"opening_note"`
+	ClosingNote string        `db:"closing_note"`
+	OpenedAt    time.Time     `db:"opened_at"`
+	ClosedAt    sql.NullTime  `db:"closed_at"`
+	ClosedBy    uuid.NullUUID `db:"closed_by"`
+}
+
+// CashMovementType is the reason cash entered or left the drawer
+type CashMovementType string
+
+const (
+	// CashMovementSale is cash received for a sale
+	CashMovementSale CashMovementType = "sale"
+	// CashMovementRefund is cash given back by a refund
+	CashMovementRefund CashMovementType = "refund"
+	// CashMovementVoid is cash given back for a voided sale
+	CashMovementVoid CashMovementType = "void"
+	// CashMovementCashIn is cash put into the drawer, e.g. change from the bank
+	CashMovementCashIn CashMovementType = "cash_in"
+	// CashMovementCashOut is cash taken out of the drawer, e.g. petty cash or
+	// a bank drop
+	CashMovementCashOut CashMovementType = "cash_out"
+)
+
+// CashMovement is an entry of the cash ledger of a shift, the ledger is append-only
+type CashMovement struct {
+	ID         uuid.UUID        `db:"id"`
+	MerchantID uuid.UUID        `db:"merchant_id"`
+	ShiftID    uuid.UUID        `db:"shift_id"`
+	Type       CashMovementType `db:"type"`
+	// Amount is the change of the cash in the drawer, negative when cash leaves
+	Amount int64 `db:"amount"`
+	// ReferenceID points to the order or refund behind the movement
+	ReferenceID uuid.NullUUID `db:"reference_id"`
+	Reason      string        `db:"reason"`
+	CreatedBy   uuid.UUID     `db:"created_by"`
+	CreatedAt   time.Time     `db:"created_at"`
+}
+
+// TenderSummary is the total of the payments of a shift with one method
+type TenderSummary struct {
+	Method PaymentMethod `db:"method"`
+	Count  int64         `db:"count"`
+	Amount int64         `db:"amount"`
+}
+
+// ShiftReport summarizes the sales and the cash drawer of a shift. The report
+// of an open shift is an X report, a snapshot that leaves the shift open, the
+// report of a closed shift is its final Z report.
+type ShiftReport struct {
+	Shift *Shift `db:"-"`
+	// SalesCount and the totals cover the sales checked out in the shift that
+	// were not voided
+	SalesCount         int64 `db:"sales_count"`
+	Subtotal           int64 `db:"subtotal"`
+	DiscountTotal      int64 `db:"discount_total"`
+	ServiceChargeTotal int64 `db:"service_charge_total"`
+	TaxTotal           int64 `db:"tax_total"`
+	Total              int64 `db:"total"`
+	VoidedCount        int64 `db:"voided_count"`
+	VoidedTotal        int64 `db:"voided_total"`
+	// RefundCount and RefundTotal cover the refunds made in the shift
+	RefundCount int64 `db:"refund_count"`
+	RefundTotal int64 `db:"refund_total"`
+	// Tenders are the captured payments of the sales by method
+	Tenders []*TenderSummary `db:"-"`
+
+	// The cash of the drawer by movement type, amounts leaving are negative
+	CashSales    int64 `db:"cash_sales"`
+	CashRefunds  int64 `db:"cash_refunds"`
+	CashVoids    int64 `db:"cash_voids"`
+	CashIn       int64 `db:"cash_in"`
+	CashOut      int64 `db:"cash_out"`
+	ExpectedCash int64 `db:"-"`
+}
+
+// IsFinal reports whether the report is the Z report of a closed shift
+func (r *ShiftReport) IsFinal() bool {
+	return r.Shift.Status == ShiftStatusClosed
+}
+
+// ShiftFilter narrows down a shift listing, zero values leave the
+// corresponding field unfiltered
+type ShiftFilter struct {
+	Status ShiftStatus
+	UserID uuid.UUID
+}
+
+// ShiftPage is one page of shifts
+type ShiftPage struct {
+	Shifts []*Shift
+	// NextCursor is empty on the last page
+	NextCursor string
+}
+
+var (
+	// ErrShiftAlreadyOpen is returned when a user opens a second shift at an outlet
+	ErrShiftAlreadyOpen = errors.New("shift is already open")
+	// ErrShiftClosed is returned when a shift was closed since it was read
+	ErrShiftClosed = errors.New("shift was closed")
+)
+
+// ShiftRepository represents the shift repository contract, every query is
+// scoped to a merchant
+type ShiftRepository interface {
+	// Open stores a new shift, it fails with ErrShiftAlreadyOpen when the
+	// user already has an open shift at the outlet
+	Open(ctx context.Context, shift *Shift) error
+	GetByID(ctx context.Context, merchantID, id uuid.UUID) (*Shift, error)
+	// GetOpen retrieves the open shift of a user at an outlet, nil when there is none
+	GetOpen(ctx context.Context, merchantID, outletID, userID uuid.UUID) (*Shift, error)
+	// List lists the shifts of an outlet newest first, starting after the cursor
+	List(ctx context.Context, merchantID, outletID uuid.UUID, filter ShiftFilter, after *PageCursor, limit int) ([]*Shift, error)
+	// AddCashMovement appends a movement to the cash ledger of an open shift,
+	// it fails with ErrShiftClosed when the shift is closed
+	AddCashMovement(ctx context.Context, movement *CashMovement) error
+	// Close computes the expected cash and the variance of an open shift and
+	// stores the closed shift, it fails with ErrShiftClosed when the shift is
+	// already closed
+	Close(ctx context.Context, shift *Shift) error
+	Report(ctx context.Context, shift *Shift) (*ShiftReport, error)
+}
+
+// ShiftUseCase represents the shift use case contract, every method acts on
+// the outlet of the tenant
+type ShiftUseCase interface {
+	// OpenShift opens a shift for the caller with the cash put into the drawer
+	OpenShift(ctx context.Context, openingFloat int64, note string) (*Shift, error)
+	// GetCurrentShift returns the open shift of the caller
+	GetCurrentShift(ctx context.Context) (*Shift, error)
+	// AddCashMovement records cash put into or taken out of the drawer of the
+	// open shift of the caller
+	AddCashMovement(ctx context.Context, movementType CashMovementType, amount int64, reason string) (*CashMovement, error)
+	// CloseShift closes a shift with the cash counted in the drawer and
+	// returns its Z report
+	CloseShift(ctx context.Context, id uuid.UUID, countedCash int64, note string) (*ShiftReport, error)
+	// GetShiftReport returns the X report of an open shift or the Z report of
+	// a closed one
+	GetShiftReport(ctx context.Context, id uuid.UUID) (*ShiftReport, error)
+	ListShifts(ctx context.Context, filter ShiftFilter, pageSize int, cursor string) (*ShiftPage, error)
+}
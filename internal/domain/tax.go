@@ -0,0 +1,214 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// TaxType is the kind of charge a tax rule adds to a sale
+type TaxType string
+
+const (
+	// TaxTypeServiceCharge is the service charge of a restaurant, a percentage
+	// of the discounted price of every item
+	TaxTypeServiceCharge TaxType = "service_charge"
+	// TaxTypeVAT is value added tax (PPN), levied on the discounted price and
+	// the service charge of every item not exempt from tax
+	TaxTypeVAT TaxType = "vat"
+)
+
+// TaxRule configures a charge of the sales of a merchant. A rule without an
+// outlet applies to every outlet, a rule of an outlet replaces the rule of
+// the same type of the merchant there.
+type TaxRule struct {
+	ID         uuid.UUID     `db:"id"`
+	MerchantID uuid.UUID     `db:"merchant_id"`
+	OutletID   uuid.NullUUID `db:"outlet_id"`
+	Type       TaxType       `db:"type"`
+	// Name is printed on receipts, e.g. PPN or Service
+	Name string          `db:"name"`
+	Rate decimal.Decimal `db:"rate"`
+	// Inclusive VAT is contained in the prices, exclusive VAT is added on top.
+	// Service charges are always added on top.
+	Inclusive bool         `db:"inclusive"`
+	CreatedAt time.Time    `db:"created_at"`
+	UpdatedAt sql.NullTime `db:"updated_at"`
+}
+
+// EffectiveTaxRules returns the rules applying at an outlet, a rule of the
+// outlet over the rule of the same type of the merchant, service charge first
+func EffectiveTaxRules(rules []*TaxRule, outletID uuid.UUID) []*TaxRule {
+	var effective []*TaxRule
+	for _, taxType := range []TaxType{TaxTypeServiceCharge, TaxTypeVAT} {
+		var chosen *TaxRule
+		for _, rule := range rules {
+			if rule.Type != taxType {
+				continue
+			}
+			if rule.OutletID.Valid && rule.OutletID.UUID == outletID {
+				chosen = rule
+				break
+			}
+			if !rule.OutletID.Valid {
+				chosen = rule
+			}
+		}
+		if chosen != nil {
+			effective = append(effective, chosen)
+		}
+	}
+	return effective
+}
+
+// OrderTax is a tax rule applied to an order. The rule is copied when the cart
+// is created, later changes of the rule leave the order untouched.
+type OrderTax struct {
+	OrderID   uuid.UUID       `db:"order_id"`
+	Type      TaxType         `db:"type"`
+	Name      string          `db:"name"`
+	Rate      decimal.Decimal `db:"rate"`
+	Inclusive bool            `db:"inclusive"`
+	// Base is the amount the rate applied to and Amount the charge, the sums
+	// of the items
+	Base   int64 `db:"base"`
+	Amount int64 `db:"amount"`
+}
+
+// NewOrderTaxes copies the rules into the taxes of an order
+func NewOrderTaxes(orderID uuid.UUID, rules []*TaxRule) []*OrderTax {
+	taxes := make([]*OrderTax, 0, len(rules))
+	for _, rule := range rules {
+		taxes = append(taxes, &OrderTax{
+			OrderID:   orderID,
+			Type:      rule.Type,
+			Name:      rule.Name,
+			Rate:      rule.Rate,
+			Inclusive: rule.Inclusive,
+		})
+	}
+	return taxes
+}
+
+// calculateTaxes computes the service charge and the VAT of every item from
+// its net amount, the price after the item discount and its share of the
+// order discount, and adds them up into the taxes. Every item amount is
+// rounded half up once and the order amounts are sums of item amounts, so the
+// breakdowns always add up to the totals.
+func calculateTaxes(items []*OrderItem, taxes []*OrderTax) {
+	var serviceCharge, vat *OrderTax
+	for _, tax := range taxes {
+		tax.Base, tax.Amount = 0, 0
+		switch tax.Type {
+		case TaxTypeServiceCharge:
+			serviceCharge = tax
+		case TaxTypeVAT:
+			vat = tax
+		}
+	}
+
+	for _, item := range items {
+		net := item.Total - item.OrderDiscount
+
+		item.ServiceCharge = 0
+		if serviceCharge != nil {
+			item.ServiceCharge = percentOf(net, serviceCharge.Rate)
+			serviceCharge.Base += net
+			serviceCharge.Amount += item.ServiceCharge
+		}
+		charged := net + item.ServiceCharge
+
+		item.TaxBase, item.TaxAmount, item.GrandTotal = 0, 0, charged
+		if vat == nil || item.TaxExempt {
+			continue
+		}
+		if vat.Inclusive {
+			item.TaxAmount = inclusivePart(charged, vat.Rate)
+			item.TaxBase = charged - item.TaxAmount
+		} else {
+			item.TaxAmount = percentOf(charged, vat.Rate)
+			item.TaxBase = charged
+			item.GrandTotal = charged + item.TaxAmount
+		}
+		vat.Base += item.TaxBase
+		vat.Amount += item.TaxAmount
+	}
+}
+
+// inclusivePart returns the tax contained in an amount that includes tax at
+// percent percent, rounded half up to a whole amount
+func inclusivePart(amount int64, percent decimal.Decimal) int64 {
+	return decimal.NewFromInt(amount).Mul(percent).Div(percent.Add(decimal.NewFromInt(100))).Round(0).IntPart()
+}
+
+// allocate splits amount across the weights in proportion by the largest
+// remainder method: every weight gets the whole part of its share and the
+// units left go one each to the largest fractions, the first weight on ties.
+// The amount is limited to the sum of the weights, so the shares add up to it
+// and every share stays between zero and its weight.
+func allocate(amount int64, weights []int64) []int64 {
+	var whole int64
+	for _, weight := range weights {
+		whole += max(weight, 0)
+	}
+	amount = min(max(amount, 0), whole)
+
+	shares := make([]int64, len(weights))
+	fractions := make([]decimal.Decimal, len(weights))
+	remaining := amount
+	for i, weight := range weights {
+		if weight <= 0 {
+			continue
+		}
+		share, fraction := decimal.NewFromInt(amount).Mul(decimal.NewFromInt(weight)).QuoRem(decimal.NewFromInt(whole), 0)
+		shares[i] = share.IntPart()
+		fractions[i] = fraction
+		remaining -= shares[i]
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return fractions[order[a]].GreaterThan(fractions[order[b]])
+	})
+	for _, i := range order[:remaining] {
+		shares[i]++
+	}
+	return shares
+}
+
+var (
+	// ErrTaxRuleExists is returned when a rule of the same type already exists
+	// for the merchant or the outlet
+	ErrTaxRuleExists = errors.New("tax rule already exists")
+)
+
+// TaxRuleRepository represents the tax rule repository contract, every query
+// is scoped to a merchant
+type TaxRuleRepository interface {
+	// Create stores a new rule, it fails with ErrTaxRuleExists when a rule of
+	// the same type exists for the same outlet or for the whole merchant
+	Create(ctx context.Context, rule *TaxRule) error
+	GetByID(ctx context.Context, merchantID, id uuid.UUID) (*TaxRule, error)
+	// ListByMerchant lists the rules of a merchant and of all its outlets
+	ListByMerchant(ctx context.Context, merchantID uuid.UUID) ([]*TaxRule, error)
+	Update(ctx context.Context, rule *TaxRule) error
+	Delete(ctx context.Context, merchantID, id uuid.UUID) error
+}
+
+// TaxUseCase represents the tax use case contract, every method acts on the
+// merchant of the tenant. Changed rules apply to carts created afterwards.
+type TaxUseCase interface {
+	ListTaxRules(ctx context.Context) ([]*TaxRule, error)
+	CreateTaxRule(ctx context.Context, rule *TaxRule) (*TaxRule, error)
+	// UpdateTaxRule changes the name, the rate and the inclusiveness of a rule
+	UpdateTaxRule(ctx context.Context, rule *TaxRule) (*TaxRule, error)
+	DeleteTaxRule(ctx context.Context, id uuid.UUID) error
+}
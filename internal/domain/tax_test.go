@@ -0,0 +1,174 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestInclusivePart(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  int64
+		percent string
+		want    int64
+	}{
+		{name: "exact", amount: 111000, percent: "11", want: 11000},
+		{name: "rounded half up", amount: 10000, percent: "11", want: 991},
+		{name: "fractional rate", amount: 12000, percent: "12.5", want: 1333},
+		{name: "zero amount", amount: 0, percent: "11", want: 0},
+		{name: "zero rate", amount: 10000, percent: "0", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := inclusivePart(tt.amount, decimal.RequireFromString(tt.percent)); got != tt.want {
+				t.Errorf("inclusivePart(%d, %s) = %d, want %d", tt.amount, tt.percent, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  int64
+		weights []int64
+		want    []int64
+	}{
+		{name: "proportional", amount: 300, weights: []int64{100, 200, 300}, want: []int64{50, 100, 150}},
+		{name: "leftover to the largest fractions", amount: 5, weights: []int64{10, 20, 40}, want: []int64{1, 1, 3}},
+		{name: "ties go to the first weight", amount: 2, weights: []int64{1, 1, 1}, want: []int64{1, 1, 0}},
+		{name: "ties among fractions", amount: 5, weights: []int64{2, 3, 5}, want: []int64{1, 2, 2}},
+		{name: "one unit over equal weights and a zero", amount: 1, weights: []int64{15000, 15000, 0}, want: []int64{1, 0, 0}},
+		{name: "zero weight gets nothing", amount: 100, weights: []int64{0, 300, 100}, want: []int64{0, 75, 25}},
+		{name: "amount above the weights is capped", amount: 7, weights: []int64{1, 2, 3}, want: []int64{1, 2, 3}},
+		{name: "negative amount", amount: -5, weights: []int64{1, 2}, want: []int64{0, 0}},
+		{name: "all weights zero", amount: 5, weights: []int64{0, 0}, want: []int64{0, 0}},
+		{name: "no weights", amount: 5, weights: nil, want: []int64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := allocate(tt.amount, tt.weights)
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("allocate(%d, %v) = %v, want %v", tt.amount, tt.weights, got, tt.want)
+			}
+
+			var sum, whole int64
+			for i, share := range got {
+				if share < 0 || share > max(tt.weights[i], 0) {
+					t.Errorf("share %d = %d is outside [0, %d]", i, share, tt.weights[i])
+				}
+				sum += share
+				whole += max(tt.weights[i], 0)
+			}
+			if want := min(max(tt.amount, 0), whole); sum != want {
+				t.Errorf("shares add up to %d, want %d", sum, want)
+			}
+		})
+	}
+}
+
+func TestCalculateTaxes(t *testing.T) {
+	serviceCharge := func(rate string) *OrderTax {
+		return &OrderTax{Type: TaxTypeServiceCharge, Name: "Service", Rate: decimal.RequireFromString(rate)}
+	}
+	vat := func(rate string, inclusive bool) *OrderTax {
+		return &OrderTax{Type: TaxTypeVAT, Name: "PPN", Rate: decimal.RequireFromString(rate), Inclusive: inclusive}
+	}
+
+	// want holds the service charge, tax base, tax amount and grand total of
+	// every item and the base and amount of every tax
+	type itemAmounts struct{ serviceCharge, taxBase, taxAmount, grandTotal int64 }
+	type taxAmounts struct{ base, amount int64 }
+	tests := []struct {
+		name      string
+		items     []*OrderItem
+		taxes     []*OrderTax
+		wantItems []itemAmounts
+		wantTaxes []taxAmounts
+	}{
+		{
+			name:      "no taxes",
+			items:     []*OrderItem{{Total: 25000}},
+			wantItems: []itemAmounts{{0, 0, 0, 25000}},
+		},
+		{
+			name:      "exclusive VAT",
+			items:     []*OrderItem{{Total: 100000}, {Total: 10000}},
+			taxes:     []*OrderTax{vat("11", false)},
+			wantItems: []itemAmounts{{0, 100000, 11000, 111000}, {0, 10000, 1100, 11100}},
+			wantTaxes: []taxAmounts{{110000, 12100}},
+		},
+		{
+			name:      "inclusive VAT",
+			items:     []*OrderItem{{Total: 111000}, {Total: 10000}},
+			taxes:     []*OrderTax{vat("11", true)},
+			wantItems: []itemAmounts{{0, 100000, 11000, 111000}, {0, 9009, 991, 10000}},
+			wantTaxes: []taxAmounts{{109009, 11991}},
+		},
+		{
+			name:      "service charge with exclusive VAT on top",
+			items:     []*OrderItem{{Total: 100000}},
+			taxes:     []*OrderTax{serviceCharge("5"), vat("11", false)},
+			wantItems: []itemAmounts{{5000, 105000, 11550, 116550}},
+			wantTaxes: []taxAmounts{{100000, 5000}, {105000, 11550}},
+		},
+		{
+			name:      "service charge with inclusive VAT",
+			items:     []*OrderItem{{Total: 100000}},
+			taxes:     []*OrderTax{serviceCharge("10"), vat("11", true)},
+			wantItems: []itemAmounts{{10000, 99099, 10901, 110000}},
+			wantTaxes: []taxAmounts{{100000, 10000}, {99099, 10901}},
+		},
+		{
+			name:      "exempt item pays service charge but no VAT",
+			items:     []*OrderItem{{Total: 100000}, {Total: 20000, TaxExempt: true}},
+			taxes:     []*OrderTax{serviceCharge("5"), vat("11", false)},
+			wantItems: []itemAmounts{{5000, 105000, 11550, 116550}, {1000, 0, 0, 21000}},
+			wantTaxes: []taxAmounts{{120000, 6000}, {105000, 11550}},
+		},
+		{
+			name:      "order discount is taken off before taxes",
+			items:     []*OrderItem{{Total: 50000, OrderDiscount: 10000}},
+			taxes:     []*OrderTax{serviceCharge("5"), vat("11", false)},
+			wantItems: []itemAmounts{{2000, 42000, 4620, 46620}},
+			wantTaxes: []taxAmounts{{40000, 2000}, {42000, 4620}},
+		},
+		{
+			name:      "zero-priced line",
+			items:     []*OrderItem{{Total: 0}, {Total: 10000}},
+			taxes:     []*OrderTax{serviceCharge("5"), vat("11", false)},
+			wantItems: []itemAmounts{{0, 0, 0, 0}, {500, 10500, 1155, 11655}},
+			wantTaxes: []taxAmounts{{10000, 500}, {10500, 1155}},
+		},
+		{
+			name:      "amounts of a previous calculation are reset",
+			items:     []*OrderItem{{Total: 10000, ServiceCharge: 700, TaxBase: 1, TaxAmount: 2, GrandTotal: 3}},
+			taxes:     []*OrderTax{{Type: TaxTypeVAT, Rate: decimal.RequireFromString("11"), Base: 99, Amount: 99}},
+			wantItems: []itemAmounts{{0, 10000, 1100, 11100}},
+			wantTaxes: []taxAmounts{{10000, 1100}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calculateTaxes(tt.items, tt.taxes)
+
+			for i, item := range tt.items {
+				got := itemAmounts{item.ServiceCharge, item.TaxBase, item.TaxAmount, item.GrandTotal}
+				if got != tt.wantItems[i] {
+					t.Errorf("item %d: got %+v, want %+v", i, got, tt.wantItems[i])
+				}
+			}
+			for i, tax := range tt.taxes {
+				got := taxAmounts{tax.Base, tax.Amount}
+				if got != tt.wantTaxes[i] {
+					t.Errorf("tax %s: got %+v, want %+v", tax.Type, got, tt.wantTaxes[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Tenant is the merchant, and optionally the outlet, a request acts on.
+// Every query of merchant owned data is filtered by the MerchantID of the tenant.
+type Tenant struct {
+	MerchantID uuid.UUID
+	// OutletID is uuid.Nil when the request is not bound to an outlet
+	OutletID uuid.UUID
+	// Role is the role of the caller within the merchant
+	Role string
+}
+
+type tenantContextKey struct{}
+
+// ContextWithTenant returns a copy of ctx carrying the tenant of the request
+func ContextWithTenant(ctx context.Context, tenant *Tenant) context.Context {
+	return context.WithValue(ctx, tenantContextKey{}, tenant)
+}
+
+// TenantFromContext returns the tenant of the request, if any
+func TenantFromContext(ctx context.Context) (*Tenant, bool) {
+	tenant, ok := ctx.Value(tenantContextKey{}).(*Tenant)
+	return tenant, ok && tenant != nil
+}
+
+// TenantResolver resolves the tenant a caller selected for a request
+type TenantResolver interface {
+	// ResolveTenant checks that the authenticated caller is a member of the
+	// merchant, and staff of the outlet when outletID is not uuid.Nil
+	ResolveTenant(ctx context.Context, merchantID, outletID uuid.UUID) (*Tenant, error)
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TokenClaims represents the claims carried by a signed access token
+type TokenClaims struct {
+	TokenID   string
+	UserID    uuid.UUID
+	UserName  string
+	AuthType  AuthType
+	Roles     []string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// AuthToken represents the token pair issued to a user after authentication
+type AuthToken struct {
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+	User                  *User
+}
+
+// RefreshToken represents a stored refresh token.
+// Only the hash of the token is persisted; every refresh rotates the token
+// and tokens issued from the same login share a FamilyID.
+type RefreshToken struct {
+	ID        uuid.UUID    `db:"id"`
+	UserID    uuid.UUID    `db:"user_id"`
+	FamilyID  uuid.UUID    `db:"family_id"`
+	TokenHash string       `db:"token_hash"`
+	AuthType  AuthType     `db:"auth_type"`
+	ExpiresAt time.Time    `db:"expires_at"`
+	CreatedAt time.Time    `db:"created_at"`
+	RevokedAt sql.NullTime `db:"revoked_at"`
+}
+
+// TokenService represents the token service contract
+type TokenService interface {
+	// GenerateAccessToken signs a new access token carrying the given claims,
+	// the token ID, issue time and expiry are set by the service
+	GenerateAccessToken(ctx context.Context, claims TokenClaims) (string, time.Time, error)
+
+	// ParseAccessToken verifies the signature and expiry of an access token and returns its claims
+	ParseAccessToken(ctx context.Context, accessToken string) (*TokenClaims, error)
+
+	// GenerateRefreshToken creates a new opaque refresh token and its hash
+	GenerateRefreshToken(ctx context.Context) (token string, tokenHash string, expiresAt time.Time, err error)
+
+	// HashRefreshToken returns the hash under which a refresh token is stored
+	HashRefreshToken(token string) string
+}
+
+// RefreshTokenRepository represents the refresh token repository contract
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, token *RefreshToken) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	// Revoke marks the token as revoked and reports whether it was still active
+	Revoke(ctx context.Context, id uuid.UUID) (bool, error)
+	RevokeFamily(ctx context.Context, familyID uuid.UUID) error
+	// RevokeAllByUserID revokes every active refresh token of the user, signing out all sessions
+	RevokeAllByUserID(ctx context.Context, userID uuid.UUID) error
+}
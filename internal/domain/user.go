@@ -3,6 +3,7 @@ package domain
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,10 +15,14 @@ type User struct {
 	UserName            string       `db:"username"`
 	PasswordHash        string       `db:"password_hash"`
 	Email               string       `db:"email"`
+	EmailVerifiedAt     sql.NullTime `db:"email_verified_at"`
 	WhatsAppNumber      string       `db:"whatsapp_number"`
-	OTPPIN              string       `db:"otp_pin"`
+	WhatsAppVerifiedAt  sql.NullTime `db:"whatsapp_verified_at"`
+	PINHash             string       `db:"pin"`
 	IsActive            bool         `db:"is_active"`
 	FailedLoginAttempts int          `db:"failed_login_attempts"`
+	LockoutCount        int          `db:"lockout_count"`
+	LockedUntil         sql.NullTime `db:"locked_until"`
 	CreatedAt           time.Time    `db:"created_at"`
 	LastLoginAt         sql.NullTime `db:"last_login_at"`
 	PasswordChangedAt   sql.NullTime `db:"password_changed_at"`
@@ -25,6 +30,62 @@ type User struct {
 	DeletedAt           sql.NullTime `db:"deleted_at"`
 }
 
+// IsLocked reports whether the account is temporarily locked at the given time
+func (u *User) IsLocked(now time.Time) bool {
+	return u.LockedUntil.Valid && u.LockedUntil.Time.After(now)
+}
+
+// AwaitsRegistration reports whether the account was registered with a
+// WhatsApp number and never signed in. Such accounts stay inactive until the
+// number is verified, unlike accounts deactivated by an administrator.
+func (u *User) AwaitsRegistration() bool {
+	return !u.IsActive && !u.LastLoginAt.Valid
+}
+
+// VerificationChannels returns the verified channels a verification code can be sent to
+func (u *User) VerificationChannels() []VerificationChannel {
+	var channels []VerificationChannel
+	if u.WhatsAppNumber != "" && u.WhatsAppVerifiedAt.Valid {
+		channels = append(channels, VerificationChannelWhatsApp)
+	}
+	if u.Email != "" && u.EmailVerifiedAt.Valid {
+		channels = append(channels, VerificationChannelEmail)
+	}
+	return channels
+}
+
+// LockoutPolicy defines how repeated failed logins lock an account.
+// Every lockout doubles the lock duration, starting at BaseLockDuration
+// and capped at MaxLockDuration.
+type LockoutPolicy struct {
+	MaxFailedAttempts int
+	BaseLockDuration  time.Duration
+	MaxLockDuration   time.Duration
+}
+
+// LockDurations returns the duration of every lockout in turn, starting at
+// BaseLockDuration and doubling up to MaxLockDuration. The last duration
+// applies to every later lockout.
+func (p LockoutPolicy) LockDurations() []time.Duration {
+	d := min(p.BaseLockDuration, p.MaxLockDuration)
+	durations := []time.Duration{d}
+	for d > 0 && d < p.MaxLockDuration {
+		if d > p.MaxLockDuration/2 {
+			d = p.MaxLockDuration
+		} else {
+			d *= 2
+		}
+		durations = append(durations, d)
+	}
+	return durations
+}
+
+var (
+	// ErrLoginRejected is returned when an account was locked, deactivated or
+	// deleted while a login checked its credential
+	ErrLoginRejected = errors.New("login rejected")
+)
+
 // AuthType defines the type of authenticatiuon (login or registration)
 type AuthType string
 
@@ -35,6 +96,13 @@ const (
 	AuthTypeWhatsApp AuthType = "whatsapp"
 )
 
+// ProfileUpdate holds the profile fields a user changes, nil fields are left unchanged
+type ProfileUpdate struct {
+	UserName       *string
+	Email          *string
+	WhatsAppNumber *string
+}
+
 // UserRepository represents the user repository contract
 type UserRepository interface {
 	Create(ctx context.Context, user *User) error
@@ -44,15 +112,51 @@ type UserRepository interface {
 	GetByWhatsAppNumber(ctx context.Context, whatsAppNumber string) (*User, error)
 	Update(ctx context.Context, user *User) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	GetRoles(ctx context.Context, id uuid.UUID) ([]string, error)
+	// RecordFailedLogin atomically counts a failed login and locks the account
+	// once the policy limit is reached. It returns the lock expiry when locked.
+	RecordFailedLogin(ctx context.Context, id uuid.UUID, policy LockoutPolicy) (sql.NullTime, error)
+	// RecordLogin stores a successful login and clears the failed attempts and
+	// the lockout backoff of an active, unlocked account. It fails with
+	// ErrLoginRejected when the account is locked, inactive or deleted.
+	RecordLogin(ctx context.Context, id uuid.UUID, at time.Time) error
+	// RehashPIN replaces the stored PIN with newHash when it is still oldHash
+	RehashPIN(ctx context.Context, id uuid.UUID, oldHash, newHash string) error
+	Unlock(ctx context.Context, id uuid.UUID) error
+	// List returns up to limit users matching the filter that come after the cursor
+	List(ctx context.Context, filter UserFilter, after *PageCursor, limit int) ([]*User, error)
+	// GetByIDWithDeleted retrieves a user by ID even when the account is soft deleted
+	GetByIDWithDeleted(ctx context.Context, id uuid.UUID) (*User, error)
+	Restore(ctx context.Context, id uuid.UUID) error
 }
 
 // UserUseCase represents the user use case contract
 type UserUseCase interface {
 	Register(ctx context.Context, authType AuthType, username string, params map[string]string) (*User, error)
-	Login(ctx context.Context, authType AuthType, identifier, credential string) (*User, error)
+	VerifyWhatsApp(ctx context.Context, whatsAppNumber, verificationCode string) (*AuthToken, error)
+	Login(ctx context.Context, authType AuthType, identifier, credential string) (*AuthToken, error)
+	RefreshToken(ctx context.Context, refreshToken string) (*AuthToken, error)
+	Logout(ctx context.Context, refreshToken string) error
+	SetPIN(ctx context.Context, pin string) error
+	ChangePIN(ctx context.Context, currentPIN, newPIN string) error
+	RequestPINReset(ctx context.Context, identifier string, channel VerificationChannel) ([]VerificationChannel, error)
+	VerifyPINReset(ctx context.Context, identifier, verificationCode, newPIN string) error
+	// ResetPassword sends a password reset code when the email belongs to an active account.
+	// The outcome is not revealed to the caller, the code is only returned in development mode.
 	ResetPassword(ctx context.Context, email string) (string, error)
 	VerifyPasswordReset(ctx context.Context, email, verificationCode, newPassword string) error
 	GetUserByID(ctx context.Context, id uuid.UUID) (*User, error)
 	UpdateUser(ctx context.Context, user *User) error
 	DeleteUser(ctx context.Context, id uuid.UUID) error
+	UnlockUser(ctx context.Context, id uuid.UUID) error
+
+	// Self-service account management of the authenticated user
+	GetMe(ctx context.Context) (*User, error)
+	// UpdateProfile changes the profile of the caller, a changed email address or
+	// WhatsApp number is unverified until the code sent to it is confirmed
+	UpdateProfile(ctx context.Context, update ProfileUpdate) (*User, error)
+	VerifyEmail(ctx context.Context, verificationCode string) error
+	ChangePassword(ctx context.Context, currentPassword, newPassword string) error
+	// DeleteAccount deletes the account of the caller after confirming its password or PIN
+	DeleteAccount(ctx context.Context, credential string) error
 }
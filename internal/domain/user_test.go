@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestLockoutPolicyLockDurations(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy LockoutPolicy
+		want   []time.Duration
+	}{
+		{
+			name:   "doubles up to the cap",
+			policy: LockoutPolicy{BaseLockDuration: time.Minute, MaxLockDuration: 8 * time.Minute},
+			want:   []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute},
+		},
+		{
+			name:   "last step is cut at the cap",
+			policy: LockoutPolicy{BaseLockDuration: time.Minute, MaxLockDuration: 5 * time.Minute},
+			want:   []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 5 * time.Minute},
+		},
+		{
+			name:   "base at the cap",
+			policy: LockoutPolicy{BaseLockDuration: time.Hour, MaxLockDuration: time.Hour},
+			want:   []time.Duration{time.Hour},
+		},
+		{
+			name:   "base above the cap",
+			policy: LockoutPolicy{BaseLockDuration: 2 * time.Hour, MaxLockDuration: time.Hour},
+			want:   []time.Duration{time.Hour},
+		},
+		{
+			name:   "cap near the largest duration",
+			policy: LockoutPolicy{BaseLockDuration: 1 << 61, MaxLockDuration: 1<<63 - 1},
+			want:   []time.Duration{1 << 61, 1 << 62, 1<<63 - 1},
+		},
+		{
+			name:   "no base",
+			policy: LockoutPolicy{MaxLockDuration: time.Hour},
+			want:   []time.Duration{0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.LockDurations(); !slices.Equal(got, tt.want) {
+				t.Errorf("LockDurations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
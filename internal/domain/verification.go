@@ -2,17 +2,74 @@ package domain
 
 import (
 	"context"
+	"errors"
 	"time"
 )
 
-// VerificationRepository represents the verification repository contract
+// VerificationChannel defines where a verification code is delivered
+type VerificationChannel string
+
+const (
+	// VerificationChannelWhatsApp delivers the code to the registered WhatsApp number
+	VerificationChannelWhatsApp VerificationChannel = "whatsapp"
+	// VerificationChannelEmail delivers the code to the registered email address
+	VerificationChannelEmail VerificationChannel = "email"
+)
+
+// VerificationPurpose defines the flow a verification code was issued for.
+// A code is only valid for the purpose it was issued for.
+type VerificationPurpose string
+
+const (
+	// VerificationPurposePasswordReset is used by the password reset flow
+	VerificationPurposePasswordReset VerificationPurpose = "password_reset"
+	// VerificationPurposePINReset is used by the "forgot PIN" flow
+	VerificationPurposePINReset VerificationPurpose = "pin_reset"
+	// VerificationPurposeWhatsAppVerify is used to confirm a WhatsApp number
+	VerificationPurposeWhatsAppVerify VerificationPurpose = "whatsapp_verify"
+	// VerificationPurposeEmailVerify is used to confirm an email address
+	VerificationPurposeEmailVerify VerificationPurpose = "email_verify"
+)
+
+var (
+	// ErrVerificationCodeNotFound is returned when no code is pending for the identifier and purpose
+	ErrVerificationCodeNotFound = errors.New("verification code not found")
+	// ErrVerificationCodeExpired is returned when the pending code has expired
+	ErrVerificationCodeExpired = errors.New("verification code has expired")
+	// ErrVerificationCodeInvalid is returned when the code does not match the pending code
+	ErrVerificationCodeInvalid = errors.New("invalid verification code")
+	// ErrVerificationCodeAttemptsExceeded is returned when too many wrong codes were
+	// entered, the pending code is invalidated and a new one has to be requested
+	ErrVerificationCodeAttemptsExceeded = errors.New("too many wrong verification codes, please request a new code")
+)
+
+// VerificationPolicy defines how often verification codes can be sent
+type VerificationPolicy struct {
+	// ResendCooldown is the minimum time between two codes for the same identifier
+	ResendCooldown time.Duration
+	// DailyLimit is the number of codes an identifier can receive per day
+	DailyLimit int
+	// IPDailyLimit is the number of codes that can be requested from one client IP per day
+	IPDailyLimit int
+	// MinResponseTime is the minimum duration of requests whose timing must not
+	// reveal whether an account exists
+	MinResponseTime time.Duration
+	// EchoCode returns sent codes to the caller, for development only
+	EchoCode bool
+}
+
+// VerificationRepository represents the verification repository contract.
+// Codes are stored hashed and keyed by identifier plus purpose.
 type VerificationRepository interface {
-	// StoreVerificationCode stores a verification code for the given email with an expiration time
-	StoreVerificationCode(ctx context.Context, email, code string, expiration time.Duration) error
-	
-	// GetVerificationCode retrieves a verification code for the given email
-	GetVerificationCode(ctx context.Context, email string) (string, error)
-	
-	// DeleteVerificationCode deletes a verification code for the given email
-	DeleteVerificationCode(ctx context.Context, email string) error
+	// StoreVerificationCode stores a verification code for the identifier and purpose,
+	// replacing any code that is still pending
+	StoreVerificationCode(ctx context.Context, purpose VerificationPurpose, identifier, code string, expiration time.Duration) error
+
+	// ConsumeVerificationCode checks a verification code and consumes it on success so it
+	// can only be used once. A wrong code increments the attempt counter of the pending code,
+	// once the maximum number of attempts is reached the code is invalidated.
+	ConsumeVerificationCode(ctx context.Context, purpose VerificationPurpose, identifier, code string) error
+
+	// DeleteVerificationCode deletes the pending verification code for the identifier and purpose
+	DeleteVerificationCode(ctx context.Context, purpose VerificationPurpose, identifier string) error
 }
@@ -0,0 +1,11 @@
+package domain
+
+import "context"
+
+// WhatsAppService represents the WhatsApp messaging service contract
+type WhatsAppService interface {
+	// SendVerificationCode sends a verification code to the specified WhatsApp number
+	SendVerificationCode(ctx context.Context, whatsAppNumber, code string) error
+	// SendReceipt sends a receipt to the specified WhatsApp number
+	SendReceipt(ctx context.Context, whatsAppNumber string, receipt *ReceiptMessage) error
+}
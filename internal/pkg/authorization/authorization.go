@@ -0,0 +1,122 @@
+package authorization
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"sync"
+	"time"
+)
+
+// cachedPermissions holds the permissions of a role until they expire
+type cachedPermissions struct {
+	permissions map[domain.Permission]struct{}
+	expiresAt   time.Time
+}
+
+// Service implements the domain.AuthorizationService interface.
+// The roles of the caller are taken from its access token, plus its role within
+// the merchant of the request. The permissions of every role are read from the
+// database and kept in memory for cacheTTL.
+type Service struct {
+	roleRepo domain.RoleRepository
+	cacheTTL time.Duration
+
+	mu    sync.Mutex
+	cache map[string]cachedPermissions
+}
+
+// NewAuthorizationService creates a new authorization service
+func NewAuthorizationService(roleRepo domain.RoleRepository, cacheTTL time.Duration) *Service {
+	return &Service{
+		roleRepo: roleRepo,
+		cacheTTL: cacheTTL,
+		cache:    make(map[string]cachedPermissions),
+	}
+}
+
+// Authorize succeeds when the caller has all of the given permissions
+func (s *Service) Authorize(ctx context.Context, permissions ...domain.Permission) error {
+	ctx, span := apm.GetTracer().Start(ctx, "pkg.authorization.Authorize")
+	defer span.End()
+
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return appErrors.NewUnauthorizedError("unauthenticated", nil)
+	}
+
+	for _, permission := range permissions {
+		granted, err := s.isGranted(ctx, principal, permission)
+		if err != nil {
+			return err
+		}
+		if !granted {
+			return appErrors.NewForbiddenError("permission denied", nil)
+		}
+	}
+
+	return nil
+}
+
+// HasPermission reports whether the caller has the permission
+func (s *Service) HasPermission(ctx context.Context, permission domain.Permission) (bool, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "pkg.authorization.HasPermission")
+	defer span.End()
+
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return false, nil
+	}
+	return s.isGranted(ctx, principal, permission)
+}
+
+// isGranted reports whether one of the roles of the principal grants the permission
+func (s *Service) isGranted(ctx context.Context, principal *domain.Principal, permission domain.Permission) (bool, error) {
+	roles := principal.Roles
+	if tenant, ok := domain.TenantFromContext(ctx); ok && tenant.Role != "" {
+		roles = append(roles[:len(roles):len(roles)], tenant.Role)
+	}
+
+	for _, role := range roles {
+		permissions, err := s.rolePermissions(ctx, role)
+		if err != nil {
+			return false, err
+		}
+		if _, ok := permissions[permission]; ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// rolePermissions returns the permissions of a role, from the cache while it is fresh
+func (s *Service) rolePermissions(ctx context.Context, role string) (map[domain.Permission]struct{}, error) {
+	now := time.Now()
+
+	s.mu.Lock()
+	cached, ok := s.cache[role]
+	s.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.permissions, nil
+	}
+
+	permissions, err := s.roleRepo.GetPermissions(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+
+	cached = cachedPermissions{
+		permissions: make(map[domain.Permission]struct{}, len(permissions)),
+		expiresAt:   now.Add(s.cacheTTL),
+	}
+	for _, permission := range permissions {
+		cached.permissions[permission] = struct{}{}
+	}
+
+	s.mu.Lock()
+	s.cache[role] = cached
+	s.mu.Unlock()
+
+	return cached.permissions, nil
+}
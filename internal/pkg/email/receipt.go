@@ -0,0 +1,86 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/smtp"
+	"net/textproto"
+)
+
+// SendReceipt sends a receipt as a plain text email with the rendered receipt
+// attached
+func (s *Service) SendReceipt(ctx context.Context, email string, receipt *domain.ReceiptMessage) error {
+	ctx, span := apm.GetTracer().Start(ctx, "pkg.email.SendReceipt")
+	defer span.End()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	// Plain text part, the receipt is laid out in a fixed number of columns
+	textPart, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {`text/plain; charset="UTF-8"`},
+		"Content-Transfer-Encoding": {"base64"},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to compose email: %w", err)
+	}
+	if err := writeBase64(textPart, []byte(receipt.Text)); err != nil {
+		return fmt.Errorf("failed to compose email: %w", err)
+	}
+
+	if attachment := receipt.Attachment; attachment != nil {
+		attachmentPart, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {attachment.ContentType},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName})},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to compose email: %w", err)
+		}
+		if err := writeBase64(attachmentPart, attachment.Content); err != nil {
+			return fmt.Errorf("failed to compose email: %w", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to compose email: %w", err)
+	}
+
+	// Compose message
+	var message bytes.Buffer
+	fmt.Fprintf(&message, "To: %s\r\n", email)
+	fmt.Fprintf(&message, "From: %s <%s>\r\n", mime.QEncoding.Encode("UTF-8", s.config.SenderName), s.config.SenderEmail)
+	fmt.Fprintf(&message, "Subject: %s\r\n", mime.QEncoding.Encode("UTF-8", receipt.Subject))
+	fmt.Fprintf(&message, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&message, "Content-Type: multipart/mixed; boundary=%q\r\n\r\n", writer.Boundary())
+	message.Write(body.Bytes())
+
+	// Send email
+	auth := smtp.PlainAuth("", s.config.SenderEmail, s.config.SMTPPassword, s.config.SMTPHost)
+	addr := fmt.Sprintf("%s:%s", s.config.SMTPHost, s.config.SMTPPort)
+	if err := smtp.SendMail(addr, auth, s.config.SenderEmail, []string{email}, message.Bytes()); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return nil
+}
+
+// writeBase64 writes content base64 encoded in lines of 76 characters as
+// required by MIME
+func writeBase64(w io.Writer, content []byte) error {
+	encoded := base64.StdEncoding.EncodeToString(content)
+	for len(encoded) > 0 {
+		n := min(76, len(encoded))
+		if _, err := fmt.Fprintf(w, "%s\r\n", encoded[:n]); err != nil {
+			return err
+		}
+		encoded = encoded[n:]
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package errors
+
+import "github.com/google/uuid"
+
+// ParseID parses an ID of a request, its format is checked by the validation rules
+func ParseID(field, value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, NewFieldValidationError(field, "invalid "+field)
+	}
+	return id, nil
+}
+
+// ParseOptionalID parses an ID of a request that may be empty
+func ParseOptionalID(field, value string) (uuid.NullUUID, error) {
+	if value == "" {
+		return uuid.NullUUID{}, nil
+	}
+	id, err := ParseID(field, value)
+	if err != nil {
+		return uuid.NullUUID{}, err
+	}
+	return uuid.NullUUID{UUID: id, Valid: true}, nil
+}
@@ -1,12 +1,6 @@
 package errors
 
-import (
-	"context"
-	"errors"
-	"strings"
-
-	"go.opentelemetry.io/otel/trace"
-)
+import "strings"
 
 // ErrorCategory defines the category of an error
 type ErrorCategory int
@@ -26,13 +20,28 @@ const (
 	CategoryBadRequest
 	// CategoryInternal represents internal server errors
 	CategoryInternal
+	// CategoryAccountLocked represents errors for temporarily locked accounts
+	CategoryAccountLocked
+	// CategoryTooManyRequests represents rate limited requests
+	CategoryTooManyRequests
+	// CategoryInsufficientStock represents stock changes that would make stock negative
+	CategoryInsufficientStock
+	// CategoryConflict represents requests conflicting with the current state of a resource
+	CategoryConflict
 )
 
+// FieldViolation describes why a single request field is invalid
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
 // AppError represents an application error with category
 type AppError struct {
-	Category ErrorCategory
-	Message  string
-	Err      error
+	Category   ErrorCategory
+	Message    string
+	Err        error
+	Violations []FieldViolation
 }
 
 // Error implements the error interface
@@ -60,6 +69,29 @@ func NewValidationError(message string, err error) error {
 	}
 }
 
+// NewFieldValidationError creates a new validation error for a single request field
+func NewFieldValidationError(field, message string) error {
+	return &AppError{
+		Category:   CategoryValidation,
+		Message:    message,
+		Violations: []FieldViolation{{Field: field, Description: message}},
+	}
+}
+
+// NewFieldViolationsError creates a new validation error for several invalid request fields
+func NewFieldViolationsError(violations []FieldViolation) error {
+	descriptions := make([]string, 0, len(violations))
+	for _, v := range violations {
+		descriptions = append(descriptions, v.Field+": "+v.Description)
+	}
+
+	return &AppError{
+		Category:   CategoryValidation,
+		Message:    "invalid request: " + strings.Join(descriptions, "; "),
+		Violations: violations,
+	}
+}
+
 // NewNotFoundError creates a new not found error
 func NewNotFoundError(message string, err error) error {
 	return &AppError{
@@ -114,51 +146,38 @@ func NewInternalError(message string, err error) error {
 	}
 }
 
-// HandleResponseError maps error messages to appropriate response messages
-// and logs internal errors to the provided span
-func HandleResponseError(ctx context.Context, span trace.Span, err error) string {
-	if err == nil {
-		return ""
-	}
-
-	// Try to unwrap as AppError
-	var appErr *AppError
-	if errors.As(err, &appErr) {
-		// For internal errors, don't expose details
-		if appErr.Category == CategoryInternal {
-			if span != nil {
-				span.RecordError(err)
-			}
-			return "Operation failed, please try again later"
-		}
-		// For other categories, return the message
-		return appErr.Error()
-	}
-
-	// Legacy error handling for errors not wrapped in AppError
-	errMsg := err.Error()
-	
-	// Try to categorize based on error message
-	switch {
-	case strings.Contains(errMsg, "already exists"):
-		return errMsg
-	case strings.Contains(errMsg, "not found"):
-		return errMsg
-	case strings.Contains(errMsg, "is required") || 
-	     strings.Contains(errMsg, "invalid") || 
-	     strings.Contains(errMsg, "must be"):
-		return errMsg
-	case strings.Contains(errMsg, "unauthorized") || 
-	     strings.Contains(errMsg, "unauthenticated"):
-		return errMsg
-	case strings.Contains(errMsg, "forbidden") || 
-	     strings.Contains(errMsg, "permission denied"):
-		return errMsg
-	default:
-		// Log the internal error but don't expose details to client
-		if span != nil {
-			span.RecordError(err)
-		}
-		return "Operation failed, please try again later"
+// NewAccountLockedError creates a new account locked error
+func NewAccountLockedError(message string, err error) error {
+	return &AppError{
+		Category: CategoryAccountLocked,
+		Message:  message,
+		Err:      err,
+	}
+}
+
+// NewTooManyRequestsError creates a new too many requests error
+func NewTooManyRequestsError(message string, err error) error {
+	return &AppError{
+		Category: CategoryTooManyRequests,
+		Message:  message,
+		Err:      err,
+	}
+}
+
+// NewInsufficientStockError creates a new insufficient stock error
+func NewInsufficientStockError(message string, err error) error {
+	return &AppError{
+		Category: CategoryInsufficientStock,
+		Message:  message,
+		Err:      err,
+	}
+}
+
+// NewConflictError creates a new conflict error
+func NewConflictError(message string, err error) error {
+	return &AppError{
+		Category: CategoryConflict,
+		Message:  message,
+		Err:      err,
 	}
 }
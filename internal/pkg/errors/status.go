@@ -0,0 +1,150 @@
+package errors
+
+import (
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// ErrorDomain is the domain reported in the ErrorInfo details of a status
+const ErrorDomain = "kijunpos"
+
+// internalErrorMessage is returned instead of the details of internal errors
+const internalErrorMessage = "Operation failed, please try again later"
+
+// Code returns the gRPC status code of the category
+func (c ErrorCategory) Code() codes.Code {
+	switch c {
+	case CategoryValidation, CategoryBadRequest:
+		return codes.InvalidArgument
+	case CategoryNotFound:
+		return codes.NotFound
+	case CategoryDuplicate:
+		return codes.AlreadyExists
+	case CategoryUnauthorized:
+		return codes.Unauthenticated
+	case CategoryForbidden:
+		return codes.PermissionDenied
+	case CategoryAccountLocked, CategoryInsufficientStock, CategoryConflict:
+		return codes.FailedPrecondition
+	case CategoryTooManyRequests:
+		return codes.ResourceExhausted
+	default:
+		return codes.Internal
+	}
+}
+
+// Reason returns the machine readable reason of the category
+func (c ErrorCategory) Reason() string {
+	switch c {
+	case CategoryValidation:
+		return "VALIDATION_FAILED"
+	case CategoryNotFound:
+		return "NOT_FOUND"
+	case CategoryDuplicate:
+		return "ALREADY_EXISTS"
+	case CategoryUnauthorized:
+		return "UNAUTHENTICATED"
+	case CategoryForbidden:
+		return "PERMISSION_DENIED"
+	case CategoryBadRequest:
+		return "BAD_REQUEST"
+	case CategoryAccountLocked:
+		return "ACCOUNT_LOCKED"
+	case CategoryTooManyRequests:
+		return "TOO_MANY_REQUESTS"
+	case CategoryInsufficientStock:
+		return "INSUFFICIENT_STOCK"
+	case CategoryConflict:
+		return "CONFLICT"
+	default:
+		return "INTERNAL"
+	}
+}
+
+// IsInternal reports whether err is reported to clients as an internal error,
+// which is the case for every error that is neither an AppError nor a gRPC status
+func IsInternal(err error) bool {
+	var appErr *AppError
+	if errors.As(err, &appErr) {
+		return appErr.Category == CategoryInternal
+	}
+	_, ok := status.FromError(err)
+	return !ok
+}
+
+// ToStatus converts an error into a gRPC status carrying the error reason,
+// field violations and request ID as error details. Errors that are already
+// a gRPC status keep their code and message, internal errors are reported
+// without their details.
+func ToStatus(err error, requestID string) *status.Status {
+	var appErr *AppError
+	if !errors.As(err, &appErr) {
+		if st, ok := status.FromError(err); ok {
+			if len(st.Details()) > 0 {
+				return st
+			}
+			return withDetails(st, reasonOfCode(st.Code()), nil, requestID)
+		}
+		appErr = &AppError{Category: CategoryInternal, Err: err}
+	}
+
+	message := appErr.Error()
+	if appErr.Category == CategoryInternal {
+		message = internalErrorMessage
+	}
+
+	st := status.New(appErr.Category.Code(), message)
+	return withDetails(st, appErr.Category.Reason(), appErr.Violations, requestID)
+}
+
+// withDetails returns st with ErrorInfo, BadRequest and RequestInfo details attached
+func withDetails(st *status.Status, reason string, violations []FieldViolation, requestID string) *status.Status {
+	details := []protoadapt.MessageV1{
+		&errdetails.ErrorInfo{Reason: reason, Domain: ErrorDomain},
+	}
+
+	if len(violations) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, v := range violations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+		details = append(details, badRequest)
+	}
+
+	if requestID != "" {
+		details = append(details, &errdetails.RequestInfo{RequestId: requestID})
+	}
+
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+	return detailed
+}
+
+// reasonOfCode returns the reason reported for a status created outside of AppError
+func reasonOfCode(code codes.Code) string {
+	switch code {
+	case codes.InvalidArgument:
+		return CategoryValidation.Reason()
+	case codes.NotFound:
+		return CategoryNotFound.Reason()
+	case codes.AlreadyExists:
+		return CategoryDuplicate.Reason()
+	case codes.Unauthenticated:
+		return CategoryUnauthorized.Reason()
+	case codes.PermissionDenied:
+		return CategoryForbidden.Reason()
+	case codes.ResourceExhausted:
+		return CategoryTooManyRequests.Reason()
+	default:
+		return code.String()
+	}
+}
@@ -0,0 +1,66 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultPageSize is used when the client does not ask for a page size
+	DefaultPageSize = 20
+	// MaxPageSize is the largest page a client can ask for
+	MaxPageSize = 100
+)
+
+// PageSize returns the requested page size limited to MaxPageSize, or
+// DefaultPageSize when none was requested
+func PageSize(requested int) int {
+	switch {
+	case requested <= 0:
+		return DefaultPageSize
+	case requested > MaxPageSize:
+		return MaxPageSize
+	default:
+		return requested
+	}
+}
+
+// EncodeCursor encodes a list position into an opaque page cursor
+func EncodeCursor(cursor domain.PageCursor) string {
+	raw := fmt.Sprintf("%d:%s", cursor.CreatedAt.UnixNano(), cursor.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor decodes a page cursor created by EncodeCursor, an empty cursor
+// stands for the first page and decodes to nil
+func DecodeCursor(cursor string) (*domain.PageCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, fmt.Errorf("malformed cursor")
+	}
+	nanos, err := strconv.ParseInt(createdAt, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	parsedID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.PageCursor{CreatedAt: time.Unix(0, nanos), ID: parsedID}, nil
+}
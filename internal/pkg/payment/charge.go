@@ -0,0 +1,78 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"time"
+)
+
+// Charge authorizes a new payment with the gateway of its method and captures
+// it right away when the gateway approves it at once. The state reported by
+// the gateway is copied into the payment.
+func Charge(ctx context.Context, gateways domain.PaymentGateways, payment *domain.Payment) error {
+	gateway, ok := gateways.ForMethod(payment.Method)
+	if !ok {
+		return appErrors.NewBadRequestError(fmt.Sprintf("payment method %s is not available", payment.Method), nil)
+	}
+
+	result, err := gateway.Authorize(ctx, &domain.PaymentRequest{
+		PaymentID: payment.ID,
+		OrderID:   payment.OrderID,
+		Method:    payment.Method,
+		Amount:    payment.Amount,
+	})
+	if err != nil {
+		return err
+	}
+	if result.Status == domain.PaymentStatusAuthorized {
+		if result, err = gateway.Capture(ctx, result.Reference, payment.Amount); err != nil {
+			return err
+		}
+	}
+
+	payment.Provider = gateway.Name()
+	payment.ProviderReference = result.Reference
+	payment.Status = result.Status
+	payment.QRString = result.QRString
+	if !result.ExpiresAt.IsZero() {
+		payment.ExpiresAt.Time = result.ExpiresAt
+		payment.ExpiresAt.Valid = true
+	}
+	return nil
+}
+
+// Refund gives a captured payment back, or cancels a payment still waiting
+// for the customer, through the gateway that handled it
+func Refund(ctx context.Context, gateways domain.PaymentGateways, payment *domain.Payment) error {
+	result, err := RefundPart(ctx, gateways, payment, payment.Amount)
+	if err != nil {
+		return err
+	}
+
+	payment.Status = result.Status
+	payment.UpdatedAt.Time = time.Now()
+	payment.UpdatedAt.Valid = true
+	return nil
+}
+
+// RefundPart gives amount of a captured payment back through the gateway that
+// handled it and returns the state the gateway reports, which stays captured
+// while part of the payment is left. The payment itself is not changed.
+func RefundPart(ctx context.Context, gateways domain.PaymentGateways, payment *domain.Payment, amount int64) (*domain.PaymentResult, error) {
+	gateway, ok := gateways.ByName(payment.Provider)
+	if !ok {
+		return nil, fmt.Errorf("payment gateway %s is not registered", payment.Provider)
+	}
+	return gateway.Refund(ctx, payment.ProviderReference, amount)
+}
+
+// Status asks the gateway that handled a payment for its state
+func Status(ctx context.Context, gateways domain.PaymentGateways, payment *domain.Payment) (*domain.PaymentResult, error) {
+	gateway, ok := gateways.ByName(payment.Provider)
+	if !ok {
+		return nil, fmt.Errorf("payment gateway %s is not registered", payment.Provider)
+	}
+	return gateway.Status(ctx, payment.ProviderReference)
+}
@@ -0,0 +1,41 @@
+package payment
+
+import (
+	"github/kijunpos/internal/domain"
+)
+
+// Gateways implements the domain.PaymentGateways interface, routing every
+// payment method to one gateway
+type Gateways struct {
+	byMethod map[domain.PaymentMethod]domain.PaymentGateway
+	byName   map[string]domain.PaymentGateway
+}
+
+// NewGateways creates an empty set of gateways
+func NewGateways() *Gateways {
+	return &Gateways{
+		byMethod: make(map[domain.PaymentMethod]domain.PaymentGateway),
+		byName:   make(map[string]domain.PaymentGateway),
+	}
+}
+
+// Register routes the payment methods to the gateway
+func (g *Gateways) Register(gateway domain.PaymentGateway, methods ...domain.PaymentMethod) *Gateways {
+	g.byName[gateway.Name()] = gateway
+	for _, method := range methods {
+		g.byMethod[method] = gateway
+	}
+	return g
+}
+
+// ForMethod returns the gateway collecting payments of the method
+func (g *Gateways) ForMethod(method domain.PaymentMethod) (domain.PaymentGateway, bool) {
+	gateway, ok := g.byMethod[method]
+	return gateway, ok
+}
+
+// ByName returns the gateway with the name
+func (g *Gateways) ByName(name string) (domain.PaymentGateway, bool) {
+	gateway, ok := g.byName[name]
+	return gateway, ok
+}
@@ -0,0 +1,226 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// ProviderHTTP talks to a QRIS provider over its HTTP API
+	ProviderHTTP = "http"
+	// ProviderSimulator runs a QRISSimulator inside the application and talks to it
+	ProviderSimulator = "simulator"
+)
+
+// SignatureHeader is the header carrying the signature of a notification
+const SignatureHeader = "X-Callback-Signature"
+
+// QRISConfig holds the configuration for the QRIS gateway
+type QRISConfig struct {
+	APIURL string
+	APIKey string
+	// WebhookSecret is shared with the provider to sign notifications
+	WebhookSecret string
+	// Expiry is how long a QR code can be paid
+	Expiry  time.Duration
+	Timeout time.Duration
+}
+
+// QRISGateway implements the domain.PaymentGateway and domain.PaymentNotifier
+// interfaces on top of the HTTP API of a QRIS provider. A charge shows a
+// dynamic QR code the customer pays from any bank or e-wallet app, the
+// provider confirms the payment with a notification.
+type QRISGateway struct {
+	config QRISConfig
+	client *http.Client
+}
+
+// NewQRISGateway creates a new QRIS gateway
+func NewQRISGateway(config QRISConfig) *QRISGateway {
+	return &QRISGateway{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}
+}
+
+type (
+	chargeRequest struct {
+		ReferenceID string `json:"reference_id"`
+		Amount      int64  `json:"amount"`
+		Channel     string `json:"channel"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+
+	refundRequest struct {
+		Amount int64 `json:"amount"`
+	}
+
+	charge struct {
+		ID             string    `json:"id"`
+		ReferenceID    string    `json:"reference_id"`
+		Amount         int64     `json:"amount"`
+		RefundedAmount int64     `json:"refunded_amount"`
+		Channel        string    `json:"channel"`
+		Status         string    `json:"status"`
+		QRString       string    `json:"qr_string"`
+		ExpiresAt      time.Time `json:"expires_at"`
+	}
+
+	notification struct {
+		ID          string `json:"id"`
+		ReferenceID string `json:"reference_id"`
+		Status      string `json:"status"`
+	}
+)
+
+// Statuses of a charge at the provider
+const (
+	chargeStatusPending   = "pending"
+	chargeStatusPaid      = "paid"
+	chargeStatusExpired   = "expired"
+	chargeStatusCancelled = "cancelled"
+	chargeStatusRefunded  = "refunded"
+)
+
+// paymentStatus converts the status of a charge into a payment status
+func paymentStatus(status string) (domain.PaymentStatus, error) {
+	switch status {
+	case chargeStatusPending:
+		return domain.PaymentStatusPending, nil
+	case chargeStatusPaid:
+		return domain.PaymentStatusCaptured, nil
+	case chargeStatusExpired:
+		return domain.PaymentStatusFailed, nil
+	case chargeStatusCancelled:
+		return domain.PaymentStatusVoided, nil
+	case chargeStatusRefunded:
+		return domain.PaymentStatusRefunded, nil
+	default:
+		return "", fmt.Errorf("unknown qris charge status %q", status)
+	}
+}
+
+// Name returns the name of the gateway
+func (g *QRISGateway) Name() string {
+	return "qris"
+}
+
+// Authorize creates a charge and returns it pending with its QR code
+func (g *QRISGateway) Authorize(ctx context.Context, req *domain.PaymentRequest) (*domain.PaymentResult, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "pkg.payment.QRISGateway.Authorize")
+	defer span.End()
+
+	return g.do(ctx, http.MethodPost, "/v1/charges", chargeRequest{
+		ReferenceID: req.PaymentID.String(),
+		Amount:      req.Amount,
+		Channel:     string(req.Method),
+		ExpiresIn:   int64(g.config.Expiry / time.Second),
+	})
+}
+
+// Capture returns the state of the charge, a QRIS charge is captured once the
+// customer pays it
+func (g *QRISGateway) Capture(ctx context.Context, reference string, amount int64) (*domain.PaymentResult, error) {
+	return g.Status(ctx, reference)
+}
+
+// Refund refunds a paid charge or cancels a charge that was not paid yet
+func (g *QRISGateway) Refund(ctx context.Context, reference string, amount int64) (*domain.PaymentResult, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "pkg.payment.QRISGateway.Refund")
+	defer span.End()
+
+	return g.do(ctx, http.MethodPost, "/v1/charges/"+url.PathEscape(reference)+"/refunds", refundRequest{Amount: amount})
+}
+
+// Status returns the state of a charge
+func (g *QRISGateway) Status(ctx context.Context, reference string) (*domain.PaymentResult, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "pkg.payment.QRISGateway.Status")
+	defer span.End()
+
+	return g.do(ctx, http.MethodGet, "/v1/charges/"+url.PathEscape(reference), nil)
+}
+
+// ParseNotification checks the signature of a notification and parses it
+func (g *QRISGateway) ParseNotification(payload []byte, signature string) (*domain.PaymentNotification, error) {
+	if !hmac.Equal([]byte(Sign(g.config.WebhookSecret, payload)), []byte(signature)) {
+		return nil, domain.ErrInvalidNotificationSignature
+	}
+
+	var body notification
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil, fmt.Errorf("failed to parse qris notification: %w", err)
+	}
+	status, err := paymentStatus(body.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.PaymentNotification{Reference: body.ID, Status: status}, nil
+}
+
+// Sign returns the signature of a notification payload
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// do sends a request to the provider and converts the returned charge
+func (g *QRISGateway) do(ctx context.Context, method, path string, body interface{}) (*domain.PaymentResult, error) {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compose qris request: %w", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(g.config.APIURL, "/")+path, reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create qris request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+g.config.APIKey)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send qris request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("qris request failed: status %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+	}
+
+	var result charge
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to parse qris response: %w", err)
+	}
+	status, err := paymentStatus(result.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.PaymentResult{
+		Status:    status,
+		Reference: result.ID,
+		QRString:  result.QRString,
+		ExpiresAt: result.ExpiresAt,
+	}, nil
+}
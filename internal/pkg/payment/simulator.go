@@ -0,0 +1,249 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github/kijunpos/internal/pkg/logger"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// defaultExpiry is the expiry of a charge created without one
+const defaultExpiry = 15 * time.Minute
+
+// errChargeNotFound is returned for an unknown charge
+var errChargeNotFound = errors.New("charge not found")
+
+// QRISSimulator is a fake QRIS provider serving the API the QRIS gateway
+// talks to, so payments can be tried out without a provider account. Charges
+// are kept in memory. Nobody scans the QR codes, a charge is paid by calling
+// POST /v1/charges/{id}/pay, which sends a signed notification to the webhook.
+type QRISSimulator struct {
+	apiKey        string
+	webhookURL    string
+	webhookSecret string
+	client        *http.Client
+
+	mutex   sync.Mutex
+	charges map[string]*charge
+}
+
+// NewQRISSimulator creates a new QRIS simulator sending notifications to webhookURL
+func NewQRISSimulator(apiKey, webhookURL, webhookSecret string) *QRISSimulator {
+	return &QRISSimulator{
+		apiKey:        apiKey,
+		webhookURL:    webhookURL,
+		webhookSecret: webhookSecret,
+		client:        &http.Client{Timeout: 10 * time.Second},
+		charges:       make(map[string]*charge),
+	}
+}
+
+// Handler returns the HTTP handler serving the provider API
+func (s *QRISSimulator) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/charges", s.authenticated(s.createCharge))
+	mux.HandleFunc("GET /v1/charges/{id}", s.authenticated(s.getCharge))
+	mux.HandleFunc("POST /v1/charges/{id}/refunds", s.authenticated(s.refundCharge))
+	mux.HandleFunc("POST /v1/charges/{id}/pay", s.payCharge)
+	return mux
+}
+
+// ListenAndServe serves the provider API on the port
+func (s *QRISSimulator) ListenAndServe(port int) error {
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return server.ListenAndServe()
+}
+
+// Pay pays a pending charge as if the customer scanned its QR code
+func (s *QRISSimulator) Pay(ctx context.Context, id string) error {
+	s.mutex.Lock()
+	current, ok := s.charges[id]
+	if !ok {
+		s.mutex.Unlock()
+		return errChargeNotFound
+	}
+	s.expire(current)
+	if current.Status != chargeStatusPending {
+		s.mutex.Unlock()
+		return fmt.Errorf("charge is %s", current.Status)
+	}
+	current.Status = chargeStatusPaid
+	paid := *current
+	s.mutex.Unlock()
+
+	s.notify(ctx, &paid)
+	return nil
+}
+
+// authenticated rejects requests without the API key
+func (s *QRISSimulator) authenticated(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+s.apiKey {
+			http.Error(w, "invalid api key", http.StatusUnauthorized)
+			return
+		}
+		next(w, r)
+	}
+}
+
+func (s *QRISSimulator) createCharge(w http.ResponseWriter, r *http.Request) {
+	var req chargeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Amount <= 0 || req.ReferenceID == "" {
+		http.Error(w, "invalid charge", http.StatusBadRequest)
+		return
+	}
+	expiry := time.Duration(req.ExpiresIn) * time.Second
+	if expiry <= 0 {
+		expiry = defaultExpiry
+	}
+
+	id := "qr_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	created := &charge{
+		ID:          id,
+		ReferenceID: req.ReferenceID,
+		Amount:      req.Amount,
+		Channel:     req.Channel,
+		Status:      chargeStatusPending,
+		QRString:    fmt.Sprintf("SIMULATED-QRIS|%s|%d", id, req.Amount),
+		ExpiresAt:   time.Now().Add(expiry).UTC(),
+	}
+
+	s.mutex.Lock()
+	s.charges[id] = created
+	result := *created
+	s.mutex.Unlock()
+
+	writeJSON(w, http.StatusCreated, result)
+}
+
+func (s *QRISSimulator) getCharge(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	current, ok := s.charges[r.PathValue("id")]
+	if ok {
+		s.expire(current)
+	}
+	var result charge
+	if ok {
+		result = *current
+	}
+	s.mutex.Unlock()
+
+	if !ok {
+		http.Error(w, errChargeNotFound.Error(), http.StatusNotFound)
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// refundCharge cancels a pending charge or gives back part of a paid one,
+// the whole remaining amount when the request has none
+func (s *QRISSimulator) refundCharge(w http.ResponseWriter, r *http.Request) {
+	var req refundRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Amount < 0 {
+		http.Error(w, "invalid refund", http.StatusBadRequest)
+		return
+	}
+
+	s.mutex.Lock()
+	current, ok := s.charges[r.PathValue("id")]
+	if !ok {
+		s.mutex.Unlock()
+		http.Error(w, errChargeNotFound.Error(), http.StatusNotFound)
+		return
+	}
+	s.expire(current)
+	switch current.Status {
+	case chargeStatusPending:
+		current.Status = chargeStatusCancelled
+	case chargeStatusPaid:
+		remaining := current.Amount - current.RefundedAmount
+		if req.Amount == 0 {
+			req.Amount = remaining
+		}
+		if req.Amount > remaining {
+			s.mutex.Unlock()
+			http.Error(w, "refund exceeds the paid amount", http.StatusConflict)
+			return
+		}
+		current.RefundedAmount += req.Amount
+		if current.RefundedAmount == current.Amount {
+			current.Status = chargeStatusRefunded
+		}
+	}
+	result := *current
+	s.mutex.Unlock()
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (s *QRISSimulator) payCharge(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if err := s.Pay(r.Context(), id); err != nil {
+		status := http.StatusConflict
+		if errors.Is(err, errChargeNotFound) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	s.mutex.Lock()
+	result := *s.charges[id]
+	s.mutex.Unlock()
+	writeJSON(w, http.StatusOK, result)
+}
+
+// expire marks a pending charge expired once its QR code can no longer be
+// paid, the caller holds the mutex
+func (s *QRISSimulator) expire(current *charge) {
+	if current.Status == chargeStatusPending && time.Now().After(current.ExpiresAt) {
+		current.Status = chargeStatusExpired
+	}
+}
+
+// notify sends a signed notification about the charge to the webhook
+func (s *QRISSimulator) notify(ctx context.Context, paid *charge) {
+	log := logger.GetLogger().WithContext(ctx)
+
+	payload, err := json.Marshal(notification{ID: paid.ID, ReferenceID: paid.ReferenceID, Status: paid.Status})
+	if err != nil {
+		log.Errorf("qris simulator: failed to compose notification: %v", err)
+		return
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.webhookURL, bytes.NewReader(payload))
+	if err != nil {
+		log.Errorf("qris simulator: failed to create notification: %v", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(s.webhookSecret, payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		log.Errorf("qris simulator: failed to send notification of charge %s: %v", paid.ID, err)
+		return
+	}
+	resp.Body.Close()
+
+	log.Infof("qris simulator: charge %s paid, webhook answered %d", paid.ID, resp.StatusCode)
+}
+
+// writeJSON writes value as the JSON body of the response
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(value)
+}
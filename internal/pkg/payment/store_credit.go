@@ -0,0 +1,43 @@
+package payment
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+)
+
+// StoreCreditGateway implements the domain.PaymentGateway interface for
+// payments from the balance of a store credit. The balance is debited in the
+// transaction that completes the sale and credited back in the one that voids
+// or refunds it, so the gateway itself keeps no state.
+type StoreCreditGateway struct{}
+
+// NewStoreCreditGateway creates a new store credit gateway
+func NewStoreCreditGateway() *StoreCreditGateway {
+	return &StoreCreditGateway{}
+}
+
+// Name returns the name of the gateway
+func (g *StoreCreditGateway) Name() string {
+	return "store_credit"
+}
+
+// Authorize captures the payment, the balance is debited with the sale
+func (g *StoreCreditGateway) Authorize(ctx context.Context, req *domain.PaymentRequest) (*domain.PaymentResult, error) {
+	return &domain.PaymentResult{Status: domain.PaymentStatusCaptured, Reference: req.PaymentID.String()}, nil
+}
+
+// Capture reports the payment captured
+func (g *StoreCreditGateway) Capture(ctx context.Context, reference string, amount int64) (*domain.PaymentResult, error) {
+	return &domain.PaymentResult{Status: domain.PaymentStatusCaptured, Reference: reference}, nil
+}
+
+// Refund reports the payment refunded, the balance is credited back with the
+// void or the refund
+func (g *StoreCreditGateway) Refund(ctx context.Context, reference string, amount int64) (*domain.PaymentResult, error) {
+	return &domain.PaymentResult{Status: domain.PaymentStatusRefunded, Reference: reference}, nil
+}
+
+// Status reports the payment captured
+func (g *StoreCreditGateway) Status(ctx context.Context, reference string) (*domain.PaymentResult, error) {
+	return &domain.PaymentResult{Status: domain.PaymentStatusCaptured, Reference: reference}, nil
+}
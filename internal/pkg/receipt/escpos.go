@@ -0,0 +1,81 @@
+package receipt
+
+import (
+	"bytes"
+	"github/kijunpos/internal/domain"
+	"image"
+)
+
+// ESC/POS command bytes
+const (
+	esc = 0x1b
+	gs  = 0x1d
+	lf  = 0x0a
+)
+
+// escPOS renders the lines as ESC/POS commands: the logo as a raster image,
+// centered lines with the printer alignment and a cut at the end. Characters
+// the default code page lacks are printed as "?".
+func escPOS(lines []line, logo []byte, width domain.PaperWidth) ([]byte, error) {
+	var out bytes.Buffer
+	out.Write([]byte{esc, '@'})
+
+	if len(logo) > 0 {
+		img, err := grayLogo(logo, dots(width), dots(width)/2)
+		if err != nil {
+			return nil, err
+		}
+		out.Write([]byte{esc, 'a', 1})
+		writeRaster(&out, img)
+		out.WriteByte(lf)
+	}
+
+	for _, l := range lines {
+		alignment, bold := byte(0), byte(0)
+		if l.align == alignCenter {
+			alignment = 1
+		}
+		if l.bold {
+			bold = 1
+		}
+		out.Write([]byte{esc, 'a', alignment, esc, 'E', bold})
+		out.WriteString(ascii(l.text))
+		out.WriteByte(lf)
+	}
+
+	// Reset the style, feed the paper past the cutter and cut it partially
+	out.Write([]byte{esc, 'E', 0, esc, 'a', 0})
+	out.Write([]byte{gs, 'V', 66, 3})
+	return out.Bytes(), nil
+}
+
+// writeRaster writes the image as a GS v 0 raster bit image, one bit per
+// dot with the dark pixels printed
+func writeRaster(out *bytes.Buffer, img *image.Gray) {
+	width, height := img.Bounds().Dx(), img.Bounds().Dy()
+	rowBytes := (width + 7) / 8
+	out.Write([]byte{gs, 'v', '0', 0, byte(rowBytes), byte(rowBytes >> 8), byte(height), byte(height >> 8)})
+
+	row := make([]byte, rowBytes)
+	for y := 0; y < height; y++ {
+		clear(row)
+		for x := 0; x < width; x++ {
+			if img.GrayAt(x, y).Y < 128 {
+				row[x/8] |= 0x80 >> (x % 8)
+			}
+		}
+		out.Write(row)
+	}
+}
+
+// ascii replaces the characters outside of printable ASCII with "?"
+func ascii(text string) string {
+	out := make([]byte, 0, len(text))
+	for _, r := range text {
+		if r < 0x20 || r > 0x7e {
+			r = '?'
+		}
+		out = append(out, byte(r))
+	}
+	return string(out)
+}
@@ -0,0 +1,276 @@
+package receipt
+
+import (
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"strings"
+	"unicode/utf8"
+)
+
+// align is the alignment of a line of a receipt
+type align int
+
+const (
+	alignLeft align = iota
+	alignCenter
+)
+
+// line is a line of a receipt, never wider than the paper
+type line struct {
+	text  string
+	align align
+	bold  bool
+}
+
+// padded returns the text of the line padded with spaces to center it
+func (l line) padded(columns int) string {
+	if l.align != alignCenter {
+		return l.text
+	}
+	return strings.Repeat(" ", (columns-utf8.RuneCountInString(l.text))/2) + l.text
+}
+
+// builder lays out the lines of a receipt
+type builder struct {
+	columns int
+	lines   []line
+}
+
+// center adds text centered, wrapped over as many lines as needed
+func (b *builder) center(text string, bold bool) {
+	for _, part := range wrap(text, b.columns) {
+		b.lines = append(b.lines, line{text: part, align: alignCenter, bold: bold})
+	}
+}
+
+// left adds text aligned left, wrapped over as many lines as needed
+func (b *builder) left(text string) {
+	for _, part := range wrap(text, b.columns) {
+		b.lines = append(b.lines, line{text: part})
+	}
+}
+
+// row adds a label aligned left and a value aligned right, the value goes on
+// a line of its own when both do not fit
+func (b *builder) row(label, value string, bold bool) {
+	gap := b.columns - utf8.RuneCountInString(label) - utf8.RuneCountInString(value)
+	if gap < 1 {
+		for _, part := range wrap(label, b.columns) {
+			b.lines = append(b.lines, line{text: part, bold: bold})
+		}
+		gap = b.columns - utf8.RuneCountInString(value)
+		label = ""
+	}
+	b.lines = append(b.lines, line{text: label + strings.Repeat(" ", gap) + value, bold: bold})
+}
+
+func (b *builder) separator() {
+	b.lines = append(b.lines, line{text: strings.Repeat("-", b.columns)})
+}
+
+// layout lays out a receipt in lines of at most columns characters
+func layout(receipt *domain.Receipt, columns int) []line {
+	b := &builder{columns: columns}
+	order := receipt.Order
+	hasVAT := false
+	for _, tax := range order.Taxes {
+		hasVAT = hasVAT || tax.Type == domain.TaxTypeVAT
+	}
+	settings := receipt.Settings
+	if settings == nil {
+		settings = &domain.ReceiptSettings{}
+	}
+
+	for _, text := range splitLines(settings.Header) {
+		b.center(text, false)
+	}
+	b.center(receipt.MerchantName, true)
+	if receipt.Outlet.Name != receipt.MerchantName {
+		b.center(receipt.Outlet.Name, false)
+	}
+	if receipt.Outlet.Address != "" {
+		b.center(receipt.Outlet.Address, false)
+	}
+	if receipt.Outlet.PhoneNumber != "" {
+		b.center(receipt.Outlet.PhoneNumber, false)
+	}
+	if settings.TaxID != "" {
+		b.center("Tax ID: "+settings.TaxID, false)
+	}
+
+	b.separator()
+	b.row(fmt.Sprintf("No. %d", order.Number.Int64), receipt.PrintedAt.Format("02/01/2006 15:04"), false)
+	if receipt.CashierName != "" {
+		b.left("Cashier: " + receipt.CashierName)
+	}
+	if order.Status == domain.OrderStatusVoided {
+		b.center("*** VOID ***", true)
+	}
+
+	b.separator()
+	for _, item := range order.Items {
+		b.left(item.Name)
+		quantity := fmt.Sprintf("%d", item.Quantity)
+		if item.Unit != "" {
+			quantity += " " + item.Unit
+		}
+		b.row(fmt.Sprintf("  %s x %s", quantity, formatAmount(item.UnitPrice)), formatAmount(item.Subtotal), false)
+		if item.DiscountAmount > 0 {
+			b.row("  Discount", formatAmount(-item.DiscountAmount), false)
+		}
+		for _, p := range order.Promotions {
+			if p.ItemID.Valid && p.ItemID.UUID == item.ID {
+				b.row("  "+p.Name, formatAmount(-p.Amount), false)
+			}
+		}
+		if item.TaxExempt && hasVAT {
+			b.left("  Tax exempt")
+		}
+		if item.RefundedQuantity > 0 {
+			b.left(fmt.Sprintf("  %d refunded", item.RefundedQuantity))
+		}
+	}
+
+	b.separator()
+	b.row("Subtotal", formatAmount(order.Subtotal), false)
+	// Order discounts of promotions are listed by name and redeemed points on
+	// their own, the item discounts and the manual order discount add up to
+	// the discount row
+	var orderPromotions int64
+	for _, p := range order.Promotions {
+		if !p.ItemID.Valid {
+			orderPromotions += p.Amount
+		}
+	}
+	if discount := order.DiscountTotal - orderPromotions - order.PointsDiscount; discount > 0 {
+		b.row("Discount", formatAmount(-discount), false)
+	}
+	for _, p := range order.Promotions {
+		if !p.ItemID.Valid {
+			b.row(p.Name, formatAmount(-p.Amount), false)
+		}
+	}
+	if order.VoucherCode != "" {
+		b.left("Voucher " + order.VoucherCode)
+	}
+	if order.PointsDiscount > 0 {
+		b.row(fmt.Sprintf("Points (%d)", order.PointsRedeemed), formatAmount(-order.PointsDiscount), false)
+	}
+	// Charges added on top are listed before the total, VAT contained in the
+	// prices after it
+	for _, tax := range order.Taxes {
+		if !tax.Inclusive {
+			b.row(taxLabel(tax), formatAmount(tax.Amount), false)
+		}
+	}
+	b.row("TOTAL", formatAmount(order.Total), true)
+	for _, tax := range order.Taxes {
+		if tax.Inclusive {
+			b.row("Incl. "+taxLabel(tax), formatAmount(tax.Amount), false)
+		}
+	}
+
+	for _, p := range order.Payments {
+		if p.Status != domain.PaymentStatusCaptured && p.Status != domain.PaymentStatusRefunded {
+			continue
+		}
+		label := paymentLabels[p.Method]
+		if label == "" {
+			label = string(p.Method)
+		}
+		b.row(label, formatAmount(p.Tendered), false)
+		if p.Change > 0 {
+			b.row("Change", formatAmount(p.Change), false)
+		}
+		if p.Reference != "" {
+			b.left("  Ref: " + p.Reference)
+		}
+	}
+	if order.RefundedTotal > 0 {
+		b.row("Refunded", formatAmount(-order.RefundedTotal), false)
+	}
+	if order.PointsEarned > 0 {
+		b.left(fmt.Sprintf("Points earned: %d", order.PointsEarned))
+	}
+
+	if footer := splitLines(settings.Footer); len(footer) > 0 {
+		b.separator()
+		for _, text := range footer {
+			b.center(text, false)
+		}
+	}
+
+	return b.lines
+}
+
+// paymentLabels are the names of the payment methods printed on receipts
+var paymentLabels = map[domain.PaymentMethod]string{
+	domain.PaymentMethodCash:        "Cash",
+	domain.PaymentMethodCard:        "Card",
+	domain.PaymentMethodQRIS:        "QRIS",
+	domain.PaymentMethodEWallet:     "E-Wallet",
+	domain.PaymentMethodStoreCredit: "Store Credit",
+}
+
+// taxLabel names a tax with its rate, e.g. PPN 11%
+func taxLabel(tax *domain.OrderTax) string {
+	return fmt.Sprintf("%s %s%%", tax.Name, tax.Rate.String())
+}
+
+// formatAmount formats an amount with dots between the thousands, e.g. 12.500
+func formatAmount(amount int64) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	digits := fmt.Sprintf("%d", amount)
+	var formatted strings.Builder
+	for i, digit := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			formatted.WriteByte('.')
+		}
+		formatted.WriteRune(digit)
+	}
+	return sign + formatted.String()
+}
+
+// splitLines splits text into its lines, leaving out blank lines at the ends
+func splitLines(text string) []string {
+	text = strings.Trim(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	if strings.TrimSpace(text) == "" {
+		return nil
+	}
+	return strings.Split(text, "\n")
+}
+
+// wrap breaks text into lines of at most columns characters at spaces, words
+// longer than a line are cut
+func wrap(text string, columns int) []string {
+	var lines []string
+	current := []rune{}
+	for _, word := range strings.Fields(text) {
+		runes := []rune(word)
+		for len(runes) > columns {
+			if len(current) > 0 {
+				lines = append(lines, string(current))
+				current = current[:0]
+			}
+			lines = append(lines, string(runes[:columns]))
+			runes = runes[columns:]
+		}
+		if len(current) > 0 && len(current)+1+len(runes) > columns {
+			lines = append(lines, string(current))
+			current = current[:0]
+		}
+		if len(current) > 0 {
+			current = append(current, ' ')
+		}
+		current = append(current, runes...)
+	}
+	if len(current) > 0 {
+		lines = append(lines, string(current))
+	}
+	return lines
+}
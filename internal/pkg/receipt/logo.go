@@ -0,0 +1,76 @@
+package receipt
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	// Logos are PNG or JPEG images
+	_ "image/jpeg"
+	_ "image/png"
+)
+
+// MaxLogoSize is the largest logo accepted in bytes
+const MaxLogoSize = 256 * 1024
+
+// MaxLogoDimension is the largest width and height of a logo in pixels, the
+// dots per line of 80mm paper. A small compressed file can still describe a
+// huge image, so the dimensions are checked before the pixels are decoded.
+const MaxLogoDimension = 576
+
+// DecodeLogo decodes a PNG or JPEG logo
+func DecodeLogo(data []byte) (image.Image, error) {
+	if len(data) > MaxLogoSize {
+		return nil, fmt.Errorf("logo is larger than %d bytes", MaxLogoSize)
+	}
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode logo: %w", err)
+	}
+	if config.Width > MaxLogoDimension || config.Height > MaxLogoDimension {
+		return nil, fmt.Errorf("logo is larger than %dx%d pixels", MaxLogoDimension, MaxLogoDimension)
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode logo: %w", err)
+	}
+	return img, nil
+}
+
+// grayLogo decodes a logo and scales it down to fit in maxWidth by maxHeight
+// pixels, transparent pixels become white
+func grayLogo(data []byte, maxWidth, maxHeight int) (*image.Gray, error) {
+	img, err := DecodeLogo(data)
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width == 0 || height == 0 {
+		return nil, fmt.Errorf("logo is empty")
+	}
+	if width > maxWidth {
+		height = height * maxWidth / width
+		width = maxWidth
+	}
+	if height > maxHeight {
+		width = width * maxHeight / height
+		height = maxHeight
+	}
+	width, height = max(width, 1), max(height, 1)
+
+	// Nearest neighbour scaling is good enough for a logo printed in black and white
+	gray := image.NewGray(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			source := img.At(bounds.Min.X+x*bounds.Dx()/width, bounds.Min.Y+y*bounds.Dy()/height)
+			r, g, b, a := source.RGBA()
+			// Composite the premultiplied colour over white
+			white := 0xffff - a
+			luminance := (299*(r+white) + 587*(g+white) + 114*(b+white)) / 1000
+			gray.SetGray(x, y, color.Gray{Y: uint8(luminance >> 8)})
+		}
+	}
+	return gray, nil
+}
@@ -0,0 +1,136 @@
+package receipt
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"strings"
+)
+
+// Layout of PDF receipts in points, the page is as wide as the paper
+const (
+	pdfMargin     = 10.0
+	pdfLineHeight = 1.25
+	// pdfLogoHeight is the tallest a logo is drawn
+	pdfLogoHeight = 60.0
+	// pdfLogoScale is the number of logo pixels per point
+	pdfLogoScale = 2
+)
+
+// pdf renders the lines as a single page PDF in a monospace font, with the
+// logo on top. The font size makes a line of columns characters fill the page.
+func pdf(lines []line, logo []byte, width domain.PaperWidth, columns int) ([]byte, error) {
+	pageWidth := float64(width) * 72 / 25.4
+	textWidth := pageWidth - 2*pdfMargin
+	// Courier characters are 0.6 of the font size wide
+	fontSize := textWidth / (0.6 * float64(columns))
+	leading := fontSize * pdfLineHeight
+
+	var image []byte
+	var imageWidth, imageHeight int
+	var logoWidth, logoHeight float64
+	if len(logo) > 0 {
+		img, err := grayLogo(logo, int(textWidth*pdfLogoScale), pdfLogoHeight*pdfLogoScale)
+		if err != nil {
+			return nil, err
+		}
+		imageWidth, imageHeight = img.Bounds().Dx(), img.Bounds().Dy()
+		logoWidth, logoHeight = float64(imageWidth)/pdfLogoScale, float64(imageHeight)/pdfLogoScale
+		if image, err = deflate(img.Pix); err != nil {
+			return nil, err
+		}
+	}
+
+	pageHeight := 2*pdfMargin + float64(len(lines))*leading
+	if logoHeight > 0 {
+		pageHeight += logoHeight + leading
+	}
+
+	// Content stream, the origin of a page is its bottom left corner
+	var content bytes.Buffer
+	top := pageHeight - pdfMargin
+	if image != nil {
+		fmt.Fprintf(&content, "q %.2f 0 0 %.2f %.2f %.2f cm /Logo Do Q\n", logoWidth, logoHeight, (pageWidth-logoWidth)/2, top-logoHeight)
+		top -= logoHeight + leading
+	}
+	fmt.Fprintf(&content, "BT %.2f TL %.2f %.2f Td\n", leading, pdfMargin, top-fontSize)
+	for _, l := range lines {
+		font := "Regular"
+		if l.bold {
+			font = "Bold"
+		}
+		fmt.Fprintf(&content, "/%s %.2f Tf (%s) Tj T*\n", font, fontSize, pdfString(l.padded(columns)))
+	}
+	content.WriteString("ET\n")
+
+	resources := "/Font << /Regular 4 0 R /Bold 5 0 R >>"
+	if image != nil {
+		resources += " /XObject << /Logo 7 0 R >>"
+	}
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << %s >> /Contents 6 0 R >>", pageWidth, pageHeight, resources),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier-Bold /Encoding /WinAnsiEncoding >>",
+		pdfStream(fmt.Sprintf("<< /Length %d >>", content.Len()), content.Bytes()),
+	}
+	if image != nil {
+		dictionary := fmt.Sprintf("<< /Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceGray /BitsPerComponent 8 /Filter /FlateDecode /Length %d >>", imageWidth, imageHeight, len(image))
+		objects = append(objects, pdfStream(dictionary, image))
+	}
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return out.Bytes(), nil
+}
+
+// pdfStream formats a stream object with its dictionary
+func pdfStream(dictionary string, data []byte) string {
+	return dictionary + "\nstream\n" + string(data) + "\nendstream"
+}
+
+// pdfString escapes text for a PDF string literal in WinAnsiEncoding,
+// characters outside of Latin-1 are replaced with "?"
+func pdfString(text string) string {
+	var out strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			out.WriteByte('\\')
+			out.WriteRune(r)
+		case r >= 0x20 && r <= 0x7e:
+			out.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			fmt.Fprintf(&out, "\\%03o", r)
+		default:
+			out.WriteByte('?')
+		}
+	}
+	return out.String()
+}
+
+// deflate compresses data for the FlateDecode filter
+func deflate(data []byte) ([]byte, error) {
+	var out bytes.Buffer
+	writer := zlib.NewWriter(&out)
+	if _, err := writer.Write(data); err != nil {
+		return nil, fmt.Errorf("failed to compress logo: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress logo: %w", err)
+	}
+	return out.Bytes(), nil
+}
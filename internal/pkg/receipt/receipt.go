@@ -0,0 +1,78 @@
+// Package receipt renders the receipts of sales as plain text, as ESC/POS
+// commands for thermal printers and as PDF documents
+package receipt
+
+import (
+	"fmt"
+	"github/kijunpos/internal/domain"
+)
+
+// Render renders a receipt for paper of the width. PDF receipts are laid out
+// like printed ones, one receipt wide.
+func Render(receipt *domain.Receipt, format domain.ReceiptFormat, width domain.PaperWidth) (*domain.ReceiptDocument, error) {
+	columns, err := Columns(width)
+	if err != nil {
+		return nil, err
+	}
+	lines := layout(receipt, columns)
+	name := fmt.Sprintf("receipt-%d", receipt.Order.Number.Int64)
+
+	var logo []byte
+	if receipt.Settings != nil {
+		logo = receipt.Settings.Logo
+	}
+
+	switch format {
+	case domain.ReceiptFormatText:
+		return &domain.ReceiptDocument{
+			Format:      format,
+			ContentType: "text/plain; charset=utf-8",
+			FileName:    name + ".txt",
+			Content:     []byte(plainText(lines, columns)),
+		}, nil
+	case domain.ReceiptFormatESCPOS:
+		content, err := escPOS(lines, logo, width)
+		if err != nil {
+			return nil, err
+		}
+		return &domain.ReceiptDocument{
+			Format:      format,
+			ContentType: "application/octet-stream",
+			FileName:    name + ".bin",
+			Content:     content,
+		}, nil
+	case domain.ReceiptFormatPDF:
+		content, err := pdf(lines, logo, width, columns)
+		if err != nil {
+			return nil, err
+		}
+		return &domain.ReceiptDocument{
+			Format:      format,
+			ContentType: "application/pdf",
+			FileName:    name + ".pdf",
+			Content:     content,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown receipt format %q", format)
+	}
+}
+
+// Columns returns the number of characters per line of paper of the width
+func Columns(width domain.PaperWidth) (int, error) {
+	switch width {
+	case domain.PaperWidth58:
+		return 32, nil
+	case domain.PaperWidth80:
+		return 48, nil
+	default:
+		return 0, fmt.Errorf("unsupported paper width %dmm", width)
+	}
+}
+
+// dots returns the number of dots per line of a 203 dpi thermal printer
+func dots(width domain.PaperWidth) int {
+	if width == domain.PaperWidth58 {
+		return 384
+	}
+	return 576
+}
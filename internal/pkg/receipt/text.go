@@ -0,0 +1,15 @@
+package receipt
+
+import (
+	"strings"
+)
+
+// plainText renders the lines as plain text, centered lines are padded with spaces
+func plainText(lines []line, columns int) string {
+	var text strings.Builder
+	for _, l := range lines {
+		text.WriteString(l.padded(columns))
+		text.WriteByte('\n')
+	}
+	return text.String()
+}
@@ -0,0 +1,31 @@
+package tenancy
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	appErrors "github/kijunpos/internal/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// Require returns the tenant selected for the request
+func Require(ctx context.Context) (*domain.Tenant, error) {
+	tenant, ok := domain.TenantFromContext(ctx)
+	if !ok {
+		return nil, appErrors.NewBadRequestError("merchant is not selected, set the x-merchant-id header", nil)
+	}
+	return tenant, nil
+}
+
+// RequireOutlet returns the tenant selected for the request, which must be
+// bound to an outlet
+func RequireOutlet(ctx context.Context) (*domain.Tenant, error) {
+	tenant, err := Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if tenant.OutletID == uuid.Nil {
+		return nil, appErrors.NewBadRequestError("outlet is not selected, set the x-outlet-id header", nil)
+	}
+	return tenant, nil
+}
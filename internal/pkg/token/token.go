@@ -0,0 +1,157 @@
+package token
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// Config holds the configuration for the token service
+type Config struct {
+	Issuer          string
+	SigningKeyID    string
+	SigningKey      string // base64 encoded Ed25519 seed
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+}
+
+// claims is the JWT payload of an access token
+type claims struct {
+	UserName string   `json:"username"`
+	AuthType string   `json:"auth_type"`
+	Roles    []string `json:"roles,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// Service implements the domain.TokenService interface.
+// Access tokens are EdDSA signed JWTs so other services can verify them
+// offline with the public key, refresh tokens are opaque random strings.
+type Service struct {
+	config     Config
+	privateKey ed25519.PrivateKey
+	publicKey  ed25519.PublicKey
+}
+
+// NewTokenService creates a new token service
+func NewTokenService(config Config) (*Service, error) {
+	seed, err := base64.StdEncoding.DecodeString(config.SigningKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode signing key: %w", err)
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("signing key must be a %d byte Ed25519 seed", ed25519.SeedSize)
+	}
+
+	privateKey := ed25519.NewKeyFromSeed(seed)
+	return &Service{
+		config:     config,
+		privateKey: privateKey,
+		publicKey:  privateKey.Public().(ed25519.PublicKey),
+	}, nil
+}
+
+// PublicKey returns the key other services use to verify access tokens
+func (s *Service) PublicKey() ed25519.PublicKey {
+	return s.publicKey
+}
+
+// GenerateAccessToken signs a new access token carrying the given claims
+func (s *Service) GenerateAccessToken(ctx context.Context, tokenClaims domain.TokenClaims) (string, time.Time, error) {
+	_, span := apm.GetTracer().Start(ctx, "pkg.token.GenerateAccessToken")
+	defer span.End()
+
+	now := time.Now()
+	expiresAt := now.Add(s.config.AccessTokenTTL)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims{
+		UserName: tokenClaims.UserName,
+		AuthType: string(tokenClaims.AuthType),
+		Roles:    tokenClaims.Roles,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    s.config.Issuer,
+			Subject:   tokenClaims.UserID.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	})
+	token.Header["kid"] = s.config.SigningKeyID
+
+	signed, err := token.SignedString(s.privateKey)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign access token: %w", err)
+	}
+
+	return signed, expiresAt, nil
+}
+
+// ParseAccessToken verifies the signature and expiry of an access token and returns its claims
+func (s *Service) ParseAccessToken(ctx context.Context, accessToken string) (*domain.TokenClaims, error) {
+	_, span := apm.GetTracer().Start(ctx, "pkg.token.ParseAccessToken")
+	defer span.End()
+
+	var parsed claims
+	_, err := jwt.ParseWithClaims(
+		accessToken,
+		&parsed,
+		func(t *jwt.Token) (interface{}, error) {
+			if kid, _ := t.Header["kid"].(string); kid != s.config.SigningKeyID {
+				return nil, errors.New("unknown signing key")
+			}
+			return s.publicKey, nil
+		},
+		jwt.WithValidMethods([]string{jwt.SigningMethodEdDSA.Alg()}),
+		jwt.WithIssuer(s.config.Issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid access token: %w", err)
+	}
+
+	userID, err := uuid.Parse(parsed.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("invalid access token subject: %w", err)
+	}
+
+	return &domain.TokenClaims{
+		TokenID:   parsed.ID,
+		UserID:    userID,
+		UserName:  parsed.UserName,
+		AuthType:  domain.AuthType(parsed.AuthType),
+		Roles:     parsed.Roles,
+		IssuedAt:  parsed.IssuedAt.Time,
+		ExpiresAt: parsed.ExpiresAt.Time,
+	}, nil
+}
+
+// GenerateRefreshToken creates a new opaque refresh token and its hash
+func (s *Service) GenerateRefreshToken(ctx context.Context) (string, string, time.Time, error) {
+	_, span := apm.GetTracer().Start(ctx, "pkg.token.GenerateRefreshToken")
+	defer span.End()
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", time.Time{}, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(buf)
+	return token, s.HashRefreshToken(token), time.Now().Add(s.config.RefreshTokenTTL), nil
+}
+
+// HashRefreshToken returns the hash under which a refresh token is stored
+func (s *Service) HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,178 @@
+package token
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"github/kijunpos/internal/domain"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// testConfig returns a config signing with a seed of the given byte
+func testConfig(seed byte) Config {
+	key := make([]byte, ed25519.SeedSize)
+	for i := range key {
+		key[i] = seed
+	}
+	return Config{
+		Issuer:          "kijunpos",
+		SigningKeyID:    "test",
+		SigningKey:      base64.StdEncoding.EncodeToString(key),
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: 24 * time.Hour,
+	}
+}
+
+func newTestService(t *testing.T, config Config) *Service {
+	t.Helper()
+	service, err := NewTokenService(config)
+	if err != nil {
+		t.Fatalf("NewTokenService() error = %v", err)
+	}
+	return service
+}
+
+func TestNewTokenService(t *testing.T) {
+	tests := []struct {
+		name       string
+		signingKey string
+		wantErr    bool
+	}{
+		{name: "Ed25519 seed", signingKey: testConfig(1).SigningKey},
+		{name: "not base64", signingKey: "not a key!", wantErr: true},
+		{name: "short seed", signingKey: base64.StdEncoding.EncodeToString(make([]byte, 16)), wantErr: true},
+		{name: "empty", signingKey: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := testConfig(1)
+			config.SigningKey = tt.signingKey
+			if _, err := NewTokenService(config); (err != nil) != tt.wantErr {
+				t.Errorf("NewTokenService() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestServiceAccessToken(t *testing.T) {
+	ctx := context.Background()
+	service := newTestService(t, testConfig(1))
+
+	want := domain.TokenClaims{
+		UserID:   uuid.New(),
+		UserName: "kasir",
+		AuthType: domain.AuthTypeWhatsApp,
+		Roles:    []string{"cashier", "manager"},
+	}
+	accessToken, expiresAt, err := service.GenerateAccessToken(ctx, want)
+	if err != nil {
+		t.Fatalf("GenerateAccessToken() error = %v", err)
+	}
+
+	got, err := service.ParseAccessToken(ctx, accessToken)
+	if err != nil {
+		t.Fatalf("ParseAccessToken() error = %v", err)
+	}
+	if got.UserID != want.UserID || got.UserName != want.UserName || got.AuthType != want.AuthType || !slices.Equal(got.Roles, want.Roles) {
+		t.Errorf("ParseAccessToken() = %+v, want %+v", got, want)
+	}
+	if got.TokenID == "" {
+		t.Error("ParseAccessToken() returned no token ID")
+	}
+	// JWT times have a resolution of one second
+	if !got.ExpiresAt.Equal(expiresAt.Truncate(time.Second)) {
+		t.Errorf("ParseAccessToken() expires at %v, want %v", got.ExpiresAt, expiresAt)
+	}
+}
+
+func TestServiceParseAccessTokenRejects(t *testing.T) {
+	ctx := context.Background()
+	service := newTestService(t, testConfig(1))
+	claims := domain.TokenClaims{UserID: uuid.New(), UserName: "kasir", AuthType: domain.AuthTypeEmail}
+
+	// sign returns a token of claims signed by a service with config
+	sign := func(t *testing.T, config Config) string {
+		t.Helper()
+		accessToken, _, err := newTestService(t, config).GenerateAccessToken(ctx, claims)
+		if err != nil {
+			t.Fatalf("GenerateAccessToken() error = %v", err)
+		}
+		return accessToken
+	}
+
+	tests := []struct {
+		name  string
+		token func(t *testing.T) string
+	}{
+		{name: "expired", token: func(t *testing.T) string {
+			config := testConfig(1)
+			config.AccessTokenTTL = -time.Minute
+			return sign(t, config)
+		}},
+		{name: "signed with another key", token: func(t *testing.T) string {
+			return sign(t, testConfig(2))
+		}},
+		{name: "unknown key ID", token: func(t *testing.T) string {
+			config := testConfig(1)
+			config.SigningKeyID = "old"
+			return sign(t, config)
+		}},
+		{name: "other issuer", token: func(t *testing.T) string {
+			config := testConfig(1)
+			config.Issuer = "elsewhere"
+			return sign(t, config)
+		}},
+		{name: "changed payload", token: func(t *testing.T) string {
+			parts := strings.Split(sign(t, testConfig(1)), ".")
+			other := strings.Split(sign(t, testConfig(1)), ".")
+			return parts[0] + "." + other[1] + "." + parts[2]
+		}},
+		{name: "unsigned", token: func(t *testing.T) string {
+			parts := strings.Split(sign(t, testConfig(1)), ".")
+			header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT","kid":"test"}`))
+			return header + "." + parts[1] + "."
+		}},
+		{name: "not a token", token: func(t *testing.T) string { return "kijunpos" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := service.ParseAccessToken(ctx, tt.token(t)); err == nil {
+				t.Error("ParseAccessToken() accepted the token")
+			}
+		})
+	}
+}
+
+func TestServiceRefreshToken(t *testing.T) {
+	ctx := context.Background()
+	service := newTestService(t, testConfig(1))
+
+	first, hash, expiresAt, err := service.GenerateRefreshToken(ctx)
+	if err != nil {
+		t.Fatalf("GenerateRefreshToken() error = %v", err)
+	}
+	if hash != service.HashRefreshToken(first) {
+		t.Errorf("GenerateRefreshToken() hash = %q, want HashRefreshToken() = %q", hash, service.HashRefreshToken(first))
+	}
+	if hash == first {
+		t.Error("GenerateRefreshToken() hash equals the token")
+	}
+	if d := time.Until(expiresAt); d <= 23*time.Hour || d > 24*time.Hour {
+		t.Errorf("GenerateRefreshToken() expires in %v, want 24h", d)
+	}
+
+	second, _, _, err := service.GenerateRefreshToken(ctx)
+	if err != nil {
+		t.Fatalf("GenerateRefreshToken() error = %v", err)
+	}
+	if first == second {
+		t.Error("GenerateRefreshToken() returned the same token twice")
+	}
+}
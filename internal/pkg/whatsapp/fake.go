@@ -0,0 +1,89 @@
+package whatsapp
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/logger"
+	"sync"
+	"time"
+)
+
+// Message is a WhatsApp message recorded by the fake service, Code is set for
+// verification codes and Text for receipts
+type Message struct {
+	To     string
+	Code   string
+	Text   string
+	SentAt time.Time
+}
+
+// FakeService implements the domain.WhatsAppService interface without
+// contacting any provider. Messages are kept in memory and logged so they can
+// be inspected in tests and in the docker-compose environment.
+type FakeService struct {
+	mutex    sync.RWMutex
+	messages []Message
+}
+
+// NewFakeWhatsAppService creates a new fake WhatsApp service
+func NewFakeWhatsAppService() *FakeService {
+	return &FakeService{}
+}
+
+// SendVerificationCode records a verification code sent to the specified WhatsApp number
+func (s *FakeService) SendVerificationCode(ctx context.Context, whatsAppNumber, code string) error {
+	ctx, span := apm.GetTracer().Start(ctx, "pkg.whatsapp.FakeService.SendVerificationCode")
+	defer span.End()
+
+	s.mutex.Lock()
+	s.messages = append(s.messages, Message{
+		To:     whatsAppNumber,
+		Code:   code,
+		SentAt: time.Now(),
+	})
+	s.mutex.Unlock()
+
+	logger.GetLogger().WithContext(ctx).Infof("fake whatsapp: verification code %s sent to %s", code, whatsAppNumber)
+	return nil
+}
+
+// SendReceipt records a receipt sent to the specified WhatsApp number
+func (s *FakeService) SendReceipt(ctx context.Context, whatsAppNumber string, receipt *domain.ReceiptMessage) error {
+	ctx, span := apm.GetTracer().Start(ctx, "pkg.whatsapp.FakeService.SendReceipt")
+	defer span.End()
+
+	s.mutex.Lock()
+	s.messages = append(s.messages, Message{
+		To:     whatsAppNumber,
+		Text:   receipt.Text,
+		SentAt: time.Now(),
+	})
+	s.mutex.Unlock()
+
+	logger.GetLogger().WithContext(ctx).Infof("fake whatsapp: receipt %q sent to %s", receipt.Subject, whatsAppNumber)
+	return nil
+}
+
+// Messages returns the messages recorded so far
+func (s *FakeService) Messages() []Message {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	messages := make([]Message, len(s.messages))
+	copy(messages, s.messages)
+	return messages
+}
+
+// LastMessageTo returns the most recent message sent to the given number
+func (s *FakeService) LastMessageTo(whatsAppNumber string) (Message, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for i := len(s.messages) - 1; i >= 0; i-- {
+		if s.messages[i].To == whatsAppNumber {
+			return s.messages[i], true
+		}
+	}
+	return Message{}, false
+}
@@ -0,0 +1,152 @@
+package whatsapp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// ProviderHTTP sends messages through a WhatsApp Business style HTTP API
+	ProviderHTTP = "http"
+	// ProviderFake records messages in memory instead of sending them
+	ProviderFake = "fake"
+)
+
+// Config holds the configuration for the WhatsApp service
+type Config struct {
+	APIURL           string
+	PhoneNumberID    string
+	AccessToken      string
+	TemplateName     string
+	TemplateLanguage string
+	Timeout          time.Duration
+}
+
+// Service implements the domain.WhatsAppService interface on top of a
+// WhatsApp Business style HTTP API using a pre-approved authentication template
+type Service struct {
+	config Config
+	client *http.Client
+}
+
+// NewWhatsAppService creates a new WhatsApp service
+func NewWhatsAppService(config Config) domain.WhatsAppService {
+	return &Service{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}
+}
+
+type (
+	messageRequest struct {
+		MessagingProduct string    `json:"messaging_product"`
+		To               string    `json:"to"`
+		Type             string    `json:"type"`
+		Template         *template `json:"template,omitempty"`
+		Text             *text     `json:"text,omitempty"`
+	}
+
+	text struct {
+		Body string `json:"body"`
+	}
+
+	template struct {
+		Name       string      `json:"name"`
+		Language   language    `json:"language"`
+		Components []component `json:"components"`
+	}
+
+	language struct {
+		Code string `json:"code"`
+	}
+
+	component struct {
+		Type       string      `json:"type"`
+		Parameters []parameter `json:"parameters"`
+	}
+
+	parameter struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	}
+)
+
+// SendVerificationCode sends a verification code to the specified WhatsApp number
+func (s *Service) SendVerificationCode(ctx context.Context, whatsAppNumber, code string) error {
+	ctx, span := apm.GetTracer().Start(ctx, "pkg.whatsapp.SendVerificationCode")
+	defer span.End()
+
+	// Compose template message, the provider expects the number without "+"
+	payload, err := json.Marshal(messageRequest{
+		MessagingProduct: "whatsapp",
+		To:               strings.TrimPrefix(whatsAppNumber, "+"),
+		Type:             "template",
+		Template: &template{
+			Name:     s.config.TemplateName,
+			Language: language{Code: s.config.TemplateLanguage},
+			Components: []component{
+				{
+					Type:       "body",
+					Parameters: []parameter{{Type: "text", Text: code}},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to compose whatsapp message: %w", err)
+	}
+
+	return s.send(ctx, payload)
+}
+
+// SendReceipt sends a receipt as a text message, the text is wrapped in a
+// code block so WhatsApp shows its columns in a monospace font
+func (s *Service) SendReceipt(ctx context.Context, whatsAppNumber string, receipt *domain.ReceiptMessage) error {
+	ctx, span := apm.GetTracer().Start(ctx, "pkg.whatsapp.SendReceipt")
+	defer span.End()
+
+	payload, err := json.Marshal(messageRequest{
+		MessagingProduct: "whatsapp",
+		To:               strings.TrimPrefix(whatsAppNumber, "+"),
+		Type:             "text",
+		Text:             &text{Body: "```\n" + receipt.Text + "```"},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to compose whatsapp message: %w", err)
+	}
+
+	return s.send(ctx, payload)
+}
+
+// send posts a message to the messages endpoint of the provider
+func (s *Service) send(ctx context.Context, payload []byte) error {
+	url := fmt.Sprintf("%s/%s/messages", strings.TrimSuffix(s.config.APIURL, "/"), s.config.PhoneNumberID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create whatsapp request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+s.config.AccessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	// Send message
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send whatsapp message: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("failed to send whatsapp message: status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package category
+
+import (
+	"github/kijunpos/config/db"
+	"github/kijunpos/internal/domain"
+)
+
+// categoryColumns is the column list selected into domain.Category
+const categoryColumns = `id, merchant_id, parent_id, name, created_at, updated_at`
+
+type categoryRepository struct {
+	dbConn *db.Connection
+}
+
+// NewCategoryRepository creates a new category repository
+func NewCategoryRepository(dbConn *db.Connection) domain.CategoryRepository {
+	return &categoryRepository{
+		dbConn: dbConn,
+	}
+}
@@ -0,0 +1,30 @@
+package category
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// Create stores a new category
+func (r *categoryRepository) Create(ctx context.Context, category *domain.Category) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.category.Create")
+	defer span.End()
+
+	query := `
+		INSERT INTO categories (id, merchant_id, parent_id, name, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	_, err := r.dbConn.DB.ExecContext(
+		ctx,
+		query,
+		category.ID,
+		category.MerchantID,
+		category.ParentID,
+		category.Name,
+		category.CreatedAt,
+	)
+
+	return err
+}
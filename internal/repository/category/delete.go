@@ -0,0 +1,22 @@
+package category
+
+import (
+	"context"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// Delete deletes a category, the foreign keys uncategorize its products and subcategories
+func (r *categoryRepository) Delete(ctx context.Context, merchantID, id uuid.UUID) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.category.Delete")
+	defer span.End()
+
+	query := `
+		DELETE FROM categories
+		WHERE merchant_id = $1 AND id = $2
+	`
+
+	_, err := r.dbConn.DB.ExecContext(ctx, query, merchantID, id)
+	return err
+}
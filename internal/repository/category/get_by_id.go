@@ -0,0 +1,33 @@
+package category
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetByID retrieves a category of a merchant by ID
+func (r *categoryRepository) GetByID(ctx context.Context, merchantID, id uuid.UUID) (*domain.Category, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.category.GetByID")
+	defer span.End()
+
+	query := `
+		SELECT ` + categoryColumns + `
+		FROM categories
+		WHERE merchant_id = $1 AND id = $2
+	`
+
+	var category domain.Category
+	err := r.dbConn.DB.GetContext(ctx, &category, query, merchantID, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &category, nil
+}
@@ -0,0 +1,29 @@
+package category
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// List retrieves the categories of a merchant
+func (r *categoryRepository) List(ctx context.Context, merchantID uuid.UUID) ([]*domain.Category, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.category.List")
+	defer span.End()
+
+	query := `
+		SELECT ` + categoryColumns + `
+		FROM categories
+		WHERE merchant_id = $1
+		ORDER BY name
+	`
+
+	categories := []*domain.Category{}
+	if err := r.dbConn.DB.SelectContext(ctx, &categories, query, merchantID); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
@@ -0,0 +1,31 @@
+package category
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// Update updates a category
+func (r *categoryRepository) Update(ctx context.Context, category *domain.Category) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.category.Update")
+	defer span.End()
+
+	query := `
+		UPDATE categories
+		SET parent_id = $3, name = $4, updated_at = $5
+		WHERE merchant_id = $1 AND id = $2
+	`
+
+	_, err := r.dbConn.DB.ExecContext(
+		ctx,
+		query,
+		category.MerchantID,
+		category.ID,
+		category.ParentID,
+		category.Name,
+		category.UpdatedAt,
+	)
+
+	return err
+}
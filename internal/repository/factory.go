@@ -3,8 +3,28 @@ package repository
 import (
 	"github/kijunpos/config/db"
 	"github/kijunpos/internal/domain"
+	categoryRepo "github/kijunpos/internal/repository/category"
+	inventoryRepo "github/kijunpos/internal/repository/inventory"
+	loyaltyRepo "github/kijunpos/internal/repository/loyalty"
+	merchantRepo "github/kijunpos/internal/repository/merchant"
+	orderRepo "github/kijunpos/internal/repository/order"
+	outletRepo "github/kijunpos/internal/repository/outlet"
+	paymentRepo "github/kijunpos/internal/repository/payment"
+	productRepo "github/kijunpos/internal/repository/product"
+	promotionRepo "github/kijunpos/internal/repository/promotion"
+	rateLimitPostgres "github/kijunpos/internal/repository/ratelimit/postgres"
+	rateLimitRedis "github/kijunpos/internal/repository/ratelimit/redis"
+	receiptRepo "github/kijunpos/internal/repository/receipt"
+	refreshTokenRepo "github/kijunpos/internal/repository/refreshtoken"
+	refundRepo "github/kijunpos/internal/repository/refund"
+	roleRepo "github/kijunpos/internal/repository/role"
+	shiftRepo "github/kijunpos/internal/repository/shift"
+	taxRepo "github/kijunpos/internal/repository/tax"
 	userRepo "github/kijunpos/internal/repository/user"
-	verificationRepo "github/kijunpos/internal/repository/verification"
+	verificationPostgres "github/kijunpos/internal/repository/verification/postgres"
+	verificationRedis "github/kijunpos/internal/repository/verification/redis"
+
+	goredis "github.com/redis/go-redis/v9"
 )
 
 // NewUserRepository creates a new user repository
@@ -12,7 +32,97 @@ func NewUserRepository(dbConn *db.Connection) domain.UserRepository {
 	return userRepo.NewUserRepository(dbConn)
 }
 
-// NewVerificationRepository creates a new verification repository
-func NewVerificationRepository() domain.VerificationRepository {
-	return verificationRepo.NewVerificationRepository()
+// NewPostgresVerificationRepository creates a new PostgreSQL backed verification repository
+func NewPostgresVerificationRepository(dbConn *db.Connection, secret string, maxAttempts int) domain.VerificationRepository {
+	return verificationPostgres.NewVerificationRepository(dbConn, secret, maxAttempts)
+}
+
+// NewRedisVerificationRepository creates a new Redis backed verification repository
+func NewRedisVerificationRepository(client *goredis.Client, secret string, maxAttempts int) domain.VerificationRepository {
+	return verificationRedis.NewVerificationRepository(client, secret, maxAttempts)
+}
+
+// NewPostgresRateLimitRepository creates a new PostgreSQL backed rate limit repository
+func NewPostgresRateLimitRepository(dbConn *db.Connection) domain.RateLimitRepository {
+	return rateLimitPostgres.NewRateLimitRepository(dbConn)
+}
+
+// NewRedisRateLimitRepository creates a new Redis backed rate limit repository
+func NewRedisRateLimitRepository(client *goredis.Client) domain.RateLimitRepository {
+	return rateLimitRedis.NewRateLimitRepository(client)
+}
+
+// NewRefreshTokenRepository creates a new refresh token repository
+func NewRefreshTokenRepository(dbConn *db.Connection) domain.RefreshTokenRepository {
+	return refreshTokenRepo.NewRefreshTokenRepository(dbConn)
+}
+
+// NewRoleRepository creates a new role repository
+func NewRoleRepository(dbConn *db.Connection) domain.RoleRepository {
+	return roleRepo.NewRoleRepository(dbConn)
+}
+
+// NewMerchantRepository creates a new merchant repository
+func NewMerchantRepository(dbConn *db.Connection) domain.MerchantRepository {
+	return merchantRepo.NewMerchantRepository(dbConn)
+}
+
+// NewOutletRepository creates a new outlet repository
+func NewOutletRepository(dbConn *db.Connection) domain.OutletRepository {
+	return outletRepo.NewOutletRepository(dbConn)
+}
+
+// NewCategoryRepository creates a new category repository
+func NewCategoryRepository(dbConn *db.Connection) domain.CategoryRepository {
+	return categoryRepo.NewCategoryRepository(dbConn)
+}
+
+// NewProductRepository creates a new product repository
+func NewProductRepository(dbConn *db.Connection) domain.ProductRepository {
+	return productRepo.NewProductRepository(dbConn)
+}
+
+// NewInventoryRepository creates a new inventory repository
+func NewInventoryRepository(dbConn *db.Connection) domain.InventoryRepository {
+	return inventoryRepo.NewInventoryRepository(dbConn)
+}
+
+// NewOrderRepository creates a new order repository
+func NewOrderRepository(dbConn *db.Connection) domain.OrderRepository {
+	return orderRepo.NewOrderRepository(dbConn)
+}
+
+// NewPaymentRepository creates a new payment repository
+func NewPaymentRepository(dbConn *db.Connection) domain.PaymentRepository {
+	return paymentRepo.NewPaymentRepository(dbConn)
+}
+
+// NewRefundRepository creates a new refund repository
+func NewRefundRepository(dbConn *db.Connection) domain.RefundRepository {
+	return refundRepo.NewRefundRepository(dbConn)
+}
+
+// NewShiftRepository creates a new shift repository
+func NewShiftRepository(dbConn *db.Connection) domain.ShiftRepository {
+	return shiftRepo.NewShiftRepository(dbConn)
+}
+
+// NewReceiptRepository creates a new receipt settings repository
+func NewReceiptRepository(dbConn *db.Connection) domain.ReceiptRepository {
+	return receiptRepo.NewReceiptRepository(dbConn)
+}
+
+// NewTaxRuleRepository creates a new tax rule repository
+func NewTaxRuleRepository(dbConn *db.Connection) domain.TaxRuleRepository {
+	return taxRepo.NewTaxRuleRepository(dbConn)
+}
+
+// NewPromotionRepository creates a new promotion repository
+func NewPromotionRepository(dbConn *db.Connection) domain.PromotionRepository {
+	return promotionRepo.NewPromotionRepository(dbConn)
+}
+
+// NewLoyaltyRepository creates a new loyalty repository
+func NewLoyaltyRepository(dbConn *db.Connection) domain.LoyaltyRepository {
+	return loyaltyRepo.NewLoyaltyRepository(dbConn)
 }
@@ -0,0 +1,147 @@
+package inventory
+
+import (
+	"bytes"
+	"context"
+	"github/kijunpos/internal/domain"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// levelKey identifies the stock level of a variant at an outlet
+type levelKey struct {
+	outletID  uuid.UUID
+	variantID uuid.UUID
+}
+
+// lockedLevel is a stock level locked for the rest of the transaction
+type lockedLevel struct {
+	onHand        int64
+	allowNegative bool
+}
+
+// ApplyMovements locks the stock levels touched by the movements, appends the
+// movements to the ledger and stores the new levels within tx, which lets
+// other repositories change stock together with their own rows. Levels are
+// locked in a fixed order so concurrent transactions cannot deadlock.
+func ApplyMovements(ctx context.Context, tx *sqlx.Tx, movements []*domain.StockMovement) error {
+	merchants := make(map[levelKey]uuid.UUID, len(movements))
+	for _, movement := range movements {
+		merchants[levelKey{movement.OutletID, movement.VariantID}] = movement.MerchantID
+	}
+
+	keys := make([]levelKey, 0, len(merchants))
+	for key := range merchants {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if c := bytes.Compare(keys[i].outletID[:], keys[j].outletID[:]); c != 0 {
+			return c < 0
+		}
+		return bytes.Compare(keys[i].variantID[:], keys[j].variantID[:]) < 0
+	})
+
+	levels := make(map[levelKey]*lockedLevel, len(keys))
+	for _, key := range keys {
+		level, err := lockLevel(ctx, tx, merchants[key], key)
+		if err != nil {
+			return err
+		}
+		levels[key] = level
+	}
+
+	for _, movement := range movements {
+		level := levels[levelKey{movement.OutletID, movement.VariantID}]
+		if movement.Type == domain.StockMovementStockTake {
+			movement.Quantity = movement.BalanceAfter - level.onHand
+		} else {
+			movement.BalanceAfter = level.onHand + movement.Quantity
+		}
+		if movement.Quantity < 0 && movement.BalanceAfter < 0 && !level.allowNegative {
+			return &domain.InsufficientStockError{
+				OutletID:  movement.OutletID,
+				VariantID: movement.VariantID,
+				OnHand:    level.onHand,
+				Requested: -movement.Quantity,
+			}
+		}
+		level.onHand = movement.BalanceAfter
+
+		if err := insertMovement(ctx, tx, movement); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range keys {
+		query := `
+			UPDATE stock_levels
+			SET on_hand = $3, updated_at = NOW()
+			WHERE outlet_id = $1 AND variant_id = $2
+		`
+		if _, err := tx.ExecContext(ctx, query, key.outletID, key.variantID, levels[key].onHand); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lockLevel locks the stock level of a variant at an outlet, creating it when
+// the variant was never stocked there
+func lockLevel(ctx context.Context, tx *sqlx.Tx, merchantID uuid.UUID, key levelKey) (*lockedLevel, error) {
+	insertQuery := `
+		INSERT INTO stock_levels (outlet_id, variant_id, merchant_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (outlet_id, variant_id) DO NOTHING
+	`
+	if _, err := tx.ExecContext(ctx, insertQuery, key.outletID, key.variantID, merchantID); err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT s.on_hand, o.allow_negative_stock
+		FROM stock_levels s
+		JOIN outlets o ON o.id = s.outlet_id
+		WHERE s.outlet_id = $1 AND s.variant_id = $2 AND s.merchant_id = $3
+		FOR UPDATE OF s
+	`
+
+	var level lockedLevel
+	err := tx.QueryRowxContext(ctx, query, key.outletID, key.variantID, merchantID).Scan(&level.onHand, &level.allowNegative)
+	if err != nil {
+		return nil, err
+	}
+	return &level, nil
+}
+
+// insertMovement appends a movement to the ledger
+func insertMovement(ctx context.Context, tx *sqlx.Tx, movement *domain.StockMovement) error {
+	query := `
+		INSERT INTO stock_movements (
+			id, merchant_id, outlet_id, variant_id, type, quantity, balance_after,
+			reference_id, note, created_by, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+		)
+	`
+
+	_, err := tx.ExecContext(
+		ctx,
+		query,
+		movement.ID,
+		movement.MerchantID,
+		movement.OutletID,
+		movement.VariantID,
+		movement.Type,
+		movement.Quantity,
+		movement.BalanceAfter,
+		movement.ReferenceID,
+		movement.Note,
+		movement.CreatedBy,
+		movement.CreatedAt,
+	)
+
+	return err
+}
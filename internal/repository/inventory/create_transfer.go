@@ -0,0 +1,58 @@
+package inventory
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// CreateTransfer stores a stock transfer together with its movements
+func (r *inventoryRepository) CreateTransfer(ctx context.Context, transfer *domain.StockTransfer, movements []*domain.StockMovement) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.inventory.CreateTransfer")
+	defer span.End()
+
+	tx, err := r.dbConn.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO stock_transfers (
+			id, merchant_id, from_outlet_id, to_outlet_id, note, created_by, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7
+		)
+	`
+
+	_, err = tx.ExecContext(
+		ctx,
+		query,
+		transfer.ID,
+		transfer.MerchantID,
+		transfer.FromOutletID,
+		transfer.ToOutletID,
+		transfer.Note,
+		transfer.CreatedBy,
+		transfer.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	itemQuery := `
+		INSERT INTO stock_transfer_items (transfer_id, variant_id, quantity)
+		VALUES ($1, $2, $3)
+	`
+	for _, item := range transfer.Items {
+		if _, err := tx.ExecContext(ctx, itemQuery, transfer.ID, item.VariantID, item.Quantity); err != nil {
+			return err
+		}
+	}
+
+	if err := ApplyMovements(ctx, tx, movements); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,22 @@
+package inventory
+
+import (
+	"github/kijunpos/config/db"
+	"github/kijunpos/internal/domain"
+)
+
+// movementColumns is the column list selected into domain.StockMovement
+const movementColumns = `
+		id, merchant_id, outlet_id, variant_id, type, quantity, balance_after,
+		reference_id, note, created_by, created_at`
+
+type inventoryRepository struct {
+	dbConn *db.Connection
+}
+
+// NewInventoryRepository creates a new inventory repository
+func NewInventoryRepository(dbConn *db.Connection) domain.InventoryRepository {
+	return &inventoryRepository{
+		dbConn: dbConn,
+	}
+}
@@ -0,0 +1,50 @@
+package inventory
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// ListLevels lists the stock of the variants of a merchant at an outlet,
+// variants that were never stocked there are listed with nothing on hand
+func (r *inventoryRepository) ListLevels(ctx context.Context, merchantID, outletID uuid.UUID, filter domain.StockLevelFilter) ([]*domain.StockLevel, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.inventory.ListLevels")
+	defer span.End()
+
+	query := `
+		SELECT
+			v.merchant_id, CAST(? AS UUID) AS outlet_id, v.id AS variant_id, v.sku, v.name AS variant_name,
+			p.name AS product_name, COALESCE(s.on_hand, 0) AS on_hand,
+			s.low_stock_threshold, s.updated_at
+		FROM product_variants v
+		JOIN products p ON p.id = v.product_id
+		LEFT JOIN stock_levels s ON s.variant_id = v.id AND s.outlet_id = ?
+		WHERE v.merchant_id = ? AND v.deleted_at IS NULL AND p.deleted_at IS NULL
+	`
+	args := []interface{}{outletID, outletID, merchantID}
+
+	if len(filter.VariantIDs) > 0 {
+		query += " AND v.id IN (?)"
+		args = append(args, filter.VariantIDs)
+	}
+	if filter.LowStockOnly {
+		query += " AND s.low_stock_threshold IS NOT NULL AND COALESCE(s.on_hand, 0) <= s.low_stock_threshold"
+	}
+	query += " ORDER BY p.name, v.name, v.id"
+
+	query, args, err := sqlx.In(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	levels := []*domain.StockLevel{}
+	if err := r.dbConn.DB.SelectContext(ctx, &levels, r.dbConn.DB.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+
+	return levels, nil
+}
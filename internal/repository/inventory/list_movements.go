@@ -0,0 +1,43 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ListMovements lists the stock ledger of an outlet, newest first, starting after the cursor
+func (r *inventoryRepository) ListMovements(ctx context.Context, merchantID, outletID, variantID uuid.UUID, after *domain.PageCursor, limit int) ([]*domain.StockMovement, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.inventory.ListMovements")
+	defer span.End()
+
+	conditions := []string{"merchant_id = $1", "outlet_id = $2"}
+	args := []interface{}{merchantID, outletID}
+	if variantID != uuid.Nil {
+		args = append(args, variantID)
+		conditions = append(conditions, fmt.Sprintf("variant_id = $%d", len(args)))
+	}
+	if after != nil {
+		args = append(args, after.CreatedAt, after.ID)
+		conditions = append(conditions, fmt.Sprintf("(created_at, id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	args = append(args, limit)
+	query := `
+		SELECT ` + movementColumns + `
+		FROM stock_movements
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT ` + fmt.Sprintf("$%d", len(args))
+
+	movements := []*domain.StockMovement{}
+	if err := r.dbConn.DB.SelectContext(ctx, &movements, query, args...); err != nil {
+		return nil, err
+	}
+
+	return movements, nil
+}
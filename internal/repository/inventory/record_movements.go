@@ -0,0 +1,25 @@
+package inventory
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// RecordMovements appends stock movements to the ledger in one transaction
+func (r *inventoryRepository) RecordMovements(ctx context.Context, movements []*domain.StockMovement) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.inventory.RecordMovements")
+	defer span.End()
+
+	tx, err := r.dbConn.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := ApplyMovements(ctx, tx, movements); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,24 @@
+package inventory
+
+import (
+	"context"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// SetLowStockThreshold sets or removes the low stock threshold of a variant at an outlet
+func (r *inventoryRepository) SetLowStockThreshold(ctx context.Context, merchantID, outletID, variantID uuid.UUID, threshold *int64) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.inventory.SetLowStockThreshold")
+	defer span.End()
+
+	query := `
+		INSERT INTO stock_levels (outlet_id, variant_id, merchant_id, low_stock_threshold)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (outlet_id, variant_id) DO UPDATE
+		SET low_stock_threshold = EXCLUDED.low_stock_threshold
+	`
+
+	_, err := r.dbConn.DB.ExecContext(ctx, query, outletID, variantID, merchantID, threshold)
+	return err
+}
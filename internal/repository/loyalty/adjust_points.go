@@ -0,0 +1,25 @@
+package loyalty
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// AdjustPoints records a manual points entry of a customer
+func (r *loyaltyRepository) AdjustPoints(ctx context.Context, entry *domain.PointEntry) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.loyalty.AdjustPoints")
+	defer span.End()
+
+	tx, err := r.dbConn.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := ApplyEntries(ctx, tx, []*domain.PointEntry{entry}); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,203 @@
+package loyalty
+
+import (
+	"bytes"
+	"context"
+	"github/kijunpos/internal/domain"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// ApplyEntries records points entries within tx and updates the balances of
+// their customers, which lets the order repository record points at checkout
+// and void and the refund repository at refunds. The customers are locked in
+// the order of their IDs and their points due at the time of the first entry
+// expire first. Entries taking
+// points spend the earliest expiring points and fail with
+// domain.ErrInsufficientPoints beyond the balance, except for void and refund
+// entries which take at most the balance.
+func ApplyEntries(ctx context.Context, tx *sqlx.Tx, entries []*domain.PointEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	merchants := make(map[uuid.UUID]uuid.UUID, len(entries))
+	for _, entry := range entries {
+		merchants[entry.CustomerID] = entry.MerchantID
+	}
+	customerIDs := make([]uuid.UUID, 0, len(merchants))
+	for id := range merchants {
+		customerIDs = append(customerIDs, id)
+	}
+	sort.Slice(customerIDs, func(i, j int) bool {
+		return bytes.Compare(customerIDs[i][:], customerIDs[j][:]) < 0
+	})
+
+	balances := make(map[uuid.UUID]int64, len(customerIDs))
+	for _, id := range customerIDs {
+		balance, err := lockBalance(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		if balance, err = expire(ctx, tx, merchants[id], id, balance, entries[0].CreatedAt); err != nil {
+			return err
+		}
+		balances[id] = balance
+	}
+
+	for _, entry := range entries {
+		balance := balances[entry.CustomerID]
+		if entry.Points < 0 && -entry.Points > balance {
+			if entry.Type != domain.PointEntryVoid && entry.Type != domain.PointEntryRefund {
+				return domain.ErrInsufficientPoints
+			}
+			entry.Points = -balance
+		}
+		if entry.Points == 0 {
+			continue
+		}
+
+		if entry.Points < 0 {
+			if err := spend(ctx, tx, entry.CustomerID, -entry.Points); err != nil {
+				return err
+			}
+			entry.Remaining = 0
+		} else {
+			entry.Remaining = entry.Points
+		}
+		entry.BalanceAfter = balance + entry.Points
+		balances[entry.CustomerID] = entry.BalanceAfter
+
+		if err := insertEntry(ctx, tx, entry); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range customerIDs {
+		query := `UPDATE customers SET points_balance = $2 WHERE id = $1`
+		if _, err := tx.ExecContext(ctx, query, id, balances[id]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lockBalance locks a customer until tx ends and returns their balance
+func lockBalance(ctx context.Context, tx *sqlx.Tx, customerID uuid.UUID) (int64, error) {
+	var balance int64
+	query := `SELECT points_balance FROM customers WHERE id = $1 FOR UPDATE`
+	if err := tx.QueryRowxContext(ctx, query, customerID).Scan(&balance); err != nil {
+		return 0, err
+	}
+	return balance, nil
+}
+
+// expire records the expiry of the points of a locked customer due at a time
+// and returns the balance after it
+func expire(ctx context.Context, tx *sqlx.Tx, merchantID, customerID uuid.UUID, balance int64, at time.Time) (int64, error) {
+	query := `
+		WITH due AS (
+			SELECT id, remaining
+			FROM point_entries
+			WHERE customer_id = $1 AND remaining > 0 AND expires_at <= $2
+			FOR UPDATE
+		)
+		UPDATE point_entries p
+		SET remaining = 0
+		FROM due
+		WHERE p.id = due.id
+		RETURNING due.remaining
+	`
+	expired := []int64{}
+	if err := tx.SelectContext(ctx, &expired, query, customerID, at); err != nil {
+		return 0, err
+	}
+
+	var points int64
+	for _, remaining := range expired {
+		points += remaining
+	}
+	if points == 0 {
+		return balance, nil
+	}
+
+	entry := &domain.PointEntry{
+		ID:           uuid.New(),
+		MerchantID:   merchantID,
+		CustomerID:   customerID,
+		Type:         domain.PointEntryExpire,
+		Points:       -points,
+		BalanceAfter: balance - points,
+		CreatedAt:    at,
+	}
+	if err := insertEntry(ctx, tx, entry); err != nil {
+		return 0, err
+	}
+	return entry.BalanceAfter, nil
+}
+
+// spend takes points from the remaining points of a locked customer, the
+// earliest expiring first
+func spend(ctx context.Context, tx *sqlx.Tx, customerID uuid.UUID, points int64) error {
+	query := `
+		SELECT id, remaining
+		FROM point_entries
+		WHERE customer_id = $1 AND remaining > 0
+		ORDER BY expires_at NULLS LAST, created_at, id
+	`
+	lots := []struct {
+		ID        uuid.UUID `db:"id"`
+		Remaining int64     `db:"remaining"`
+	}{}
+	if err := tx.SelectContext(ctx, &lots, query, customerID); err != nil {
+		return err
+	}
+
+	for _, lot := range lots {
+		if points == 0 {
+			break
+		}
+		taken := min(points, lot.Remaining)
+		query := `UPDATE point_entries SET remaining = remaining - $2 WHERE id = $1`
+		if _, err := tx.ExecContext(ctx, query, lot.ID, taken); err != nil {
+			return err
+		}
+		points -= taken
+	}
+	if points > 0 {
+		return domain.ErrInsufficientPoints
+	}
+	return nil
+}
+
+// insertEntry stores a points entry
+func insertEntry(ctx context.Context, tx *sqlx.Tx, entry *domain.PointEntry) error {
+	query := `
+		INSERT INTO point_entries (
+			id, merchant_id, customer_id, order_id, type, points, balance_after, remaining,
+			expires_at, note, created_by, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+		)
+	`
+	_, err := tx.ExecContext(
+		ctx,
+		query,
+		entry.ID,
+		entry.MerchantID,
+		entry.CustomerID,
+		entry.OrderID,
+		entry.Type,
+		entry.Points,
+		entry.BalanceAfter,
+		entry.Remaining,
+		entry.ExpiresAt,
+		entry.Note,
+		entry.CreatedBy,
+		entry.CreatedAt,
+	)
+	return err
+}
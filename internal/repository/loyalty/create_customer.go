@@ -0,0 +1,42 @@
+package loyalty
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/repository/pgerror"
+)
+
+// CreateCustomer stores a new customer
+func (r *loyaltyRepository) CreateCustomer(ctx context.Context, customer *domain.Customer) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.loyalty.CreateCustomer")
+	defer span.End()
+
+	query := `
+		INSERT INTO customers (
+			id, merchant_id, user_id, name, whatsapp_number, email, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7
+		)
+	`
+
+	_, err := r.dbConn.DB.ExecContext(
+		ctx,
+		query,
+		customer.ID,
+		customer.MerchantID,
+		customer.UserID,
+		customer.Name,
+		customer.WhatsAppNumber,
+		customer.Email,
+		customer.CreatedAt,
+	)
+	if err != nil {
+		if pgerror.IsUniqueViolation(err, "") {
+			return domain.ErrCustomerExists
+		}
+		return err
+	}
+
+	return nil
+}
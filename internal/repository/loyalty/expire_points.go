@@ -0,0 +1,40 @@
+package loyalty
+
+import (
+	"context"
+	"github/kijunpos/internal/pkg/apm"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ExpirePoints records the expiry of the points of a customer due at a time
+func (r *loyaltyRepository) ExpirePoints(ctx context.Context, merchantID, customerID uuid.UUID, at time.Time) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.loyalty.ExpirePoints")
+	defer span.End()
+
+	tx, err := r.dbConn.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	balance, err := lockBalance(ctx, tx, customerID)
+	if err != nil {
+		return err
+	}
+	after, err := expire(ctx, tx, merchantID, customerID, balance, at)
+	if err != nil {
+		return err
+	}
+	if after == balance {
+		return nil
+	}
+
+	query := `UPDATE customers SET points_balance = $2 WHERE id = $1`
+	if _, err := tx.ExecContext(ctx, query, customerID, after); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,31 @@
+package loyalty
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetCustomer retrieves a customer of a merchant by ID
+func (r *loyaltyRepository) GetCustomer(ctx context.Context, merchantID, id uuid.UUID) (*domain.Customer, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.loyalty.GetCustomer")
+	defer span.End()
+
+	query := selectCustomers + `
+		WHERE c.merchant_id = $1 AND c.id = $2
+	`
+
+	var customer domain.Customer
+	err := r.dbConn.DB.GetContext(ctx, &customer, query, merchantID, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &customer, nil
+}
@@ -0,0 +1,31 @@
+package loyalty
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetCustomerByWhatsApp retrieves a customer of a merchant by WhatsApp number
+func (r *loyaltyRepository) GetCustomerByWhatsApp(ctx context.Context, merchantID uuid.UUID, whatsAppNumber string) (*domain.Customer, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.loyalty.GetCustomerByWhatsApp")
+	defer span.End()
+
+	query := selectCustomers + `
+		WHERE c.merchant_id = $1 AND c.whatsapp_number = $2
+	`
+
+	var customer domain.Customer
+	err := r.dbConn.DB.GetContext(ctx, &customer, query, merchantID, whatsAppNumber)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &customer, nil
+}
@@ -0,0 +1,44 @@
+package loyalty
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetProgram retrieves the loyalty program of a merchant with its tiers
+func (r *loyaltyRepository) GetProgram(ctx context.Context, merchantID uuid.UUID) (*domain.LoyaltyProgram, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.loyalty.GetProgram")
+	defer span.End()
+
+	query := `
+		SELECT ` + programColumns + `
+		FROM loyalty_programs
+		WHERE merchant_id = $1
+	`
+
+	var program domain.LoyaltyProgram
+	err := r.dbConn.DB.GetContext(ctx, &program, query, merchantID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	tiersQuery := `
+		SELECT ` + tierColumns + `
+		FROM loyalty_tiers
+		WHERE merchant_id = $1
+		ORDER BY min_spend
+	`
+	program.Tiers = []*domain.LoyaltyTier{}
+	if err := r.dbConn.DB.SelectContext(ctx, &program.Tiers, tiersQuery, merchantID); err != nil {
+		return nil, err
+	}
+
+	return &program, nil
+}
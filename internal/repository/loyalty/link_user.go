@@ -0,0 +1,24 @@
+package loyalty
+
+import (
+	"context"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// LinkUser links the customers with a WhatsApp number that are not linked to
+// a user yet to the user
+func (r *loyaltyRepository) LinkUser(ctx context.Context, userID uuid.UUID, whatsAppNumber string) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.loyalty.LinkUser")
+	defer span.End()
+
+	query := `
+		UPDATE customers
+		SET user_id = $1
+		WHERE whatsapp_number = $2 AND user_id IS NULL
+	`
+
+	_, err := r.dbConn.DB.ExecContext(ctx, query, userID, whatsAppNumber)
+	return err
+}
@@ -0,0 +1,45 @@
+package loyalty
+
+import (
+	"context"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// likeEscaper escapes the wildcards of a LIKE pattern
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// ListCustomers retrieves the customers of a merchant matching the search,
+// newest first, starting after the cursor
+func (r *loyaltyRepository) ListCustomers(ctx context.Context, merchantID uuid.UUID, search string, after *domain.PageCursor, limit int) ([]*domain.Customer, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.loyalty.ListCustomers")
+	defer span.End()
+
+	conditions := []string{"c.merchant_id = $1"}
+	args := []interface{}{merchantID}
+	if search != "" {
+		args = append(args, "%"+likeEscaper.Replace(search)+"%")
+		conditions = append(conditions, fmt.Sprintf("(c.name ILIKE $%[1]d OR c.whatsapp_number LIKE $%[1]d)", len(args)))
+	}
+	if after != nil {
+		args = append(args, after.CreatedAt, after.ID)
+		conditions = append(conditions, fmt.Sprintf("(c.created_at, c.id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	args = append(args, limit)
+	query := selectCustomers + `
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY c.created_at DESC, c.id DESC
+		LIMIT ` + fmt.Sprintf("$%d", len(args))
+
+	customers := []*domain.Customer{}
+	if err := r.dbConn.DB.SelectContext(ctx, &customers, query, args...); err != nil {
+		return nil, err
+	}
+
+	return customers, nil
+}
@@ -0,0 +1,28 @@
+package loyalty
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// ListCustomersByUser retrieves the customers linked to a user at every
+// merchant, oldest first
+func (r *loyaltyRepository) ListCustomersByUser(ctx context.Context, userID uuid.UUID) ([]*domain.Customer, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.loyalty.ListCustomersByUser")
+	defer span.End()
+
+	query := selectCustomers + `
+		WHERE c.user_id = $1
+		ORDER BY c.created_at, c.id
+	`
+
+	customers := []*domain.Customer{}
+	if err := r.dbConn.DB.SelectContext(ctx, &customers, query, userID); err != nil {
+		return nil, err
+	}
+
+	return customers, nil
+}
@@ -0,0 +1,40 @@
+package loyalty
+
+import (
+	"context"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ListPointEntries retrieves the points ledger of a customer, newest first,
+// starting after the cursor
+func (r *loyaltyRepository) ListPointEntries(ctx context.Context, merchantID, customerID uuid.UUID, after *domain.PageCursor, limit int) ([]*domain.PointEntry, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.loyalty.ListPointEntries")
+	defer span.End()
+
+	conditions := []string{"merchant_id = $1", "customer_id = $2"}
+	args := []interface{}{merchantID, customerID}
+	if after != nil {
+		args = append(args, after.CreatedAt, after.ID)
+		conditions = append(conditions, fmt.Sprintf("(created_at, id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	args = append(args, limit)
+	query := `
+		SELECT ` + entryColumns + `
+		FROM point_entries
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT ` + fmt.Sprintf("$%d", len(args))
+
+	entries := []*domain.PointEntry{}
+	if err := r.dbConn.DB.SelectContext(ctx, &entries, query, args...); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
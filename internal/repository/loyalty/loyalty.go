@@ -0,0 +1,48 @@
+package loyalty
+
+import (
+	"github/kijunpos/config/db"
+	"github/kijunpos/internal/domain"
+)
+
+// programColumns is the column list selected into domain.LoyaltyProgram
+const programColumns = `
+		merchant_id, is_active, earn_spend, earn_points, point_value, min_redeem_points,
+		max_redeem_percent, expiry_days, created_at, updated_at`
+
+// tierColumns is the column list selected into domain.LoyaltyTier
+const tierColumns = `
+		id, merchant_id, name, min_spend, multiplier`
+
+// selectCustomers selects customers into domain.Customer with the spend and
+// visits summed up from their completed sales
+const selectCustomers = `
+		SELECT
+			c.id, c.merchant_id, c.user_id, c.name, c.whatsapp_number, c.email, c.points_balance,
+			c.created_at, c.updated_at, COALESCE(s.total_spend, 0) AS total_spend,
+			s.visit_count, s.last_purchase_at
+		FROM customers c
+		LEFT JOIN LATERAL (
+			SELECT
+				SUM(o.total - o.refunded_total) AS total_spend,
+				COUNT(*) AS visit_count,
+				MAX(o.completed_at) AS last_purchase_at
+			FROM orders o
+			WHERE o.customer_id = c.id AND o.status = 'completed'
+		) s ON true`
+
+// entryColumns is the column list selected into domain.PointEntry
+const entryColumns = `
+		id, merchant_id, customer_id, order_id, type, points, balance_after, remaining,
+		expires_at, note, created_by, created_at`
+
+type loyaltyRepository struct {
+	dbConn *db.Connection
+}
+
+// NewLoyaltyRepository creates a new loyalty repository
+func NewLoyaltyRepository(dbConn *db.Connection) domain.LoyaltyRepository {
+	return &loyaltyRepository{
+		dbConn: dbConn,
+	}
+}
@@ -0,0 +1,75 @@
+package loyalty
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// SaveProgram inserts or updates the loyalty program of a merchant and
+// replaces its tiers in one transaction
+func (r *loyaltyRepository) SaveProgram(ctx context.Context, program *domain.LoyaltyProgram) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.loyalty.SaveProgram")
+	defer span.End()
+
+	tx, err := r.dbConn.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO loyalty_programs (
+			merchant_id, is_active, earn_spend, earn_points, point_value, min_redeem_points,
+			max_redeem_percent, expiry_days, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9
+		)
+		ON CONFLICT (merchant_id) DO UPDATE
+		SET
+			is_active = EXCLUDED.is_active,
+			earn_spend = EXCLUDED.earn_spend,
+			earn_points = EXCLUDED.earn_points,
+			point_value = EXCLUDED.point_value,
+			min_redeem_points = EXCLUDED.min_redeem_points,
+			max_redeem_percent = EXCLUDED.max_redeem_percent,
+			expiry_days = EXCLUDED.expiry_days,
+			updated_at = $10
+		RETURNING created_at, updated_at
+	`
+	err = tx.QueryRowxContext(
+		ctx,
+		query,
+		program.MerchantID,
+		program.IsActive,
+		program.EarnSpend,
+		program.EarnPoints,
+		program.PointValue,
+		program.MinRedeemPoints,
+		program.MaxRedeemPercent,
+		program.ExpiryDays,
+		program.CreatedAt,
+		program.UpdatedAt,
+	).Scan(&program.CreatedAt, &program.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM loyalty_tiers WHERE merchant_id = $1`, program.MerchantID); err != nil {
+		return err
+	}
+	tierQuery := `
+		INSERT INTO loyalty_tiers (
+			id, merchant_id, name, min_spend, multiplier
+		) VALUES (
+			$1, $2, $3, $4, $5
+		)
+	`
+	for _, tier := range program.Tiers {
+		if _, err := tx.ExecContext(ctx, tierQuery, tier.ID, tier.MerchantID, tier.Name, tier.MinSpend, tier.Multiplier); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
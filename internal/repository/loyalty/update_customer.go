@@ -0,0 +1,45 @@
+package loyalty
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/repository/pgerror"
+)
+
+// UpdateCustomer updates the details of a customer, the points are left unchanged
+func (r *loyaltyRepository) UpdateCustomer(ctx context.Context, customer *domain.Customer) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.loyalty.UpdateCustomer")
+	defer span.End()
+
+	query := `
+		UPDATE customers
+		SET
+			user_id = $3,
+			name = $4,
+			whatsapp_number = $5,
+			email = $6,
+			updated_at = $7
+		WHERE merchant_id = $1 AND id = $2
+	`
+
+	_, err := r.dbConn.DB.ExecContext(
+		ctx,
+		query,
+		customer.MerchantID,
+		customer.ID,
+		customer.UserID,
+		customer.Name,
+		customer.WhatsAppNumber,
+		customer.Email,
+		customer.UpdatedAt,
+	)
+	if err != nil {
+		if pgerror.IsUniqueViolation(err, "") {
+			return domain.ErrCustomerExists
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package merchant
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// Create stores a new merchant together with the owner membership
+func (r *merchantRepository) Create(ctx context.Context, merchant *domain.Merchant) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.merchant.Create")
+	defer span.End()
+
+	// A single statement keeps the merchant and its owner consistent
+	query := `
+		WITH merchant AS (
+			INSERT INTO merchants (id, name, owner_user_id, created_at)
+			VALUES ($1, $2, $3, $4)
+			RETURNING id, owner_user_id, created_at
+		)
+		INSERT INTO merchant_members (merchant_id, user_id, role, created_at)
+		SELECT id, owner_user_id, $5, created_at
+		FROM merchant
+	`
+
+	_, err := r.dbConn.DB.ExecContext(
+		ctx,
+		query,
+		merchant.ID,
+		merchant.Name,
+		merchant.OwnerUserID,
+		merchant.CreatedAt,
+		domain.RoleOwner,
+	)
+
+	return err
+}
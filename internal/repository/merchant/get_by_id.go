@@ -0,0 +1,33 @@
+package merchant
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetByID retrieves a merchant by ID
+func (r *merchantRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Merchant, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.merchant.GetByID")
+	defer span.End()
+
+	query := `
+		SELECT id, name, owner_user_id, created_at, updated_at
+		FROM merchants
+		WHERE id = $1
+	`
+
+	var merchant domain.Merchant
+	err := r.dbConn.DB.GetContext(ctx, &merchant, query, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &merchant, nil
+}
@@ -0,0 +1,33 @@
+package merchant
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetMember retrieves the membership of a user in a merchant
+func (r *merchantRepository) GetMember(ctx context.Context, merchantID, userID uuid.UUID) (*domain.MerchantMember, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.merchant.GetMember")
+	defer span.End()
+
+	query := `
+		SELECT merchant_id, user_id, role, created_at
+		FROM merchant_members
+		WHERE merchant_id = $1 AND user_id = $2
+	`
+
+	var member domain.MerchantMember
+	err := r.dbConn.DB.GetContext(ctx, &member, query, merchantID, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &member, nil
+}
@@ -0,0 +1,30 @@
+package merchant
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// ListByUserID retrieves the merchants a user is a member of
+func (r *merchantRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.Merchant, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.merchant.ListByUserID")
+	defer span.End()
+
+	query := `
+		SELECT m.id, m.name, m.owner_user_id, m.created_at, m.updated_at
+		FROM merchants m
+		JOIN merchant_members mm ON mm.merchant_id = m.id
+		WHERE mm.user_id = $1
+		ORDER BY m.name
+	`
+
+	merchants := []*domain.Merchant{}
+	if err := r.dbConn.DB.SelectContext(ctx, &merchants, query, userID); err != nil {
+		return nil, err
+	}
+
+	return merchants, nil
+}
@@ -0,0 +1,17 @@
+package merchant
+
+import (
+	"github/kijunpos/config/db"
+	"github/kijunpos/internal/domain"
+)
+
+type merchantRepository struct {
+	dbConn *db.Connection
+}
+
+// NewMerchantRepository creates a new merchant repository
+func NewMerchantRepository(dbConn *db.Connection) domain.MerchantRepository {
+	return &merchantRepository{
+		dbConn: dbConn,
+	}
+}
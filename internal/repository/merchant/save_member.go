@@ -0,0 +1,30 @@
+package merchant
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// SaveMember adds a member to a merchant or updates the role of an existing member
+func (r *merchantRepository) SaveMember(ctx context.Context, member *domain.MerchantMember) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.merchant.SaveMember")
+	defer span.End()
+
+	query := `
+		INSERT INTO merchant_members (merchant_id, user_id, role, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (merchant_id, user_id) DO UPDATE SET role = EXCLUDED.role
+	`
+
+	_, err := r.dbConn.DB.ExecContext(
+		ctx,
+		query,
+		member.MerchantID,
+		member.UserID,
+		member.Role,
+		member.CreatedAt,
+	)
+
+	return err
+}
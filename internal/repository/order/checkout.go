@@ -0,0 +1,116 @@
+package order
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/repository/inventory"
+	"github/kijunpos/internal/repository/loyalty"
+	"github/kijunpos/internal/repository/payment"
+	"github/kijunpos/internal/repository/pgerror"
+	"github/kijunpos/internal/repository/promotion"
+	"github/kijunpos/internal/repository/refund"
+	"github/kijunpos/internal/repository/shift"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Checkout completes an order, stores its new payments, spends its store
+// credit payments, redeems its promotions and its voucher, takes the sold
+// items out of stock, records the points of its customer and puts the cash
+// into the drawer of its shift in one transaction
+func (r *orderRepository) Checkout(ctx context.Context, order *domain.Order, payments []*domain.Payment, movements []*domain.StockMovement, points []*domain.PointEntry, cash *domain.CashMovement) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.order.Checkout")
+	defer span.End()
+
+	tx, err := r.dbConn.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// The sequence row stays locked until commit, so numbers are never skipped
+	sequenceQuery := `
+		INSERT INTO outlet_sequences (outlet_id, name, value)
+		VALUES ($1, 'order', 1)
+		ON CONFLICT (outlet_id, name) DO UPDATE
+		SET value = outlet_sequences.value + 1
+		RETURNING value
+	`
+	var number int64
+	if err := tx.QueryRowxContext(ctx, sequenceQuery, order.OutletID).Scan(&number); err != nil {
+		return err
+	}
+	order.Number = sql.NullInt64{Int64: number, Valid: true}
+
+	if err := updateOrder(ctx, tx, order); err != nil {
+		if pgerror.IsUniqueViolation(err, "idx_orders_idempotency_key") {
+			return domain.ErrDuplicateIdempotencyKey
+		}
+		return err
+	}
+	if err := replaceDetails(ctx, tx, order); err != nil {
+		return err
+	}
+
+	for _, p := range payments {
+		if err := payment.Insert(ctx, tx, p); err != nil {
+			return err
+		}
+	}
+	if err := checkPayments(ctx, tx, order); err != nil {
+		return err
+	}
+	if err := refund.SpendStoreCredits(ctx, tx, payments); err != nil {
+		return err
+	}
+
+	if err := promotion.Redeem(ctx, tx, order); err != nil {
+		return err
+	}
+
+	if err := inventory.ApplyMovements(ctx, tx, movements); err != nil {
+		return err
+	}
+
+	if err := loyalty.ApplyEntries(ctx, tx, points); err != nil {
+		return err
+	}
+
+	if err := shift.RecordCash(ctx, tx, order.ShiftID.UUID, cash); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// checkPayments locks the payments of the order and checks that none is
+// pending and that the captured ones pay its total
+func checkPayments(ctx context.Context, tx *sqlx.Tx, order *domain.Order) error {
+	query := `
+		SELECT status, amount
+		FROM payments
+		WHERE order_id = $1
+		FOR UPDATE
+	`
+
+	payments := []*domain.Payment{}
+	if err := tx.SelectContext(ctx, &payments, query, order.ID); err != nil {
+		return err
+	}
+
+	var captured int64
+	for _, p := range payments {
+		if p.Status.IsOpen() {
+			return domain.ErrPaymentChanged
+		}
+		if p.Status == domain.PaymentStatusCaptured {
+			captured += p.Amount
+		}
+	}
+	if captured != order.Total {
+		return domain.ErrPaymentChanged
+	}
+	return nil
+}
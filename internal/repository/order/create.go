@@ -0,0 +1,49 @@
+package order
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// Create stores a new empty order with its taxes
+func (r *orderRepository) Create(ctx context.Context, order *domain.Order) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.order.Create")
+	defer span.End()
+
+	tx, err := r.dbConn.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO orders (
+			id, merchant_id, outlet_id, cashier_id, status, note, version, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8
+		)
+	`
+
+	_, err = tx.ExecContext(
+		ctx,
+		query,
+		order.ID,
+		order.MerchantID,
+		order.OutletID,
+		order.CashierID,
+		order.Status,
+		order.Note,
+		order.Version,
+		order.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := insertTaxes(ctx, tx, order); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,37 @@
+package order
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetByID retrieves an order of a merchant by ID
+func (r *orderRepository) GetByID(ctx context.Context, merchantID, id uuid.UUID) (*domain.Order, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.order.GetByID")
+	defer span.End()
+
+	query := `
+		SELECT ` + orderColumns + `
+		FROM orders
+		WHERE merchant_id = $1 AND id = $2
+	`
+
+	var order domain.Order
+	err := r.dbConn.DB.GetContext(ctx, &order, query, merchantID, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := r.loadDetails(ctx, []*domain.Order{&order}); err != nil {
+		return nil, err
+	}
+
+	return &order, nil
+}
@@ -0,0 +1,37 @@
+package order
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetByIdempotencyKey retrieves the order of a merchant checked out with the key
+func (r *orderRepository) GetByIdempotencyKey(ctx context.Context, merchantID uuid.UUID, key string) (*domain.Order, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.order.GetByIdempotencyKey")
+	defer span.End()
+
+	query := `
+		SELECT ` + orderColumns + `
+		FROM orders
+		WHERE merchant_id = $1 AND idempotency_key = $2
+	`
+
+	var order domain.Order
+	err := r.dbConn.DB.GetContext(ctx, &order, query, merchantID, key)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := r.loadDetails(ctx, []*domain.Order{&order}); err != nil {
+		return nil, err
+	}
+
+	return &order, nil
+}
@@ -0,0 +1,52 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// List retrieves the orders of an outlet matching the filter, newest first,
+// starting after the cursor
+func (r *orderRepository) List(ctx context.Context, merchantID, outletID uuid.UUID, filter domain.OrderFilter, after *domain.PageCursor, limit int) ([]*domain.Order, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.order.List")
+	defer span.End()
+
+	conditions := []string{"merchant_id = $1", "outlet_id = $2"}
+	args := []interface{}{merchantID, outletID}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	}
+	if filter.CashierID != uuid.Nil {
+		args = append(args, filter.CashierID)
+		conditions = append(conditions, fmt.Sprintf("cashier_id = $%d", len(args)))
+	}
+	if after != nil {
+		args = append(args, after.CreatedAt, after.ID)
+		conditions = append(conditions, fmt.Sprintf("(created_at, id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	args = append(args, limit)
+	query := `
+		SELECT ` + orderColumns + `
+		FROM orders
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT ` + fmt.Sprintf("$%d", len(args))
+
+	orders := []*domain.Order{}
+	if err := r.dbConn.DB.SelectContext(ctx, &orders, query, args...); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadDetails(ctx, orders); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
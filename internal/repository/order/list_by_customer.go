@@ -0,0 +1,44 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ListByCustomer retrieves the completed and voided sales of a customer at
+// every outlet, newest first, starting after the cursor
+func (r *orderRepository) ListByCustomer(ctx context.Context, merchantID, customerID uuid.UUID, after *domain.PageCursor, limit int) ([]*domain.Order, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.order.ListByCustomer")
+	defer span.End()
+
+	conditions := []string{"merchant_id = $1", "customer_id = $2", "status IN ('completed', 'voided')"}
+	args := []interface{}{merchantID, customerID}
+	if after != nil {
+		args = append(args, after.CreatedAt, after.ID)
+		conditions = append(conditions, fmt.Sprintf("(created_at, id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	args = append(args, limit)
+	query := `
+		SELECT ` + orderColumns + `
+		FROM orders
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT ` + fmt.Sprintf("$%d", len(args))
+
+	orders := []*domain.Order{}
+	if err := r.dbConn.DB.SelectContext(ctx, &orders, query, args...); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadDetails(ctx, orders); err != nil {
+		return nil, err
+	}
+
+	return orders, nil
+}
@@ -0,0 +1,103 @@
+package order
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/repository/payment"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// loadDetails attaches the items, the payments, the taxes and the promotions
+// to the orders
+func (r *orderRepository) loadDetails(ctx context.Context, orders []*domain.Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*domain.Order, len(orders))
+	ids := make([]uuid.UUID, 0, len(orders))
+	for _, order := range orders {
+		order.Items = []*domain.OrderItem{}
+		order.Payments = []*domain.Payment{}
+		order.Taxes = []*domain.OrderTax{}
+		order.Promotions = []*domain.OrderPromotion{}
+		byID[order.ID] = order
+		ids = append(ids, order.ID)
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT `+itemColumns+`
+		FROM order_items
+		WHERE order_id IN (?)
+		ORDER BY created_at, id
+	`, ids)
+	if err != nil {
+		return err
+	}
+
+	items := []*domain.OrderItem{}
+	if err := r.dbConn.DB.SelectContext(ctx, &items, r.dbConn.DB.Rebind(query), args...); err != nil {
+		return err
+	}
+	for _, item := range items {
+		byID[item.OrderID].Items = append(byID[item.OrderID].Items, item)
+	}
+
+	query, args, err = sqlx.In(`
+		SELECT `+payment.Columns+`
+		FROM payments
+		WHERE order_id IN (?)
+		ORDER BY created_at, id
+	`, ids)
+	if err != nil {
+		return err
+	}
+
+	payments := []*domain.Payment{}
+	if err := r.dbConn.DB.SelectContext(ctx, &payments, r.dbConn.DB.Rebind(query), args...); err != nil {
+		return err
+	}
+	for _, p := range payments {
+		byID[p.OrderID].Payments = append(byID[p.OrderID].Payments, p)
+	}
+
+	query, args, err = sqlx.In(`
+		SELECT `+taxColumns+`
+		FROM order_taxes
+		WHERE order_id IN (?)
+		ORDER BY order_id, CASE type WHEN 'service_charge' THEN 0 ELSE 1 END
+	`, ids)
+	if err != nil {
+		return err
+	}
+
+	taxes := []*domain.OrderTax{}
+	if err := r.dbConn.DB.SelectContext(ctx, &taxes, r.dbConn.DB.Rebind(query), args...); err != nil {
+		return err
+	}
+	for _, tax := range taxes {
+		byID[tax.OrderID].Taxes = append(byID[tax.OrderID].Taxes, tax)
+	}
+
+	query, args, err = sqlx.In(`
+		SELECT `+promotionColumns+`
+		FROM order_promotions
+		WHERE order_id IN (?)
+		ORDER BY order_id, position
+	`, ids)
+	if err != nil {
+		return err
+	}
+
+	promotions := []*domain.OrderPromotion{}
+	if err := r.dbConn.DB.SelectContext(ctx, &promotions, r.dbConn.DB.Rebind(query), args...); err != nil {
+		return err
+	}
+	for _, p := range promotions {
+		byID[p.OrderID].Promotions = append(byID[p.OrderID].Promotions, p)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package order
+
+import (
+	"github/kijunpos/config/db"
+	"github/kijunpos/internal/domain"
+)
+
+// orderColumns is the column list selected into domain.Order
+const orderColumns = `
+		id, merchant_id, outlet_id, cashier_id, shift_id, status, number, note, discount_type,
+		discount_value, voucher_id, voucher_code, customer_id, points_redeemed, points_discount,
+		points_earned, subtotal, discount_total, promotion_total,
+		service_charge_total, tax_total, total,
+		refunded_total, idempotency_key, void_reason, version, created_at, updated_at, completed_at, voided_at`
+
+// itemColumns is the column list selected into domain.OrderItem
+const itemColumns = `
+		id, order_id, product_id, variant_id, category_id, sku, name, unit, quantity, unit_price,
+		discount_type, discount_value, tax_exempt, subtotal, discount_amount, promotion_discount,
+		total, order_discount, service_charge, tax_base, tax_amount, grand_total,
+		refunded_quantity, created_at`
+
+// taxColumns is the column list selected into domain.OrderTax
+const taxColumns = `
+		order_id, type, name, rate, inclusive, base, amount`
+
+// promotionColumns is the column list selected into domain.OrderPromotion
+const promotionColumns = `
+		order_id, promotion_id, item_id, name, amount`
+
+type orderRepository struct {
+	dbConn *db.Connection
+}
+
+// NewOrderRepository creates a new order repository
+func NewOrderRepository(dbConn *db.Connection) domain.OrderRepository {
+	return &orderRepository{
+		dbConn: dbConn,
+	}
+}
@@ -0,0 +1,215 @@
+package order
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Update stores the order and replaces its items, the amounts of its taxes
+// and its promotions
+func (r *orderRepository) Update(ctx context.Context, order *domain.Order) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.order.Update")
+	defer span.End()
+
+	tx, err := r.dbConn.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := updateOrder(ctx, tx, order); err != nil {
+		return err
+	}
+	if err := replaceDetails(ctx, tx, order); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// replaceDetails replaces the items, the taxes and the promotions of the order
+func replaceDetails(ctx context.Context, tx *sqlx.Tx, order *domain.Order) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM order_items WHERE order_id = $1`, order.ID); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO order_items (
+			id, order_id, product_id, variant_id, category_id, sku, name, unit, quantity,
+			unit_price, discount_type, discount_value, tax_exempt, subtotal, discount_amount,
+			promotion_discount, total, order_discount, service_charge, tax_base, tax_amount,
+			grand_total, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17,
+			$18, $19, $20, $21, $22, $23
+		)
+	`
+	for _, item := range order.Items {
+		_, err := tx.ExecContext(
+			ctx,
+			query,
+			item.ID,
+			item.OrderID,
+			item.ProductID,
+			item.VariantID,
+			item.CategoryID,
+			item.SKU,
+			item.Name,
+			item.Unit,
+			item.Quantity,
+			item.UnitPrice,
+			item.DiscountType,
+			item.DiscountValue,
+			item.TaxExempt,
+			item.Subtotal,
+			item.DiscountAmount,
+			item.PromotionDiscount,
+			item.Total,
+			item.OrderDiscount,
+			item.ServiceCharge,
+			item.TaxBase,
+			item.TaxAmount,
+			item.GrandTotal,
+			item.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM order_taxes WHERE order_id = $1`, order.ID); err != nil {
+		return err
+	}
+	if err := insertTaxes(ctx, tx, order); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM order_promotions WHERE order_id = $1`, order.ID); err != nil {
+		return err
+	}
+	query = `
+		INSERT INTO order_promotions (
+			order_id, position, promotion_id, item_id, name, amount
+		) VALUES (
+			$1, $2, $3, $4, $5, $6
+		)
+	`
+	for i, p := range order.Promotions {
+		_, err := tx.ExecContext(ctx, query, order.ID, i, p.PromotionID, p.ItemID, p.Name, p.Amount)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertTaxes stores the taxes of the order
+func insertTaxes(ctx context.Context, tx *sqlx.Tx, order *domain.Order) error {
+	query := `
+		INSERT INTO order_taxes (
+			order_id, type, name, rate, inclusive, base, amount
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7
+		)
+	`
+	for _, tax := range order.Taxes {
+		_, err := tx.ExecContext(
+			ctx,
+			query,
+			order.ID,
+			tax.Type,
+			tax.Name,
+			tax.Rate,
+			tax.Inclusive,
+			tax.Base,
+			tax.Amount,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateOrder stores the fields of the order when its stored version still
+// matches, and increments the version
+func updateOrder(ctx context.Context, tx *sqlx.Tx, order *domain.Order) error {
+	query := `
+		UPDATE orders
+		SET
+			status = $4,
+			number = $5,
+			note = $6,
+			discount_type = $7,
+			discount_value = $8,
+			subtotal = $9,
+			discount_total = $10,
+			service_charge_total = $11,
+			tax_total = $12,
+			total = $13,
+			idempotency_key = $14,
+			void_reason = $15,
+			updated_at = $16,
+			completed_at = $17,
+			voided_at = $18,
+			shift_id = $19,
+			voucher_id = $20,
+			voucher_code = $21,
+			promotion_total = $22,
+			customer_id = $23,
+			points_redeemed = $24,
+			points_discount = $25,
+			points_earned = $26,
+			version = version + 1
+		WHERE merchant_id = $1 AND id = $2 AND version = $3
+	`
+
+	result, err := tx.ExecContext(
+		ctx,
+		query,
+		order.MerchantID,
+		order.ID,
+		order.Version,
+		order.Status,
+		order.Number,
+		order.Note,
+		order.DiscountType,
+		order.DiscountValue,
+		order.Subtotal,
+		order.DiscountTotal,
+		order.ServiceChargeTotal,
+		order.TaxTotal,
+		order.Total,
+		order.IdempotencyKey,
+		order.VoidReason,
+		order.UpdatedAt,
+		order.CompletedAt,
+		order.VoidedAt,
+		order.ShiftID,
+		order.VoucherID,
+		order.VoucherCode,
+		order.PromotionTotal,
+		order.CustomerID,
+		order.PointsRedeemed,
+		order.PointsDiscount,
+		order.PointsEarned,
+	)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrOrderChanged
+	}
+
+	order.Version++
+	return nil
+}
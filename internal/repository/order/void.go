@@ -0,0 +1,55 @@
+package order
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/repository/inventory"
+	"github/kijunpos/internal/repository/loyalty"
+	"github/kijunpos/internal/repository/promotion"
+	"github/kijunpos/internal/repository/refund"
+	"github/kijunpos/internal/repository/shift"
+)
+
+// Void stores a voided order, returns its items to stock, settles the points
+// of its customer, gives back the uses of its promotions and its voucher,
+// gives its store credit payments back to their credits and takes the cash
+// given back out of the drawer
+func (r *orderRepository) Void(ctx context.Context, order *domain.Order, movements []*domain.StockMovement, points []*domain.PointEntry, cash *domain.CashMovement) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.order.Void")
+	defer span.End()
+
+	tx, err := r.dbConn.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := updateOrder(ctx, tx, order); err != nil {
+		return err
+	}
+
+	if err := inventory.ApplyMovements(ctx, tx, movements); err != nil {
+		return err
+	}
+
+	if err := loyalty.ApplyEntries(ctx, tx, points); err != nil {
+		return err
+	}
+
+	if err := promotion.Release(ctx, tx, order); err != nil {
+		return err
+	}
+
+	if err := refund.RestoreOrderStoreCredits(ctx, tx, order.ID, order.UpdatedAt.Time); err != nil {
+		return err
+	}
+
+	if cash != nil {
+		if err := shift.RecordCash(ctx, tx, cash.ShiftID, cash); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,23 @@
+package outlet
+
+import (
+	"context"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// AddStaff assigns a user to an outlet, assigning a user twice has no effect
+func (r *outletRepository) AddStaff(ctx context.Context, outletID, userID uuid.UUID) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.outlet.AddStaff")
+	defer span.End()
+
+	query := `
+		INSERT INTO outlet_staff (outlet_id, user_id)
+		VALUES ($1, $2)
+		ON CONFLICT (outlet_id, user_id) DO NOTHING
+	`
+
+	_, err := r.dbConn.DB.ExecContext(ctx, query, outletID, userID)
+	return err
+}
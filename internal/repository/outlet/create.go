@@ -0,0 +1,37 @@
+package outlet
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// Create stores a new outlet
+func (r *outletRepository) Create(ctx context.Context, outlet *domain.Outlet) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.outlet.Create")
+	defer span.End()
+
+	query := `
+		INSERT INTO outlets (
+			id, merchant_id, name, address, phone_number, is_active, allow_negative_stock, timezone, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9
+		)
+	`
+
+	_, err := r.dbConn.DB.ExecContext(
+		ctx,
+		query,
+		outlet.ID,
+		outlet.MerchantID,
+		outlet.Name,
+		outlet.Address,
+		outlet.PhoneNumber,
+		outlet.IsActive,
+		outlet.AllowNegativeStock,
+		outlet.Timezone,
+		outlet.CreatedAt,
+	)
+
+	return err
+}
@@ -0,0 +1,33 @@
+package outlet
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetByID retrieves an outlet of a merchant by ID
+func (r *outletRepository) GetByID(ctx context.Context, merchantID, id uuid.UUID) (*domain.Outlet, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.outlet.GetByID")
+	defer span.End()
+
+	query := `
+		SELECT ` + outletColumns + `
+		FROM outlets
+		WHERE merchant_id = $1 AND id = $2
+	`
+
+	var outlet domain.Outlet
+	err := r.dbConn.DB.GetContext(ctx, &outlet, query, merchantID, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &outlet, nil
+}
@@ -0,0 +1,26 @@
+package outlet
+
+import (
+	"context"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// IsStaff reports whether a user is assigned to an outlet
+func (r *outletRepository) IsStaff(ctx context.Context, outletID, userID uuid.UUID) (bool, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.outlet.IsStaff")
+	defer span.End()
+
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM outlet_staff
+			WHERE outlet_id = $1 AND user_id = $2
+		)
+	`
+
+	var isStaff bool
+	err := r.dbConn.DB.GetContext(ctx, &isStaff, query, outletID, userID)
+	return isStaff, err
+}
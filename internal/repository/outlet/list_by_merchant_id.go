@@ -0,0 +1,29 @@
+package outlet
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// ListByMerchantID retrieves the outlets of a merchant
+func (r *outletRepository) ListByMerchantID(ctx context.Context, merchantID uuid.UUID) ([]*domain.Outlet, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.outlet.ListByMerchantID")
+	defer span.End()
+
+	query := `
+		SELECT ` + outletColumns + `
+		FROM outlets
+		WHERE merchant_id = $1
+		ORDER BY name
+	`
+
+	outlets := []*domain.Outlet{}
+	if err := r.dbConn.DB.SelectContext(ctx, &outlets, query, merchantID); err != nil {
+		return nil, err
+	}
+
+	return outlets, nil
+}
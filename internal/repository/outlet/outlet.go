@@ -0,0 +1,21 @@
+package outlet
+
+import (
+	"github/kijunpos/config/db"
+	"github/kijunpos/internal/domain"
+)
+
+// outletColumns is the column list selected into domain.Outlet
+const outletColumns = `
+		id, merchant_id, name, address, phone_number, is_active, allow_negative_stock, timezone, created_at, updated_at`
+
+type outletRepository struct {
+	dbConn *db.Connection
+}
+
+// NewOutletRepository creates a new outlet repository
+func NewOutletRepository(dbConn *db.Connection) domain.OutletRepository {
+	return &outletRepository{
+		dbConn: dbConn,
+	}
+}
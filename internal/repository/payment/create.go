@@ -0,0 +1,51 @@
+package payment
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Create stores a new payment
+func (r *paymentRepository) Create(ctx context.Context, payment *domain.Payment) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.payment.Create")
+	defer span.End()
+
+	return Insert(ctx, r.dbConn.DB, payment)
+}
+
+// Insert stores a new payment with db, which lets other repositories store
+// payments within their own transactions
+func Insert(ctx context.Context, db sqlx.ExecerContext, payment *domain.Payment) error {
+	query := `
+		INSERT INTO payments (
+			id, order_id, merchant_id, method, status, amount, tendered, change_amount,
+			reference, provider, provider_reference, qr_string, expires_at, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+		)
+	`
+
+	_, err := db.ExecContext(
+		ctx,
+		query,
+		payment.ID,
+		payment.OrderID,
+		payment.MerchantID,
+		payment.Method,
+		payment.Status,
+		payment.Amount,
+		payment.Tendered,
+		payment.Change,
+		payment.Reference,
+		payment.Provider,
+		payment.ProviderReference,
+		payment.QRString,
+		payment.ExpiresAt,
+		payment.CreatedAt,
+	)
+
+	return err
+}
@@ -0,0 +1,33 @@
+package payment
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetByID retrieves a payment of a merchant by ID
+func (r *paymentRepository) GetByID(ctx context.Context, merchantID, id uuid.UUID) (*domain.Payment, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.payment.GetByID")
+	defer span.End()
+
+	query := `
+		SELECT ` + Columns + `
+		FROM payments
+		WHERE merchant_id = $1 AND id = $2
+	`
+
+	var payment domain.Payment
+	err := r.dbConn.DB.GetContext(ctx, &payment, query, merchantID, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &payment, nil
+}
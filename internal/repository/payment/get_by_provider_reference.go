@@ -0,0 +1,31 @@
+package payment
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// GetByProviderReference retrieves a payment by its ID at the gateway
+func (r *paymentRepository) GetByProviderReference(ctx context.Context, provider, reference string) (*domain.Payment, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.payment.GetByProviderReference")
+	defer span.End()
+
+	query := `
+		SELECT ` + Columns + `
+		FROM payments
+		WHERE provider = $1 AND provider_reference = $2
+	`
+
+	var payment domain.Payment
+	err := r.dbConn.DB.GetContext(ctx, &payment, query, provider, reference)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &payment, nil
+}
@@ -0,0 +1,23 @@
+package payment
+
+import (
+	"github/kijunpos/config/db"
+	"github/kijunpos/internal/domain"
+)
+
+// Columns is the column list selected into domain.Payment
+const Columns = `
+		id, order_id, merchant_id, method, status, amount, refunded_amount, tendered, change_amount,
+		reference, provider, provider_reference, qr_string, expires_at, created_at,
+		updated_at`
+
+type paymentRepository struct {
+	dbConn *db.Connection
+}
+
+// NewPaymentRepository creates a new payment repository
+func NewPaymentRepository(dbConn *db.Connection) domain.PaymentRepository {
+	return &paymentRepository{
+		dbConn: dbConn,
+	}
+}
@@ -0,0 +1,46 @@
+package payment
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// UpdateStatus stores the status of a payment that still has the status from
+func (r *paymentRepository) UpdateStatus(ctx context.Context, payment *domain.Payment, from domain.PaymentStatus) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.payment.UpdateStatus")
+	defer span.End()
+
+	tx, err := r.dbConn.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Checkout updates the order first, so it waits for this change or the
+	// change waits for the checkout
+	if _, err := tx.ExecContext(ctx, `SELECT 1 FROM orders WHERE id = $1 FOR SHARE`, payment.OrderID); err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE payments
+		SET status = $4, updated_at = $5
+		WHERE merchant_id = $1 AND id = $2 AND status = $3
+	`
+
+	result, err := tx.ExecContext(ctx, query, payment.MerchantID, payment.ID, from, payment.Status, payment.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrPaymentChanged
+	}
+
+	return tx.Commit()
+}
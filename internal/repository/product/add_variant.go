@@ -0,0 +1,15 @@
+package product
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// AddVariant stores a new variant of an existing product
+func (r *productRepository) AddVariant(ctx context.Context, variant *domain.ProductVariant) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.product.AddVariant")
+	defer span.End()
+
+	return insertVariant(ctx, r.dbConn.DB, variant)
+}
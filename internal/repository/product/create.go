@@ -0,0 +1,84 @@
+package product
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Create stores a new product and its variants in one transaction
+func (r *productRepository) Create(ctx context.Context, product *domain.Product) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.product.Create")
+	defer span.End()
+
+	tx, err := r.dbConn.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO products (
+			id, merchant_id, category_id, name, description, unit, base_price, is_active, tax_exempt,
+			created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+		)
+	`
+
+	_, err = tx.ExecContext(
+		ctx,
+		query,
+		product.ID,
+		product.MerchantID,
+		product.CategoryID,
+		product.Name,
+		product.Description,
+		product.Unit,
+		product.BasePrice,
+		product.IsActive,
+		product.TaxExempt,
+		product.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, variant := range product.Variants {
+		if err := insertVariant(ctx, tx, variant); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// insertVariant stores a new variant
+func insertVariant(ctx context.Context, execer sqlx.ExecerContext, variant *domain.ProductVariant) error {
+	query := `
+		INSERT INTO product_variants (
+			id, product_id, merchant_id, sku, barcode, name, attributes, price, is_active, created_at
+		) VALUES (
+			$1, $2, $3, $4, NULLIF($5, ''), $6, $7, $8, $9, $10
+		)
+	`
+
+	_, err := execer.ExecContext(
+		ctx,
+		query,
+		variant.ID,
+		variant.ProductID,
+		variant.MerchantID,
+		variant.SKU,
+		variant.Barcode,
+		variant.Name,
+		variant.Attributes,
+		variant.Price,
+		variant.IsActive,
+		variant.CreatedAt,
+	)
+
+	return err
+}
@@ -0,0 +1,29 @@
+package product
+
+import (
+	"context"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// Delete soft deletes a product and its variants, sold items keep referring to them
+func (r *productRepository) Delete(ctx context.Context, merchantID, id uuid.UUID) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.product.Delete")
+	defer span.End()
+
+	query := `
+		WITH product AS (
+			UPDATE products
+			SET deleted_at = NOW()
+			WHERE merchant_id = $1 AND id = $2 AND deleted_at IS NULL
+			RETURNING id
+		)
+		UPDATE product_variants
+		SET deleted_at = NOW()
+		WHERE product_id IN (SELECT id FROM product) AND deleted_at IS NULL
+	`
+
+	_, err := r.dbConn.DB.ExecContext(ctx, query, merchantID, id)
+	return err
+}
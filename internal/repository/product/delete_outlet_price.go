@@ -0,0 +1,22 @@
+package product
+
+import (
+	"context"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// DeleteOutletPrice removes the price of a variant at an outlet
+func (r *productRepository) DeleteOutletPrice(ctx context.Context, variantID, outletID uuid.UUID) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.product.DeleteOutletPrice")
+	defer span.End()
+
+	query := `
+		DELETE FROM outlet_prices
+		WHERE variant_id = $1 AND outlet_id = $2
+	`
+
+	_, err := r.dbConn.DB.ExecContext(ctx, query, variantID, outletID)
+	return err
+}
@@ -0,0 +1,23 @@
+package product
+
+import (
+	"context"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// DeleteVariant soft deletes a variant
+func (r *productRepository) DeleteVariant(ctx context.Context, merchantID, id uuid.UUID) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.product.DeleteVariant")
+	defer span.End()
+
+	query := `
+		UPDATE product_variants
+		SET deleted_at = NOW()
+		WHERE merchant_id = $1 AND id = $2 AND deleted_at IS NULL
+	`
+
+	_, err := r.dbConn.DB.ExecContext(ctx, query, merchantID, id)
+	return err
+}
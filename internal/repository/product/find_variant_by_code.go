@@ -0,0 +1,35 @@
+package product
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// FindVariantByCode retrieves a variant of a merchant using the SKU or the barcode
+func (r *productRepository) FindVariantByCode(ctx context.Context, merchantID uuid.UUID, sku, barcode string) (*domain.ProductVariant, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.product.FindVariantByCode")
+	defer span.End()
+
+	query := `
+		SELECT ` + variantColumns + `
+		FROM product_variants v
+		WHERE v.merchant_id = $1 AND v.deleted_at IS NULL
+			AND (v.sku = $2 OR v.barcode = NULLIF($3, ''))
+		LIMIT 1
+	`
+
+	var variant domain.ProductVariant
+	err := r.dbConn.DB.GetContext(ctx, &variant, query, merchantID, sku, barcode)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &variant, nil
+}
@@ -0,0 +1,54 @@
+package product
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetByBarcode retrieves the product of a merchant carrying the barcode, with
+// the scanned variant as its only variant
+func (r *productRepository) GetByBarcode(ctx context.Context, merchantID uuid.UUID, barcode string) (*domain.Product, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.product.GetByBarcode")
+	defer span.End()
+
+	query := `
+		SELECT ` + variantColumns + `
+		FROM product_variants v
+		WHERE v.merchant_id = $1 AND v.barcode = $2 AND v.deleted_at IS NULL
+	`
+
+	var variant domain.ProductVariant
+	err := r.dbConn.DB.GetContext(ctx, &variant, query, merchantID, barcode)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	productQuery := `
+		SELECT ` + productColumns + `
+		FROM products p
+		WHERE p.merchant_id = $1 AND p.id = $2 AND p.deleted_at IS NULL
+	`
+
+	var product domain.Product
+	err = r.dbConn.DB.GetContext(ctx, &product, productQuery, merchantID, variant.ProductID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := r.loadOutletPrices(ctx, []*domain.ProductVariant{&variant}); err != nil {
+		return nil, err
+	}
+	product.Variants = []*domain.ProductVariant{&variant}
+
+	return &product, nil
+}
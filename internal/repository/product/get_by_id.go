@@ -0,0 +1,37 @@
+package product
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetByID retrieves a product of a merchant by ID together with its variants
+func (r *productRepository) GetByID(ctx context.Context, merchantID, id uuid.UUID) (*domain.Product, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.product.GetByID")
+	defer span.End()
+
+	query := `
+		SELECT ` + productColumns + `
+		FROM products p
+		WHERE p.merchant_id = $1 AND p.id = $2 AND p.deleted_at IS NULL
+	`
+
+	var product domain.Product
+	err := r.dbConn.DB.GetContext(ctx, &product, query, merchantID, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := r.loadVariants(ctx, []*domain.Product{&product}); err != nil {
+		return nil, err
+	}
+
+	return &product, nil
+}
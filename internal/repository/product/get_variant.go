@@ -0,0 +1,37 @@
+package product
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetVariant retrieves a variant of a merchant by ID together with its outlet prices
+func (r *productRepository) GetVariant(ctx context.Context, merchantID, id uuid.UUID) (*domain.ProductVariant, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.product.GetVariant")
+	defer span.End()
+
+	query := `
+		SELECT ` + variantColumns + `
+		FROM product_variants v
+		WHERE v.merchant_id = $1 AND v.id = $2 AND v.deleted_at IS NULL
+	`
+
+	var variant domain.ProductVariant
+	err := r.dbConn.DB.GetContext(ctx, &variant, query, merchantID, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := r.loadOutletPrices(ctx, []*domain.ProductVariant{&variant}); err != nil {
+		return nil, err
+	}
+
+	return &variant, nil
+}
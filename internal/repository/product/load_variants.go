@@ -0,0 +1,87 @@
+package product
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// loadVariants attaches the variants that are not deleted, and their outlet
+// prices, to the products
+func (r *productRepository) loadVariants(ctx context.Context, products []*domain.Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*domain.Product, len(products))
+	ids := make([]uuid.UUID, 0, len(products))
+	for _, product := range products {
+		product.Variants = []*domain.ProductVariant{}
+		byID[product.ID] = product
+		ids = append(ids, product.ID)
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT `+variantColumns+`
+		FROM product_variants v
+		WHERE v.product_id IN (?) AND v.deleted_at IS NULL
+		ORDER BY v.created_at, v.id
+	`, ids)
+	if err != nil {
+		return err
+	}
+
+	variants := []*domain.ProductVariant{}
+	if err := r.dbConn.DB.SelectContext(ctx, &variants, r.dbConn.DB.Rebind(query), args...); err != nil {
+		return err
+	}
+
+	if err := r.loadOutletPrices(ctx, variants); err != nil {
+		return err
+	}
+
+	for _, variant := range variants {
+		if product, ok := byID[variant.ProductID]; ok {
+			product.Variants = append(product.Variants, variant)
+		}
+	}
+	return nil
+}
+
+// loadOutletPrices attaches the outlet prices to the variants
+func (r *productRepository) loadOutletPrices(ctx context.Context, variants []*domain.ProductVariant) error {
+	if len(variants) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*domain.ProductVariant, len(variants))
+	ids := make([]uuid.UUID, 0, len(variants))
+	for _, variant := range variants {
+		variant.OutletPrices = []*domain.OutletPrice{}
+		byID[variant.ID] = variant
+		ids = append(ids, variant.ID)
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT variant_id, outlet_id, price
+		FROM outlet_prices
+		WHERE variant_id IN (?)
+	`, ids)
+	if err != nil {
+		return err
+	}
+
+	prices := []*domain.OutletPrice{}
+	if err := r.dbConn.DB.SelectContext(ctx, &prices, r.dbConn.DB.Rebind(query), args...); err != nil {
+		return err
+	}
+
+	for _, price := range prices {
+		if variant, ok := byID[price.VariantID]; ok {
+			variant.OutletPrices = append(variant.OutletPrices, price)
+		}
+	}
+	return nil
+}
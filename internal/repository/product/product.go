@@ -0,0 +1,27 @@
+package product
+
+import (
+	"github/kijunpos/config/db"
+	"github/kijunpos/internal/domain"
+)
+
+// productColumns is the column list selected into domain.Product
+const productColumns = `
+		p.id, p.merchant_id, p.category_id, p.name, p.description, p.unit, p.base_price,
+		p.is_active, p.tax_exempt, p.created_at, p.updated_at, p.deleted_at`
+
+// variantColumns is the column list selected into domain.ProductVariant
+const variantColumns = `
+		v.id, v.product_id, v.merchant_id, v.sku, COALESCE(v.barcode, '') AS barcode, v.name,
+		v.attributes, v.price, v.is_active, v.created_at, v.updated_at, v.deleted_at`
+
+type productRepository struct {
+	dbConn *db.Connection
+}
+
+// NewProductRepository creates a new product repository
+func NewProductRepository(dbConn *db.Connection) domain.ProductRepository {
+	return &productRepository{
+		dbConn: dbConn,
+	}
+}
@@ -0,0 +1,70 @@
+package product
+
+import (
+	"context"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// likeEscaper escapes the LIKE wildcards of a search query
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// Search retrieves the products of a merchant matching the filter, newest
+// first, starting after the cursor
+func (r *productRepository) Search(ctx context.Context, merchantID uuid.UUID, filter domain.ProductFilter, after *domain.PageCursor, limit int) ([]*domain.Product, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.product.Search")
+	defer span.End()
+
+	var conditions []string
+	var args []interface{}
+	addCondition := func(condition string, values ...interface{}) {
+		placeholders := make([]interface{}, len(values))
+		for i, value := range values {
+			args = append(args, value)
+			placeholders[i] = fmt.Sprintf("$%d", len(args))
+		}
+		conditions = append(conditions, fmt.Sprintf(condition, placeholders...))
+	}
+
+	addCondition("p.merchant_id = %s", merchantID)
+	addCondition("p.deleted_at IS NULL")
+	if !filter.IncludeInactive {
+		addCondition("p.is_active")
+	}
+	if filter.CategoryID != uuid.Nil {
+		addCondition("p.category_id = %s", filter.CategoryID)
+	}
+	if filter.Query != "" {
+		pattern := "%" + likeEscaper.Replace(filter.Query) + "%"
+		addCondition(`(p.name ILIKE %[1]s OR EXISTS (
+			SELECT 1 FROM product_variants v
+			WHERE v.product_id = p.id AND v.deleted_at IS NULL AND (v.sku ILIKE %[1]s OR v.barcode = %[2]s)
+		))`, pattern, filter.Query)
+	}
+	if after != nil {
+		addCondition("(p.created_at, p.id) < (%s, %s)", after.CreatedAt, after.ID)
+	}
+
+	args = append(args, limit)
+	query := `
+		SELECT ` + productColumns + `
+		FROM products p
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY p.created_at DESC, p.id DESC
+		LIMIT ` + fmt.Sprintf("$%d", len(args))
+
+	products := []*domain.Product{}
+	if err := r.dbConn.DB.SelectContext(ctx, &products, query, args...); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadVariants(ctx, products); err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
@@ -0,0 +1,23 @@
+package product
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// SetOutletPrice stores or replaces the price of a variant at an outlet
+func (r *productRepository) SetOutletPrice(ctx context.Context, price *domain.OutletPrice) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.product.SetOutletPrice")
+	defer span.End()
+
+	query := `
+		INSERT INTO outlet_prices (variant_id, outlet_id, price, updated_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (variant_id, outlet_id) DO UPDATE
+		SET price = EXCLUDED.price, updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.dbConn.DB.ExecContext(ctx, query, price.VariantID, price.OutletID, price.Price)
+	return err
+}
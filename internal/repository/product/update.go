@@ -0,0 +1,44 @@
+package product
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// Update updates the fields of a product, its variants are left unchanged
+func (r *productRepository) Update(ctx context.Context, product *domain.Product) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.product.Update")
+	defer span.End()
+
+	query := `
+		UPDATE products
+		SET
+			category_id = $3,
+			name = $4,
+			description = $5,
+			unit = $6,
+			base_price = $7,
+			is_active = $8,
+			tax_exempt = $9,
+			updated_at = $10
+		WHERE merchant_id = $1 AND id = $2 AND deleted_at IS NULL
+	`
+
+	_, err := r.dbConn.DB.ExecContext(
+		ctx,
+		query,
+		product.MerchantID,
+		product.ID,
+		product.CategoryID,
+		product.Name,
+		product.Description,
+		product.Unit,
+		product.BasePrice,
+		product.IsActive,
+		product.TaxExempt,
+		product.UpdatedAt,
+	)
+
+	return err
+}
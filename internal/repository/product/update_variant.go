@@ -0,0 +1,42 @@
+package product
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// UpdateVariant updates a variant
+func (r *productRepository) UpdateVariant(ctx context.Context, variant *domain.ProductVariant) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.product.UpdateVariant")
+	defer span.End()
+
+	query := `
+		UPDATE product_variants
+		SET
+			sku = $3,
+			barcode = NULLIF($4, ''),
+			name = $5,
+			attributes = $6,
+			price = $7,
+			is_active = $8,
+			updated_at = $9
+		WHERE merchant_id = $1 AND id = $2 AND deleted_at IS NULL
+	`
+
+	_, err := r.dbConn.DB.ExecContext(
+		ctx,
+		query,
+		variant.MerchantID,
+		variant.ID,
+		variant.SKU,
+		variant.Barcode,
+		variant.Name,
+		variant.Attributes,
+		variant.Price,
+		variant.IsActive,
+		variant.UpdatedAt,
+	)
+
+	return err
+}
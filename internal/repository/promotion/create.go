@@ -0,0 +1,55 @@
+package promotion
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// Create stores a new promotion
+func (r *promotionRepository) Create(ctx context.Context, promotion *domain.Promotion) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.promotion.Create")
+	defer span.End()
+
+	query := `
+		INSERT INTO promotions (
+			id, merchant_id, outlet_id, name, type, category_id, product_id, discount_type,
+			discount_value, buy_quantity, get_quantity, min_spend, starts_at, ends_at, weekdays,
+			daily_from, daily_until, priority, stackable, requires_voucher, usage_limit,
+			is_active, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17,
+			$18, $19, $20, $21, $22, $23
+		)
+	`
+
+	_, err := r.dbConn.DB.ExecContext(
+		ctx,
+		query,
+		promotion.ID,
+		promotion.MerchantID,
+		promotion.OutletID,
+		promotion.Name,
+		promotion.Type,
+		promotion.CategoryID,
+		promotion.ProductID,
+		promotion.DiscountType,
+		promotion.DiscountValue,
+		promotion.BuyQuantity,
+		promotion.GetQuantity,
+		promotion.MinSpend,
+		promotion.StartsAt,
+		promotion.EndsAt,
+		promotion.Weekdays,
+		promotion.DailyFrom,
+		promotion.DailyUntil,
+		promotion.Priority,
+		promotion.Stackable,
+		promotion.RequiresVoucher,
+		promotion.UsageLimit,
+		promotion.IsActive,
+		promotion.CreatedAt,
+	)
+
+	return err
+}
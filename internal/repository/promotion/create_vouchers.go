@@ -0,0 +1,47 @@
+package promotion
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/repository/pgerror"
+)
+
+// CreateVouchers stores new vouchers in one transaction
+func (r *promotionRepository) CreateVouchers(ctx context.Context, vouchers []*domain.Voucher) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.promotion.CreateVouchers")
+	defer span.End()
+
+	tx, err := r.dbConn.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO vouchers (
+			id, merchant_id, promotion_id, code, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5
+		)
+	`
+	for _, voucher := range vouchers {
+		_, err := tx.ExecContext(
+			ctx,
+			query,
+			voucher.ID,
+			voucher.MerchantID,
+			voucher.PromotionID,
+			voucher.Code,
+			voucher.CreatedAt,
+		)
+		if err != nil {
+			if pgerror.IsUniqueViolation(err, "") {
+				return domain.ErrVoucherCodeExists
+			}
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,33 @@
+package promotion
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetByID retrieves a promotion of a merchant by ID
+func (r *promotionRepository) GetByID(ctx context.Context, merchantID, id uuid.UUID) (*domain.Promotion, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.promotion.GetByID")
+	defer span.End()
+
+	query := `
+		SELECT ` + promotionColumns + `
+		FROM promotions
+		WHERE merchant_id = $1 AND id = $2
+	`
+
+	var promotion domain.Promotion
+	err := r.dbConn.DB.GetContext(ctx, &promotion, query, merchantID, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &promotion, nil
+}
@@ -0,0 +1,33 @@
+package promotion
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetVoucher retrieves a voucher of a merchant by ID
+func (r *promotionRepository) GetVoucher(ctx context.Context, merchantID, id uuid.UUID) (*domain.Voucher, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.promotion.GetVoucher")
+	defer span.End()
+
+	query := `
+		SELECT ` + voucherColumns + `
+		FROM vouchers
+		WHERE merchant_id = $1 AND id = $2
+	`
+
+	var voucher domain.Voucher
+	err := r.dbConn.DB.GetContext(ctx, &voucher, query, merchantID, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &voucher, nil
+}
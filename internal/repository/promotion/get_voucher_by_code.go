@@ -0,0 +1,33 @@
+package promotion
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetVoucherByCode retrieves a voucher of a merchant by its code
+func (r *promotionRepository) GetVoucherByCode(ctx context.Context, merchantID uuid.UUID, code string) (*domain.Voucher, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.promotion.GetVoucherByCode")
+	defer span.End()
+
+	query := `
+		SELECT ` + voucherColumns + `
+		FROM vouchers
+		WHERE merchant_id = $1 AND code = $2
+	`
+
+	var voucher domain.Voucher
+	err := r.dbConn.DB.GetContext(ctx, &voucher, query, merchantID, code)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &voucher, nil
+}
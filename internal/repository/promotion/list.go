@@ -0,0 +1,29 @@
+package promotion
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// List retrieves the promotions of a merchant, newest first
+func (r *promotionRepository) List(ctx context.Context, merchantID uuid.UUID) ([]*domain.Promotion, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.promotion.List")
+	defer span.End()
+
+	query := `
+		SELECT ` + promotionColumns + `
+		FROM promotions
+		WHERE merchant_id = $1
+		ORDER BY created_at DESC, id DESC
+	`
+
+	promotions := []*domain.Promotion{}
+	if err := r.dbConn.DB.SelectContext(ctx, &promotions, query, merchantID); err != nil {
+		return nil, err
+	}
+
+	return promotions, nil
+}
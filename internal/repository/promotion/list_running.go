@@ -0,0 +1,30 @@
+package promotion
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ListRunning retrieves the active promotions of a merchant running at a time
+func (r *promotionRepository) ListRunning(ctx context.Context, merchantID uuid.UUID, at time.Time) ([]*domain.Promotion, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.promotion.ListRunning")
+	defer span.End()
+
+	query := `
+		SELECT ` + promotionColumns + `
+		FROM promotions
+		WHERE merchant_id = $1 AND is_active AND starts_at <= $2 AND (ends_at IS NULL OR ends_at > $2)
+		ORDER BY priority DESC, created_at, id
+	`
+
+	promotions := []*domain.Promotion{}
+	if err := r.dbConn.DB.SelectContext(ctx, &promotions, query, merchantID, at); err != nil {
+		return nil, err
+	}
+
+	return promotions, nil
+}
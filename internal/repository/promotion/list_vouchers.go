@@ -0,0 +1,40 @@
+package promotion
+
+import (
+	"context"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ListVouchers retrieves the vouchers of a promotion, newest first, starting
+// after the cursor
+func (r *promotionRepository) ListVouchers(ctx context.Context, merchantID, promotionID uuid.UUID, after *domain.PageCursor, limit int) ([]*domain.Voucher, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.promotion.ListVouchers")
+	defer span.End()
+
+	conditions := []string{"merchant_id = $1", "promotion_id = $2"}
+	args := []interface{}{merchantID, promotionID}
+	if after != nil {
+		args = append(args, after.CreatedAt, after.ID)
+		conditions = append(conditions, fmt.Sprintf("(created_at, id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	args = append(args, limit)
+	query := `
+		SELECT ` + voucherColumns + `
+		FROM vouchers
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT ` + fmt.Sprintf("$%d", len(args))
+
+	vouchers := []*domain.Voucher{}
+	if err := r.dbConn.DB.SelectContext(ctx, &vouchers, query, args...); err != nil {
+		return nil, err
+	}
+
+	return vouchers, nil
+}
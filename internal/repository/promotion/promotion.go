@@ -0,0 +1,28 @@
+package promotion
+
+import (
+	"github/kijunpos/config/db"
+	"github/kijunpos/internal/domain"
+)
+
+// promotionColumns is the column list selected into domain.Promotion
+const promotionColumns = `
+		id, merchant_id, outlet_id, name, type, category_id, product_id, discount_type,
+		discount_value, buy_quantity, get_quantity, min_spend, starts_at, ends_at, weekdays,
+		daily_from, daily_until, priority, stackable, requires_voucher, usage_limit,
+		usage_count, is_active, created_at, updated_at`
+
+// voucherColumns is the column list selected into domain.Voucher
+const voucherColumns = `
+		id, merchant_id, promotion_id, code, order_id, redeemed_at, created_at`
+
+type promotionRepository struct {
+	dbConn *db.Connection
+}
+
+// NewPromotionRepository creates a new promotion repository
+func NewPromotionRepository(dbConn *db.Connection) domain.PromotionRepository {
+	return &promotionRepository{
+		dbConn: dbConn,
+	}
+}
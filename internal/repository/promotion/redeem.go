@@ -0,0 +1,67 @@
+package promotion
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// Redeem counts within tx one use of every promotion applied to a sale and
+// marks its voucher redeemed by it, which lets the order repository redeem
+// them at checkout. The conditional updates lock the rows until tx ends, so
+// of concurrent checkouts only one redeems a voucher or the last use of a
+// promotion.
+func Redeem(ctx context.Context, tx *sqlx.Tx, order *domain.Order) error {
+	// Promotions are locked in the order of their IDs so concurrent
+	// checkouts cannot deadlock
+	counted := make(map[uuid.UUID]bool, len(order.Promotions))
+	var ids []uuid.UUID
+	for _, p := range order.Promotions {
+		if !counted[p.PromotionID] {
+			counted[p.PromotionID] = true
+			ids = append(ids, p.PromotionID)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+
+	for _, id := range ids {
+		result, err := tx.ExecContext(ctx, `
+			UPDATE promotions
+			SET usage_count = usage_count + 1
+			WHERE id = $1 AND (usage_limit IS NULL OR usage_count < usage_limit)
+		`, id)
+		if err != nil {
+			return err
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return domain.ErrPromotionExhausted
+		}
+	}
+
+	if !order.VoucherID.Valid {
+		return nil
+	}
+	result, err := tx.ExecContext(ctx, `
+		UPDATE vouchers
+		SET order_id = $2, redeemed_at = $3
+		WHERE id = $1 AND redeemed_at IS NULL
+	`, order.VoucherID.UUID, order.ID, order.CompletedAt.Time)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrVoucherRedeemed
+	}
+	return nil
+}
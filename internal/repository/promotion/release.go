@@ -0,0 +1,47 @@
+package promotion
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// Release undoes Redeem within tx for a voided sale, which lets the order
+// repository give back the uses of its promotions and its voucher when the
+// sale is voided. Orders voided before checkout redeemed nothing.
+func Release(ctx context.Context, tx *sqlx.Tx, order *domain.Order) error {
+	if !order.CompletedAt.Valid {
+		return nil
+	}
+
+	// Promotions are locked in the order of their IDs like in Redeem
+	released := make(map[uuid.UUID]bool, len(order.Promotions))
+	var ids []uuid.UUID
+	for _, p := range order.Promotions {
+		if !released[p.PromotionID] {
+			released[p.PromotionID] = true
+			ids = append(ids, p.PromotionID)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+
+	for _, id := range ids {
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE promotions
+			SET usage_count = usage_count - 1
+			WHERE id = $1 AND usage_count > 0
+		`, id); err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.ExecContext(ctx, `
+		UPDATE vouchers
+		SET order_id = NULL, redeemed_at = NULL
+		WHERE order_id = $1
+	`, order.ID)
+	return err
+}
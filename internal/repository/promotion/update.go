@@ -0,0 +1,68 @@
+package promotion
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// Update updates a promotion, its type and usage count are left unchanged
+func (r *promotionRepository) Update(ctx context.Context, promotion *domain.Promotion) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.promotion.Update")
+	defer span.End()
+
+	query := `
+		UPDATE promotions
+		SET
+			outlet_id = $3,
+			name = $4,
+			category_id = $5,
+			product_id = $6,
+			discount_type = $7,
+			discount_value = $8,
+			buy_quantity = $9,
+			get_quantity = $10,
+			min_spend = $11,
+			starts_at = $12,
+			ends_at = $13,
+			weekdays = $14,
+			daily_from = $15,
+			daily_until = $16,
+			priority = $17,
+			stackable = $18,
+			requires_voucher = $19,
+			usage_limit = $20,
+			is_active = $21,
+			updated_at = $22
+		WHERE merchant_id = $1 AND id = $2
+	`
+
+	_, err := r.dbConn.DB.ExecContext(
+		ctx,
+		query,
+		promotion.MerchantID,
+		promotion.ID,
+		promotion.OutletID,
+		promotion.Name,
+		promotion.CategoryID,
+		promotion.ProductID,
+		promotion.DiscountType,
+		promotion.DiscountValue,
+		promotion.BuyQuantity,
+		promotion.GetQuantity,
+		promotion.MinSpend,
+		promotion.StartsAt,
+		promotion.EndsAt,
+		promotion.Weekdays,
+		promotion.DailyFrom,
+		promotion.DailyUntil,
+		promotion.Priority,
+		promotion.Stackable,
+		promotion.RequiresVoucher,
+		promotion.UsageLimit,
+		promotion.IsActive,
+		promotion.UpdatedAt,
+	)
+
+	return err
+}
@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"context"
+	"github/kijunpos/internal/pkg/apm"
+	"time"
+)
+
+// Hit counts a hit for key and returns the number of hits in the current window.
+// An expired window is restarted by the same upsert, so concurrent hits are
+// always counted.
+func (r *rateLimitRepository) Hit(ctx context.Context, key string, window time.Duration) (int, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.ratelimit.postgres.Hit")
+	defer span.End()
+
+	query := `
+		INSERT INTO rate_limits (key, hits, expires_at)
+		VALUES ($1, 1, $2)
+		ON CONFLICT (key) DO UPDATE
+		SET 
+			hits = CASE WHEN rate_limits.expires_at <= $3 THEN 1 ELSE rate_limits.hits + 1 END,
+			expires_at = CASE WHEN rate_limits.expires_at <= $3 THEN EXCLUDED.expires_at ELSE rate_limits.expires_at END
+		RETURNING hits
+	`
+
+	now := time.Now()
+	var hits int
+	err := r.dbConn.DB.QueryRowContext(ctx, query, key, now.Add(window), now).Scan(&hits)
+	if err != nil {
+		return 0, err
+	}
+
+	return hits, nil
+}
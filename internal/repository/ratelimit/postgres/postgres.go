@@ -0,0 +1,17 @@
+package postgres
+
+import (
+	"github/kijunpos/config/db"
+	"github/kijunpos/internal/domain"
+)
+
+type rateLimitRepository struct {
+	dbConn *db.Connection
+}
+
+// NewRateLimitRepository creates a new PostgreSQL backed rate limit repository
+func NewRateLimitRepository(dbConn *db.Connection) domain.RateLimitRepository {
+	return &rateLimitRepository{
+		dbConn: dbConn,
+	}
+}
@@ -0,0 +1,29 @@
+package redis
+
+import (
+	"context"
+	"github/kijunpos/internal/pkg/apm"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// Hit counts a hit for key and returns the number of hits in the current window.
+// The window starts with the first hit, later hits do not extend it.
+func (r *rateLimitRepository) Hit(ctx context.Context, key string, window time.Duration) (int, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.ratelimit.redis.Hit")
+	defer span.End()
+
+	counter := counterKey(key)
+	var hits *goredis.IntCmd
+	_, err := r.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		hits = pipe.Incr(ctx, counter)
+		pipe.ExpireNX(ctx, counter, window)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(hits.Val()), nil
+}
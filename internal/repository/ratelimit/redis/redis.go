@@ -0,0 +1,24 @@
+package redis
+
+import (
+	"fmt"
+	"github/kijunpos/internal/domain"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+type rateLimitRepository struct {
+	client *goredis.Client
+}
+
+// NewRateLimitRepository creates a new Redis backed rate limit repository
+func NewRateLimitRepository(client *goredis.Client) domain.RateLimitRepository {
+	return &rateLimitRepository{
+		client: client,
+	}
+}
+
+// counterKey returns the key of the counter for a rate limit key
+func counterKey(key string) string {
+	return fmt.Sprintf("ratelimit:%s", key)
+}
@@ -0,0 +1,33 @@
+package receipt
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetSettings retrieves the receipt settings of an outlet of a merchant
+func (r *receiptRepository) GetSettings(ctx context.Context, merchantID, outletID uuid.UUID) (*domain.ReceiptSettings, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.receipt.GetSettings")
+	defer span.End()
+
+	query := `
+		SELECT merchant_id, outlet_id, header, footer, tax_id, logo, updated_at
+		FROM receipt_settings
+		WHERE merchant_id = $1 AND outlet_id = $2
+	`
+
+	var settings domain.ReceiptSettings
+	err := r.dbConn.DB.GetContext(ctx, &settings, query, merchantID, outletID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &settings, nil
+}
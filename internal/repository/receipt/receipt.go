@@ -0,0 +1,17 @@
+package receipt
+
+import (
+	"github/kijunpos/config/db"
+	"github/kijunpos/internal/domain"
+)
+
+type receiptRepository struct {
+	dbConn *db.Connection
+}
+
+// NewReceiptRepository creates a new receipt settings repository
+func NewReceiptRepository(dbConn *db.Connection) domain.ReceiptRepository {
+	return &receiptRepository{
+		dbConn: dbConn,
+	}
+}
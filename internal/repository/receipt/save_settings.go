@@ -0,0 +1,42 @@
+package receipt
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// SaveSettings stores the receipt settings of an outlet, replacing the
+// previous ones
+func (r *receiptRepository) SaveSettings(ctx context.Context, settings *domain.ReceiptSettings) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.receipt.SaveSettings")
+	defer span.End()
+
+	query := `
+		INSERT INTO receipt_settings (
+			outlet_id, merchant_id, header, footer, tax_id, logo, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7
+		)
+		ON CONFLICT (outlet_id) DO UPDATE
+		SET
+			header = EXCLUDED.header,
+			footer = EXCLUDED.footer,
+			tax_id = EXCLUDED.tax_id,
+			logo = EXCLUDED.logo,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.dbConn.DB.ExecContext(
+		ctx,
+		query,
+		settings.OutletID,
+		settings.MerchantID,
+		settings.Header,
+		settings.Footer,
+		settings.TaxID,
+		settings.Logo,
+		settings.UpdatedAt,
+	)
+	return err
+}
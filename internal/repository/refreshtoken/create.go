@@ -0,0 +1,35 @@
+package refreshtoken
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// Create stores a new refresh token in the database
+func (r *refreshTokenRepository) Create(ctx context.Context, token *domain.RefreshToken) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.refreshtoken.Create")
+	defer span.End()
+
+	query := `
+		INSERT INTO refresh_tokens (
+			id, user_id, family_id, token_hash, auth_type, expires_at, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7
+		)
+	`
+
+	_, err := r.dbConn.DB.ExecContext(
+		ctx,
+		query,
+		token.ID,
+		token.UserID,
+		token.FamilyID,
+		token.TokenHash,
+		token.AuthType,
+		token.ExpiresAt,
+		token.CreatedAt,
+	)
+
+	return err
+}
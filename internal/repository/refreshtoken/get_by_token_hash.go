@@ -0,0 +1,33 @@
+package refreshtoken
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// GetByTokenHash retrieves a refresh token by its hash
+func (r *refreshTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.refreshtoken.GetByTokenHash")
+	defer span.End()
+
+	query := `
+		SELECT 
+			id, user_id, family_id, token_hash, auth_type, 
+			expires_at, created_at, revoked_at
+		FROM refresh_tokens
+		WHERE token_hash = $1
+	`
+
+	var token domain.RefreshToken
+	err := r.dbConn.DB.GetContext(ctx, &token, query, tokenHash)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &token, nil
+}
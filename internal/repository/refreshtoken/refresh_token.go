@@ -0,0 +1,17 @@
+package refreshtoken
+
+import (
+	"github/kijunpos/config/db"
+	"github/kijunpos/internal/domain"
+)
+
+type refreshTokenRepository struct {
+	dbConn *db.Connection
+}
+
+// NewRefreshTokenRepository creates a new refresh token repository
+func NewRefreshTokenRepository(dbConn *db.Connection) domain.RefreshTokenRepository {
+	return &refreshTokenRepository{
+		dbConn: dbConn,
+	}
+}
@@ -0,0 +1,33 @@
+package refreshtoken
+
+import (
+	"context"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// Revoke marks a refresh token as revoked and reports whether it was still active.
+// The conditional update makes concurrent rotations of the same token race-free.
+func (r *refreshTokenRepository) Revoke(ctx context.Context, id uuid.UUID) (bool, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.refreshtoken.Revoke")
+	defer span.End()
+
+	query := `
+		UPDATE refresh_tokens
+		SET revoked_at = NOW()
+		WHERE id = $1 AND revoked_at IS NULL
+	`
+
+	result, err := r.dbConn.DB.ExecContext(ctx, query, id)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
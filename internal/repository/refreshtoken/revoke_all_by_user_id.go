@@ -0,0 +1,23 @@
+package refreshtoken
+
+import (
+	"context"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// RevokeAllByUserID revokes every active refresh token of the user
+func (r *refreshTokenRepository) RevokeAllByUserID(ctx context.Context, userID uuid.UUID) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.refreshtoken.RevokeAllByUserID")
+	defer span.End()
+
+	query := `
+		UPDATE refresh_tokens
+		SET revoked_at = NOW()
+		WHERE user_id = $1 AND revoked_at IS NULL
+	`
+
+	_, err := r.dbConn.DB.ExecContext(ctx, query, userID)
+	return err
+}
@@ -0,0 +1,23 @@
+package refreshtoken
+
+import (
+	"context"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// RevokeFamily revokes every active refresh token issued from the same login
+func (r *refreshTokenRepository) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.refreshtoken.RevokeFamily")
+	defer span.End()
+
+	query := `
+		UPDATE refresh_tokens
+		SET revoked_at = NOW()
+		WHERE family_id = $1 AND revoked_at IS NULL
+	`
+
+	_, err := r.dbConn.DB.ExecContext(ctx, query, familyID)
+	return err
+}
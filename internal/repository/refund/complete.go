@@ -0,0 +1,95 @@
+package refund
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/repository/inventory"
+	"github/kijunpos/internal/repository/loyalty"
+	"github/kijunpos/internal/repository/shift"
+)
+
+// Complete stores a completed refund, issues its store credit, credits the
+// parts given back to store credit payments, returns its items to stock,
+// reverses its share of the points of the customer and takes the cash given
+// back out of the drawer
+func (r *refundRepository) Complete(ctx context.Context, refund *domain.Refund, movements []*domain.StockMovement, points []*domain.PointEntry, cash *domain.CashMovement) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.refund.Complete")
+	defer span.End()
+
+	tx, err := r.dbConn.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE refunds
+		SET status = $3, completed_at = $4, shift_id = $5
+		WHERE merchant_id = $1 AND id = $2 AND status = 'pending'
+	`
+	result, err := tx.ExecContext(ctx, query, refund.MerchantID, refund.ID, refund.Status, refund.CompletedAt, refund.ShiftID)
+	if err != nil {
+		return err
+	}
+	if err := expectRow(result, domain.ErrRefundChanged); err != nil {
+		return err
+	}
+
+	if credit := refund.StoreCredit; credit != nil {
+		creditQuery := `
+			INSERT INTO store_credits (
+				id, merchant_id, refund_id, code, amount, balance, created_at
+			) VALUES (
+				$1, $2, $3, $4, $5, $6, $7
+			)
+		`
+		_, err := tx.ExecContext(
+			ctx,
+			creditQuery,
+			credit.ID,
+			credit.MerchantID,
+			credit.RefundID,
+			credit.Code,
+			credit.Amount,
+			credit.Balance,
+			credit.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := restoreRefundStoreCredits(ctx, tx, refund.ID, refund.CompletedAt.Time); err != nil {
+		return err
+	}
+
+	if err := inventory.ApplyMovements(ctx, tx, movements); err != nil {
+		return err
+	}
+
+	if err := loyalty.ApplyEntries(ctx, tx, points); err != nil {
+		return err
+	}
+
+	if refund.ShiftID.Valid {
+		if err := shift.RecordCash(ctx, tx, refund.ShiftID.UUID, cash); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// expectRow returns err when result changed no row
+func expectRow(result sql.Result, err error) error {
+	rows, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		return rowsErr
+	}
+	if rows == 0 {
+		return err
+	}
+	return nil
+}
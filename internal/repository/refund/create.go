@@ -0,0 +1,178 @@
+package refund
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/repository/pgerror"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Create stores a pending refund and adds its quantities and amounts to the
+// refunded ones of the order, its items and its payments
+func (r *refundRepository) Create(ctx context.Context, refund *domain.Refund, order *domain.Order) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.refund.Create")
+	defer span.End()
+
+	tx, err := r.dbConn.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// The version check makes concurrent refunds of the order wait for each
+	// other and fail instead of both giving back the same items
+	if err := reserveOrder(ctx, tx, refund, order); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO refunds (
+			id, merchant_id, outlet_id, order_id, status, tender, reason, amount,
+			points_taken, points_returned, idempotency_key, created_by, approved_by, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+		)
+	`
+	_, err = tx.ExecContext(
+		ctx,
+		query,
+		refund.ID,
+		refund.MerchantID,
+		refund.OutletID,
+		refund.OrderID,
+		refund.Status,
+		refund.Tender,
+		refund.Reason,
+		refund.Amount,
+		refund.PointsTaken,
+		refund.PointsReturned,
+		refund.IdempotencyKey,
+		refund.CreatedBy,
+		refund.ApprovedBy,
+		refund.CreatedAt,
+	)
+	if err != nil {
+		if pgerror.IsUniqueViolation(err, "idx_refunds_idempotency_key") {
+			return domain.ErrDuplicateIdempotencyKey
+		}
+		return err
+	}
+
+	if err := insertItems(ctx, tx, refund.Items); err != nil {
+		return err
+	}
+	if err := insertPayments(ctx, tx, refund.Payments); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// reserveOrder adds the refund to the refunded amounts of the order, its
+// items and its payments
+func reserveOrder(ctx context.Context, tx *sqlx.Tx, refund *domain.Refund, order *domain.Order) error {
+	query := `
+		UPDATE orders
+		SET refunded_total = refunded_total + $4, updated_at = $5, version = version + 1
+		WHERE merchant_id = $1 AND id = $2 AND version = $3 AND refunded_total + $4 <= total
+	`
+	result, err := tx.ExecContext(ctx, query, order.MerchantID, order.ID, order.Version, refund.Amount, refund.CreatedAt)
+	if err != nil {
+		return err
+	}
+	if err := expectRow(result, domain.ErrOrderChanged); err != nil {
+		return err
+	}
+
+	itemQuery := `
+		UPDATE order_items
+		SET refunded_quantity = refunded_quantity + $2
+		WHERE id = $1 AND refunded_quantity + $2 <= quantity
+	`
+	for _, item := range refund.Items {
+		result, err := tx.ExecContext(ctx, itemQuery, item.OrderItemID, item.Quantity)
+		if err != nil {
+			return err
+		}
+		if err := expectRow(result, domain.ErrOrderChanged); err != nil {
+			return err
+		}
+	}
+
+	paymentQuery := `
+		UPDATE payments
+		SET refunded_amount = refunded_amount + $2, updated_at = $3
+		WHERE id = $1 AND status = 'captured' AND refunded_amount + $2 <= amount
+	`
+	for _, p := range refund.Payments {
+		result, err := tx.ExecContext(ctx, paymentQuery, p.PaymentID, p.Amount, refund.CreatedAt)
+		if err != nil {
+			return err
+		}
+		if err := expectRow(result, domain.ErrPaymentChanged); err != nil {
+			return err
+		}
+	}
+
+	order.Version++
+	order.RefundedTotal += refund.Amount
+	return nil
+}
+
+// insertItems stores the items of a refund
+func insertItems(ctx context.Context, tx *sqlx.Tx, items []*domain.RefundItem) error {
+	query := `
+		INSERT INTO refund_items (
+			id, refund_id, order_item_id, variant_id, quantity, amount, restock
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7
+		)
+	`
+	for _, item := range items {
+		_, err := tx.ExecContext(
+			ctx,
+			query,
+			item.ID,
+			item.RefundID,
+			item.OrderItemID,
+			item.VariantID,
+			item.Quantity,
+			item.Amount,
+			item.Restock,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertPayments stores the parts of a refund given back through payments
+func insertPayments(ctx context.Context, tx *sqlx.Tx, payments []*domain.RefundPayment) error {
+	query := `
+		INSERT INTO refund_payments (
+			id, refund_id, payment_id, method, amount, status, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7
+		)
+	`
+	for _, p := range payments {
+		_, err := tx.ExecContext(
+			ctx,
+			query,
+			p.ID,
+			p.RefundID,
+			p.PaymentID,
+			p.Method,
+			p.Amount,
+			p.Status,
+			p.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
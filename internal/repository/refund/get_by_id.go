@@ -0,0 +1,37 @@
+package refund
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetByID retrieves a refund of a merchant by ID
+func (r *refundRepository) GetByID(ctx context.Context, merchantID, id uuid.UUID) (*domain.Refund, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.refund.GetByID")
+	defer span.End()
+
+	query := `
+		SELECT ` + refundColumns + `
+		FROM refunds
+		WHERE merchant_id = $1 AND id = $2
+	`
+
+	var refund domain.Refund
+	err := r.dbConn.DB.GetContext(ctx, &refund, query, merchantID, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := r.loadDetails(ctx, []*domain.Refund{&refund}); err != nil {
+		return nil, err
+	}
+
+	return &refund, nil
+}
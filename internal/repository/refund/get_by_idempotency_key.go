@@ -0,0 +1,37 @@
+package refund
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetByIdempotencyKey retrieves the refund of a merchant created with the key
+func (r *refundRepository) GetByIdempotencyKey(ctx context.Context, merchantID uuid.UUID, key string) (*domain.Refund, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.refund.GetByIdempotencyKey")
+	defer span.End()
+
+	query := `
+		SELECT ` + refundColumns + `
+		FROM refunds
+		WHERE merchant_id = $1 AND idempotency_key = $2
+	`
+
+	var refund domain.Refund
+	err := r.dbConn.DB.GetContext(ctx, &refund, query, merchantID, key)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if err := r.loadDetails(ctx, []*domain.Refund{&refund}); err != nil {
+		return nil, err
+	}
+
+	return &refund, nil
+}
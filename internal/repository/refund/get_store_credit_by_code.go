@@ -0,0 +1,33 @@
+package refund
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetStoreCreditByCode retrieves a store credit of a merchant by its code
+func (r *refundRepository) GetStoreCreditByCode(ctx context.Context, merchantID uuid.UUID, code string) (*domain.StoreCredit, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.refund.GetStoreCreditByCode")
+	defer span.End()
+
+	query := `
+		SELECT id, merchant_id, refund_id, code, amount, balance, created_at, updated_at
+		FROM store_credits
+		WHERE merchant_id = $1 AND code = $2
+	`
+
+	var credit domain.StoreCredit
+	err := r.dbConn.DB.GetContext(ctx, &credit, query, merchantID, code)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &credit, nil
+}
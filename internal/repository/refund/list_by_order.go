@@ -0,0 +1,33 @@
+package refund
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// ListByOrder lists the refunds of an order oldest first
+func (r *refundRepository) ListByOrder(ctx context.Context, merchantID, orderID uuid.UUID) ([]*domain.Refund, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.refund.ListByOrder")
+	defer span.End()
+
+	query := `
+		SELECT ` + refundColumns + `
+		FROM refunds
+		WHERE merchant_id = $1 AND order_id = $2
+		ORDER BY created_at, id
+	`
+
+	refunds := []*domain.Refund{}
+	if err := r.dbConn.DB.SelectContext(ctx, &refunds, query, merchantID, orderID); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadDetails(ctx, refunds); err != nil {
+		return nil, err
+	}
+
+	return refunds, nil
+}
@@ -0,0 +1,80 @@
+package refund
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// loadDetails attaches the items, the payments and the store credit to the refunds
+func (r *refundRepository) loadDetails(ctx context.Context, refunds []*domain.Refund) error {
+	if len(refunds) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*domain.Refund, len(refunds))
+	ids := make([]uuid.UUID, 0, len(refunds))
+	for _, refund := range refunds {
+		refund.Items = []*domain.RefundItem{}
+		refund.Payments = []*domain.RefundPayment{}
+		byID[refund.ID] = refund
+		ids = append(ids, refund.ID)
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT id, refund_id, order_item_id, variant_id, quantity, amount, restock
+		FROM refund_items
+		WHERE refund_id IN (?)
+		ORDER BY id
+	`, ids)
+	if err != nil {
+		return err
+	}
+
+	items := []*domain.RefundItem{}
+	if err := r.dbConn.DB.SelectContext(ctx, &items, r.dbConn.DB.Rebind(query), args...); err != nil {
+		return err
+	}
+	for _, item := range items {
+		byID[item.RefundID].Items = append(byID[item.RefundID].Items, item)
+	}
+
+	query, args, err = sqlx.In(`
+		SELECT id, refund_id, payment_id, method, amount, status, created_at, updated_at
+		FROM refund_payments
+		WHERE refund_id IN (?)
+		ORDER BY created_at, id
+	`, ids)
+	if err != nil {
+		return err
+	}
+
+	payments := []*domain.RefundPayment{}
+	if err := r.dbConn.DB.SelectContext(ctx, &payments, r.dbConn.DB.Rebind(query), args...); err != nil {
+		return err
+	}
+	for _, p := range payments {
+		byID[p.RefundID].Payments = append(byID[p.RefundID].Payments, p)
+	}
+
+	query, args, err = sqlx.In(`
+		SELECT id, merchant_id, refund_id, code, amount, balance, created_at, updated_at
+		FROM store_credits
+		WHERE refund_id IN (?)
+	`, ids)
+	if err != nil {
+		return err
+	}
+
+	credits := []*domain.StoreCredit{}
+	if err := r.dbConn.DB.SelectContext(ctx, &credits, r.dbConn.DB.Rebind(query), args...); err != nil {
+		return err
+	}
+	for _, credit := range credits {
+		byID[credit.RefundID].StoreCredit = credit
+	}
+
+	return nil
+}
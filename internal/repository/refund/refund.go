@@ -0,0 +1,22 @@
+package refund
+
+import (
+	"github/kijunpos/config/db"
+	"github/kijunpos/internal/domain"
+)
+
+// refundColumns is the column list selected into domain.Refund
+const refundColumns = `
+		id, merchant_id, outlet_id, order_id, shift_id, status, tender, reason, amount,
+		points_taken, points_returned, idempotency_key, created_by, approved_by, created_at, completed_at`
+
+type refundRepository struct {
+	dbConn *db.Connection
+}
+
+// NewRefundRepository creates a new refund repository
+func NewRefundRepository(dbConn *db.Connection) domain.RefundRepository {
+	return &refundRepository{
+		dbConn: dbConn,
+	}
+}
@@ -0,0 +1,79 @@
+package refund
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// SpendStoreCredits takes the store credit payments out of the balance of
+// their credits within tx. Credits are debited in code order, so concurrent
+// checkouts lock them in the same order. It fails with
+// ErrInsufficientStoreCredit when a credit does not exist or its balance
+// does not cover the payment.
+func SpendStoreCredits(ctx context.Context, tx *sqlx.Tx, payments []*domain.Payment) error {
+	credits := []*domain.Payment{}
+	for _, p := range payments {
+		if p.Method == domain.PaymentMethodStoreCredit {
+			credits = append(credits, p)
+		}
+	}
+	sort.Slice(credits, func(i, j int) bool { return credits[i].Reference < credits[j].Reference })
+
+	query := `
+		UPDATE store_credits
+		SET balance = balance - $3, updated_at = $4
+		WHERE merchant_id = $1 AND code = $2 AND balance >= $3
+	`
+	for _, p := range credits {
+		result, err := tx.ExecContext(ctx, query, p.MerchantID, p.Reference, p.Amount, p.CreatedAt)
+		if err != nil {
+			return err
+		}
+		if err := expectRow(result, domain.ErrInsufficientStoreCredit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RestoreOrderStoreCredits gives the store credit payments of a voided order
+// back to the balance of their credits within tx
+func RestoreOrderStoreCredits(ctx context.Context, tx *sqlx.Tx, orderID uuid.UUID, at time.Time) error {
+	query := `
+		UPDATE store_credits sc
+		SET balance = sc.balance + p.amount, updated_at = $2
+		FROM (
+			SELECT merchant_id, reference, SUM(amount - refunded_amount) AS amount
+			FROM payments
+			WHERE order_id = $1 AND method = 'store_credit' AND status = 'refunded'
+			GROUP BY merchant_id, reference
+		) p
+		WHERE sc.merchant_id = p.merchant_id AND sc.code = p.reference
+	`
+	_, err := tx.ExecContext(ctx, query, orderID, at)
+	return err
+}
+
+// restoreRefundStoreCredits gives the parts of a refund returned through
+// store credit payments back to the balance of their credits within tx
+func restoreRefundStoreCredits(ctx context.Context, tx *sqlx.Tx, refundID uuid.UUID, at time.Time) error {
+	query := `
+		UPDATE store_credits sc
+		SET balance = sc.balance + p.amount, updated_at = $2
+		FROM (
+			SELECT pay.merchant_id, pay.reference, SUM(rp.amount) AS amount
+			FROM refund_payments rp
+			JOIN payments pay ON pay.id = rp.payment_id
+			WHERE rp.refund_id = $1 AND pay.method = 'store_credit'
+			GROUP BY pay.merchant_id, pay.reference
+		) p
+		WHERE sc.merchant_id = p.merchant_id AND sc.code = p.reference
+	`
+	_, err := tx.ExecContext(ctx, query, refundID, at)
+	return err
+}
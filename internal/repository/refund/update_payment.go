@@ -0,0 +1,22 @@
+package refund
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// UpdatePayment stores the status of a part of a refund given back through a payment
+func (r *refundRepository) UpdatePayment(ctx context.Context, payment *domain.RefundPayment) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.refund.UpdatePayment")
+	defer span.End()
+
+	query := `
+		UPDATE refund_payments
+		SET status = $2, updated_at = $3
+		WHERE id = $1
+	`
+
+	_, err := r.dbConn.DB.ExecContext(ctx, query, payment.ID, payment.Status, payment.UpdatedAt)
+	return err
+}
@@ -0,0 +1,23 @@
+package role
+
+import (
+	"context"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// AssignToUser assigns a role to a user, assigning a role twice has no effect
+func (r *roleRepository) AssignToUser(ctx context.Context, userID uuid.UUID, role string) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.role.AssignToUser")
+	defer span.End()
+
+	query := `
+		INSERT INTO user_roles (user_id, role)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id, role) DO NOTHING
+	`
+
+	_, err := r.dbConn.DB.ExecContext(ctx, query, userID, role)
+	return err
+}
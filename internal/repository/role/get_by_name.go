@@ -0,0 +1,36 @@
+package role
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// GetByName retrieves a role and its permissions by name
+func (r *roleRepository) GetByName(ctx context.Context, name string) (*domain.Role, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.role.GetByName")
+	defer span.End()
+
+	query := `
+		SELECT name, description
+		FROM roles
+		WHERE name = $1
+	`
+
+	var role domain.Role
+	err := r.dbConn.DB.GetContext(ctx, &role, query, name)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	role.Permissions, err = r.GetPermissions(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &role, nil
+}
@@ -0,0 +1,27 @@
+package role
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// GetPermissions retrieves the permissions granted by a role
+func (r *roleRepository) GetPermissions(ctx context.Context, role string) ([]domain.Permission, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.role.GetPermissions")
+	defer span.End()
+
+	query := `
+		SELECT permission
+		FROM role_permissions
+		WHERE role = $1
+		ORDER BY permission
+	`
+
+	permissions := []domain.Permission{}
+	if err := r.dbConn.DB.SelectContext(ctx, &permissions, query, role); err != nil {
+		return nil, err
+	}
+
+	return permissions, nil
+}
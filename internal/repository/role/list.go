@@ -0,0 +1,51 @@
+package role
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// List retrieves all roles together with their permissions
+func (r *roleRepository) List(ctx context.Context) ([]*domain.Role, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.role.List")
+	defer span.End()
+
+	query := `
+		SELECT name, description
+		FROM roles
+		ORDER BY name
+	`
+
+	roles := []*domain.Role{}
+	if err := r.dbConn.DB.SelectContext(ctx, &roles, query); err != nil {
+		return nil, err
+	}
+
+	permissionQuery := `
+		SELECT role, permission
+		FROM role_permissions
+		ORDER BY role, permission
+	`
+
+	var grants []struct {
+		Role       string            `db:"role"`
+		Permission domain.Permission `db:"permission"`
+	}
+	if err := r.dbConn.DB.SelectContext(ctx, &grants, permissionQuery); err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]*domain.Role, len(roles))
+	for _, role := range roles {
+		role.Permissions = []domain.Permission{}
+		byName[role.Name] = role
+	}
+	for _, grant := range grants {
+		if role, ok := byName[grant.Role]; ok {
+			role.Permissions = append(role.Permissions, grant.Permission)
+		}
+	}
+
+	return roles, nil
+}
@@ -0,0 +1,22 @@
+package role
+
+import (
+	"context"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// RevokeFromUser removes a role from a user
+func (r *roleRepository) RevokeFromUser(ctx context.Context, userID uuid.UUID, role string) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.role.RevokeFromUser")
+	defer span.End()
+
+	query := `
+		DELETE FROM user_roles
+		WHERE user_id = $1 AND role = $2
+	`
+
+	_, err := r.dbConn.DB.ExecContext(ctx, query, userID, role)
+	return err
+}
@@ -0,0 +1,17 @@
+package role
+
+import (
+	"github/kijunpos/config/db"
+	"github/kijunpos/internal/domain"
+)
+
+type roleRepository struct {
+	dbConn *db.Connection
+}
+
+// NewRoleRepository creates a new role repository
+func NewRoleRepository(dbConn *db.Connection) domain.RoleRepository {
+	return &roleRepository{
+		dbConn: dbConn,
+	}
+}
@@ -0,0 +1,25 @@
+package shift
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// AddCashMovement appends a movement to the cash ledger of an open shift
+func (r *shiftRepository) AddCashMovement(ctx context.Context, movement *domain.CashMovement) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.shift.AddCashMovement")
+	defer span.End()
+
+	tx, err := r.dbConn.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := RecordCash(ctx, tx, movement.ShiftID, movement); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
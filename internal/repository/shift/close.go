@@ -0,0 +1,71 @@
+package shift
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// Close computes the expected cash of an open shift from its cash ledger and
+// stores the closed shift
+func (r *shiftRepository) Close(ctx context.Context, shift *domain.Shift) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.shift.Close")
+	defer span.End()
+
+	tx, err := r.dbConn.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Sales, voids and refunds recording cash hold the shift for share, the
+	// lock waits for them so the ledger is complete
+	var status domain.ShiftStatus
+	err = tx.GetContext(ctx, &status, `SELECT status FROM shifts WHERE merchant_id = $1 AND id = $2 FOR UPDATE`, shift.MerchantID, shift.ID)
+	if err == sql.ErrNoRows || (err == nil && status != domain.ShiftStatusOpen) {
+		return domain.ErrShiftClosed
+	}
+	if err != nil {
+		return err
+	}
+
+	var movements int64
+	if err := tx.GetContext(ctx, &movements, `SELECT COALESCE(SUM(amount), 0) FROM cash_movements WHERE shift_id = $1`, shift.ID); err != nil {
+		return err
+	}
+	expected := shift.OpeningFloat + movements
+	shift.ExpectedCash = sql.NullInt64{Int64: expected, Valid: true}
+	shift.Variance = sql.NullInt64{Int64: shift.CountedCash.Int64 - expected, Valid: true}
+
+	query := `
+		UPDATE shifts
+		SET
+			status = $3,
+			expected_cash = $4,
+			counted_cash = $5,
+			variance = $6,
+			closing_note = $7,
+			closed_at = $8,
+			closed_by = $9
+		WHERE merchant_id = $1 AND id = $2
+	`
+	_, err = tx.ExecContext(
+		ctx,
+		query,
+		shift.MerchantID,
+		shift.ID,
+		shift.Status,
+		shift.ExpectedCash,
+		shift.CountedCash,
+		shift.Variance,
+		shift.ClosingNote,
+		shift.ClosedAt,
+		shift.ClosedBy,
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
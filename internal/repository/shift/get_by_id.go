@@ -0,0 +1,33 @@
+package shift
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetByID retrieves a shift of a merchant by ID
+func (r *shiftRepository) GetByID(ctx context.Context, merchantID, id uuid.UUID) (*domain.Shift, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.shift.GetByID")
+	defer span.End()
+
+	query := `
+		SELECT ` + shiftColumns + `
+		FROM shifts
+		WHERE merchant_id = $1 AND id = $2
+	`
+
+	var shift domain.Shift
+	err := r.dbConn.DB.GetContext(ctx, &shift, query, merchantID, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &shift, nil
+}
@@ -0,0 +1,33 @@
+package shift
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetOpen retrieves the open shift of a user at an outlet
+func (r *shiftRepository) GetOpen(ctx context.Context, merchantID, outletID, userID uuid.UUID) (*domain.Shift, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.shift.GetOpen")
+	defer span.End()
+
+	query := `
+		SELECT ` + shiftColumns + `
+		FROM shifts
+		WHERE merchant_id = $1 AND outlet_id = $2 AND user_id = $3 AND status = 'open'
+	`
+
+	var shift domain.Shift
+	err := r.dbConn.DB.GetContext(ctx, &shift, query, merchantID, outletID, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &shift, nil
+}
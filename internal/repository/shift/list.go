@@ -0,0 +1,48 @@
+package shift
+
+import (
+	"context"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// List retrieves the shifts of an outlet matching the filter, newest first,
+// starting after the cursor
+func (r *shiftRepository) List(ctx context.Context, merchantID, outletID uuid.UUID, filter domain.ShiftFilter, after *domain.PageCursor, limit int) ([]*domain.Shift, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.shift.List")
+	defer span.End()
+
+	conditions := []string{"merchant_id = $1", "outlet_id = $2"}
+	args := []interface{}{merchantID, outletID}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	}
+	if filter.UserID != uuid.Nil {
+		args = append(args, filter.UserID)
+		conditions = append(conditions, fmt.Sprintf("user_id = $%d", len(args)))
+	}
+	if after != nil {
+		args = append(args, after.CreatedAt, after.ID)
+		conditions = append(conditions, fmt.Sprintf("(opened_at, id) < ($%d, $%d)", len(args)-1, len(args)))
+	}
+
+	args = append(args, limit)
+	query := `
+		SELECT ` + shiftColumns + `
+		FROM shifts
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY opened_at DESC, id DESC
+		LIMIT ` + fmt.Sprintf("$%d", len(args))
+
+	shifts := []*domain.Shift{}
+	if err := r.dbConn.DB.SelectContext(ctx, &shifts, query, args...); err != nil {
+		return nil, err
+	}
+
+	return shifts, nil
+}
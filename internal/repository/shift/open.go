@@ -0,0 +1,43 @@
+package shift
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/repository/pgerror"
+)
+
+// Open stores a new open shift
+func (r *shiftRepository) Open(ctx context.Context, shift *domain.Shift) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.shift.Open")
+	defer span.End()
+
+	query := `
+		INSERT INTO shifts (
+			id, merchant_id, outlet_id, user_id, status, opening_float, opening_note, opened_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8
+		)
+	`
+
+	_, err := r.dbConn.DB.ExecContext(
+		ctx,
+		query,
+		shift.ID,
+		shift.MerchantID,
+		shift.OutletID,
+		shift.UserID,
+		shift.Status,
+		shift.OpeningFloat,
+		shift.OpeningNote,
+		shift.OpenedAt,
+	)
+	if err != nil {
+		if pgerror.IsUniqueViolation(err, "idx_shifts_open_user") {
+			return domain.ErrShiftAlreadyOpen
+		}
+		return err
+	}
+
+	return nil
+}
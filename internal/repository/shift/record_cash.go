@@ -0,0 +1,50 @@
+package shift
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// RecordCash checks within tx that a shift is still open and appends the
+// movement to its cash ledger, which lets other repositories attribute their
+// rows to a shift. The shift is locked for share, so it cannot close before tx
+// commits. A nil movement only checks the shift.
+func RecordCash(ctx context.Context, tx *sqlx.Tx, shiftID uuid.UUID, movement *domain.CashMovement) error {
+	var status domain.ShiftStatus
+	err := tx.GetContext(ctx, &status, `SELECT status FROM shifts WHERE id = $1 FOR SHARE`, shiftID)
+	if err == sql.ErrNoRows || (err == nil && status != domain.ShiftStatusOpen) {
+		return domain.ErrShiftClosed
+	}
+	if err != nil {
+		return err
+	}
+	if movement == nil {
+		return nil
+	}
+
+	query := `
+		INSERT INTO cash_movements (
+			id, merchant_id, shift_id, type, amount, reference_id, reason, created_by, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9
+		)
+	`
+	_, err = tx.ExecContext(
+		ctx,
+		query,
+		movement.ID,
+		movement.MerchantID,
+		shiftID,
+		movement.Type,
+		movement.Amount,
+		movement.ReferenceID,
+		movement.Reason,
+		movement.CreatedBy,
+		movement.CreatedAt,
+	)
+	return err
+}
@@ -0,0 +1,71 @@
+package shift
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// Report summarizes the sales, refunds and cash ledger of a shift
+func (r *shiftRepository) Report(ctx context.Context, shift *domain.Shift) (*domain.ShiftReport, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.shift.Report")
+	defer span.End()
+
+	report := &domain.ShiftReport{Shift: shift}
+
+	salesQuery := `
+		SELECT
+			COUNT(*) FILTER (WHERE status = 'completed') AS sales_count,
+			COALESCE(SUM(subtotal) FILTER (WHERE status = 'completed'), 0) AS subtotal,
+			COALESCE(SUM(discount_total) FILTER (WHERE status = 'completed'), 0) AS discount_total,
+			COALESCE(SUM(service_charge_total) FILTER (WHERE status = 'completed'), 0) AS service_charge_total,
+			COALESCE(SUM(tax_total) FILTER (WHERE status = 'completed'), 0) AS tax_total,
+			COALESCE(SUM(total) FILTER (WHERE status = 'completed'), 0) AS total,
+			COUNT(*) FILTER (WHERE status = 'voided') AS voided_count,
+			COALESCE(SUM(total) FILTER (WHERE status = 'voided'), 0) AS voided_total
+		FROM orders
+		WHERE merchant_id = $1 AND shift_id = $2
+	`
+	if err := r.dbConn.DB.GetContext(ctx, report, salesQuery, shift.MerchantID, shift.ID); err != nil {
+		return nil, err
+	}
+
+	refundQuery := `
+		SELECT COUNT(*) AS refund_count, COALESCE(SUM(amount), 0) AS refund_total
+		FROM refunds
+		WHERE merchant_id = $1 AND shift_id = $2 AND status = 'completed'
+	`
+	if err := r.dbConn.DB.GetContext(ctx, report, refundQuery, shift.MerchantID, shift.ID); err != nil {
+		return nil, err
+	}
+
+	tenderQuery := `
+		SELECT p.method, COUNT(*) AS count, SUM(p.amount) AS amount
+		FROM payments p
+		JOIN orders o ON o.id = p.order_id
+		WHERE o.merchant_id = $1 AND o.shift_id = $2 AND o.status = 'completed' AND p.status = 'captured'
+		GROUP BY p.method
+		ORDER BY p.method
+	`
+	report.Tenders = []*domain.TenderSummary{}
+	if err := r.dbConn.DB.SelectContext(ctx, &report.Tenders, tenderQuery, shift.MerchantID, shift.ID); err != nil {
+		return nil, err
+	}
+
+	cashQuery := `
+		SELECT
+			COALESCE(SUM(amount) FILTER (WHERE type = 'sale'), 0) AS cash_sales,
+			COALESCE(SUM(amount) FILTER (WHERE type = 'refund'), 0) AS cash_refunds,
+			COALESCE(SUM(amount) FILTER (WHERE type = 'void'), 0) AS cash_voids,
+			COALESCE(SUM(amount) FILTER (WHERE type = 'cash_in'), 0) AS cash_in,
+			COALESCE(SUM(amount) FILTER (WHERE type = 'cash_out'), 0) AS cash_out
+		FROM cash_movements
+		WHERE shift_id = $1
+	`
+	if err := r.dbConn.DB.GetContext(ctx, report, cashQuery, shift.ID); err != nil {
+		return nil, err
+	}
+	report.ExpectedCash = shift.OpeningFloat + report.CashSales + report.CashRefunds + report.CashVoids + report.CashIn + report.CashOut
+
+	return report, nil
+}
@@ -0,0 +1,22 @@
+package shift
+
+import (
+	"github/kijunpos/config/db"
+	"github/kijunpos/internal/domain"
+)
+
+// shiftColumns is the column list selected into domain.Shift
+const shiftColumns = `
+		id, merchant_id, outlet_id, user_id, status, opening_float, expected_cash,
+		counted_cash, variance, opening_note, closing_note, opened_at, closed_at, closed_by`
+
+type shiftRepository struct {
+	dbConn *db.Connection
+}
+
+// NewShiftRepository creates a new shift repository
+func NewShiftRepository(dbConn *db.Connection) domain.ShiftRepository {
+	return &shiftRepository{
+		dbConn: dbConn,
+	}
+}
@@ -0,0 +1,43 @@
+package tax
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/repository/pgerror"
+)
+
+// Create stores a new tax rule
+func (r *taxRuleRepository) Create(ctx context.Context, rule *domain.TaxRule) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.tax.Create")
+	defer span.End()
+
+	query := `
+		INSERT INTO tax_rules (
+			id, merchant_id, outlet_id, type, name, rate, inclusive, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8
+		)
+	`
+
+	_, err := r.dbConn.DB.ExecContext(
+		ctx,
+		query,
+		rule.ID,
+		rule.MerchantID,
+		rule.OutletID,
+		rule.Type,
+		rule.Name,
+		rule.Rate,
+		rule.Inclusive,
+		rule.CreatedAt,
+	)
+	if err != nil {
+		if pgerror.IsUniqueViolation(err, "") {
+			return domain.ErrTaxRuleExists
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package tax
+
+import (
+	"context"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// Delete deletes a tax rule, carts already created keep their taxes
+func (r *taxRuleRepository) Delete(ctx context.Context, merchantID, id uuid.UUID) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.tax.Delete")
+	defer span.End()
+
+	query := `
+		DELETE FROM tax_rules
+		WHERE merchant_id = $1 AND id = $2
+	`
+
+	_, err := r.dbConn.DB.ExecContext(ctx, query, merchantID, id)
+	return err
+}
@@ -0,0 +1,33 @@
+package tax
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetByID retrieves a tax rule of a merchant by ID
+func (r *taxRuleRepository) GetByID(ctx context.Context, merchantID, id uuid.UUID) (*domain.TaxRule, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.tax.GetByID")
+	defer span.End()
+
+	query := `
+		SELECT ` + ruleColumns + `
+		FROM tax_rules
+		WHERE merchant_id = $1 AND id = $2
+	`
+
+	var rule domain.TaxRule
+	err := r.dbConn.DB.GetContext(ctx, &rule, query, merchantID, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &rule, nil
+}
@@ -0,0 +1,30 @@
+package tax
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// ListByMerchant retrieves the tax rules of a merchant, the rules of the
+// whole merchant before those of its outlets
+func (r *taxRuleRepository) ListByMerchant(ctx context.Context, merchantID uuid.UUID) ([]*domain.TaxRule, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.tax.ListByMerchant")
+	defer span.End()
+
+	query := `
+		SELECT ` + ruleColumns + `
+		FROM tax_rules
+		WHERE merchant_id = $1
+		ORDER BY outlet_id NULLS FIRST, type
+	`
+
+	rules := []*domain.TaxRule{}
+	if err := r.dbConn.DB.SelectContext(ctx, &rules, query, merchantID); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
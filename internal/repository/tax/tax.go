@@ -0,0 +1,21 @@
+package tax
+
+import (
+	"github/kijunpos/config/db"
+	"github/kijunpos/internal/domain"
+)
+
+// ruleColumns is the column list selected into domain.TaxRule
+const ruleColumns = `
+		id, merchant_id, outlet_id, type, name, rate, inclusive, created_at, updated_at`
+
+type taxRuleRepository struct {
+	dbConn *db.Connection
+}
+
+// NewTaxRuleRepository creates a new tax rule repository
+func NewTaxRuleRepository(dbConn *db.Connection) domain.TaxRuleRepository {
+	return &taxRuleRepository{
+		dbConn: dbConn,
+	}
+}
@@ -0,0 +1,32 @@
+package tax
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// Update updates the name, the rate and the inclusiveness of a tax rule
+func (r *taxRuleRepository) Update(ctx context.Context, rule *domain.TaxRule) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.tax.Update")
+	defer span.End()
+
+	query := `
+		UPDATE tax_rules
+		SET name = $3, rate = $4, inclusive = $5, updated_at = $6
+		WHERE merchant_id = $1 AND id = $2
+	`
+
+	_, err := r.dbConn.DB.ExecContext(
+		ctx,
+		query,
+		rule.MerchantID,
+		rule.ID,
+		rule.Name,
+		rule.Rate,
+		rule.Inclusive,
+		rule.UpdatedAt,
+	)
+
+	return err
+}
@@ -14,9 +14,9 @@ func (r *userRepository) Create(ctx context.Context, user *domain.User) error {
 	query := `
 		INSERT INTO users (
 			id, username, password_hash, email, whatsapp_number, pin, is_active, 
-			failed_login_attempts, created_at
+			failed_login_attempts, created_at, whatsapp_verified_at, email_verified_at
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9
+			$1, $2, $3, NULLIF($4, ''), NULLIF($5, ''), NULLIF($6, ''), $7, $8, $9, $10, $11
 		)
 	`
 
@@ -28,10 +28,12 @@ func (r *userRepository) Create(ctx context.Context, user *domain.User) error {
 		user.PasswordHash,
 		user.Email,
 		user.WhatsAppNumber,
-		user.OTPPIN,
+		user.PINHash,
 		user.IsActive,
 		user.FailedLoginAttempts,
 		user.CreatedAt,
+		user.WhatsAppVerifiedAt,
+		user.EmailVerifiedAt,
 	)
 
 	return err
@@ -13,10 +13,7 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (*domain.
 	defer span.End()
 
 	query := `
-		SELECT 
-			id, username, password_hash, email, is_active, 
-			failed_login_attempts, created_at, last_login_at, 
-			password_changed_at, updated_at, deleted_at
+		SELECT ` + userColumns + `
 		FROM users
 		WHERE email = $1 AND deleted_at IS NULL
 	`
@@ -15,10 +15,7 @@ func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Use
 	defer span.End()
 
 	query := `
-		SELECT 
-			id, username, password_hash, email, is_active, 
-			failed_login_attempts, created_at, last_login_at, 
-			password_changed_at, updated_at, deleted_at
+		SELECT ` + userColumns + `
 		FROM users
 		WHERE id = $1 AND deleted_at IS NULL
 	`
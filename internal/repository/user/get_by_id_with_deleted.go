@@ -0,0 +1,33 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetByIDWithDeleted retrieves a user by ID, including soft deleted users
+func (r *userRepository) GetByIDWithDeleted(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.user.GetByIDWithDeleted")
+	defer span.End()
+
+	query := `
+		SELECT ` + userColumns + `
+		FROM users
+		WHERE id = $1
+	`
+
+	var user domain.User
+	err := r.dbConn.DB.GetContext(ctx, &user, query, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &user, nil
+}
@@ -13,10 +13,7 @@ func (r *userRepository) GetByUsername(ctx context.Context, username string) (*d
 	defer span.End()
 
 	query := `
-		SELECT 
-			id, username, password_hash, email, is_active, 
-			failed_login_attempts, created_at, last_login_at, 
-			password_changed_at, updated_at, deleted_at
+		SELECT ` + userColumns + `
 		FROM users
 		WHERE username = $1 AND deleted_at IS NULL
 	`
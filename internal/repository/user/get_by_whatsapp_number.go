@@ -13,31 +13,13 @@ func (r *userRepository) GetByWhatsAppNumber(ctx context.Context, whatsAppNumber
 	defer span.End()
 
 	query := `
-		SELECT id, username, password_hash, email, whatsapp_number, pin, is_active, 
-		failed_login_attempts, created_at, last_login_at, password_changed_at, updated_at, deleted_at
+		SELECT ` + userColumns + `
 		FROM users
 		WHERE whatsapp_number = $1 AND deleted_at IS NULL
 	`
 
 	var user domain.User
-	var lastLoginAt, passwordChangedAt, updatedAt, deletedAt sql.NullTime
-
-	err := r.dbConn.DB.QueryRowContext(ctx, query, whatsAppNumber).Scan(
-		&user.ID,
-		&user.UserName,
-		&user.PasswordHash,
-		&user.Email,
-		&user.WhatsAppNumber,
-		&user.OTPPIN,
-		&user.IsActive,
-		&user.FailedLoginAttempts,
-		&user.CreatedAt,
-		&lastLoginAt,
-		&passwordChangedAt,
-		&updatedAt,
-		&deletedAt,
-	)
-
+	err := r.dbConn.DB.GetContext(ctx, &user, query, whatsAppNumber)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -45,10 +27,5 @@ func (r *userRepository) GetByWhatsAppNumber(ctx context.Context, whatsAppNumber
 		return nil, err
 	}
 
-	user.LastLoginAt = lastLoginAt
-	user.PasswordChangedAt = passwordChangedAt
-	user.UpdatedAt = updatedAt
-	user.DeletedAt = deletedAt
-
 	return &user, nil
 }
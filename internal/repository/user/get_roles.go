@@ -0,0 +1,28 @@
+package user
+
+import (
+	"context"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// GetRoles retrieves the role names assigned to a user
+func (r *userRepository) GetRoles(ctx context.Context, id uuid.UUID) ([]string, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.user.GetRoles")
+	defer span.End()
+
+	query := `
+		SELECT role
+		FROM user_roles
+		WHERE user_id = $1
+		ORDER BY role
+	`
+
+	roles := []string{}
+	if err := r.dbConn.DB.SelectContext(ctx, &roles, query, id); err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
@@ -0,0 +1,79 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"strings"
+)
+
+// likeEscaper escapes the LIKE wildcards of a search term
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// List retrieves the users matching the filter, newest first, starting after the cursor
+func (r *userRepository) List(ctx context.Context, filter domain.UserFilter, after *domain.PageCursor, limit int) ([]*domain.User, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.user.List")
+	defer span.End()
+
+	var conditions []string
+	var args []interface{}
+	addCondition := func(condition string, values ...interface{}) {
+		placeholders := make([]interface{}, len(values))
+		for i, value := range values {
+			args = append(args, value)
+			placeholders[i] = fmt.Sprintf("$%d", len(args))
+		}
+		conditions = append(conditions, fmt.Sprintf(condition, placeholders...))
+	}
+
+	if filter.IsDeleted != nil && *filter.IsDeleted {
+		addCondition("deleted_at IS NOT NULL")
+	} else {
+		addCondition("deleted_at IS NULL")
+	}
+	if filter.IsActive != nil {
+		addCondition("is_active = %s", *filter.IsActive)
+	}
+	if filter.IsLocked != nil {
+		if *filter.IsLocked {
+			addCondition("locked_until > NOW()")
+		} else {
+			addCondition("(locked_until IS NULL OR locked_until <= NOW())")
+		}
+	}
+	switch filter.AuthType {
+	case domain.AuthTypeEmail:
+		addCondition("password_hash <> ''")
+	case domain.AuthTypeWhatsApp:
+		addCondition("whatsapp_number IS NOT NULL")
+	}
+	if !filter.CreatedAfter.IsZero() {
+		addCondition("created_at >= %s", filter.CreatedAfter)
+	}
+	if !filter.CreatedBefore.IsZero() {
+		addCondition("created_at < %s", filter.CreatedBefore)
+	}
+	if filter.Search != "" {
+		pattern := "%" + likeEscaper.Replace(filter.Search) + "%"
+		addCondition("(username ILIKE %[1]s OR email ILIKE %[1]s OR whatsapp_number ILIKE %[1]s)", pattern)
+	}
+	if after != nil {
+		addCondition("(created_at, id) < (%s, %s)", after.CreatedAt, after.ID)
+	}
+
+	args = append(args, limit)
+	query := `
+		SELECT ` + userColumns + `
+		FROM users
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT ` + fmt.Sprintf("$%d", len(args))
+
+	users := []*domain.User{}
+	if err := r.dbConn.DB.SelectContext(ctx, &users, query, args...); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
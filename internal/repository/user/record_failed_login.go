@@ -0,0 +1,67 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// RecordFailedLogin atomically counts a failed login and locks the account
+// once the policy limit is reached.
+// The counter is evaluated inside a single UPDATE so concurrent failed attempts
+// are serialized by the row lock and cannot race past the limit.
+// Attempts made while the account is already locked are not counted.
+func (r *userRepository) RecordFailedLogin(ctx context.Context, id uuid.UUID, policy domain.LockoutPolicy) (sql.NullTime, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.user.RecordFailedLogin")
+	defer span.End()
+
+	// The lock duration of a lockout is picked by the number of earlier lockouts
+	durations := policy.LockDurations()
+	lockSeconds := make([]float64, len(durations))
+	for i, d := range durations {
+		lockSeconds[i] = d.Seconds()
+	}
+
+	query := `
+		UPDATE users
+		SET
+			failed_login_attempts = CASE
+				WHEN failed_login_attempts + 1 >= $2 THEN 0
+				ELSE failed_login_attempts + 1
+			END,
+			lockout_count = CASE
+				WHEN failed_login_attempts + 1 >= $2 THEN lockout_count + 1
+				ELSE lockout_count
+			END,
+			locked_until = CASE
+				WHEN failed_login_attempts + 1 >= $2 THEN NOW() + make_interval(
+					secs => ($3::float8[])[LEAST(lockout_count + 1, cardinality($3::float8[]))]
+				)
+				ELSE NULL
+			END,
+			updated_at = NOW()
+		WHERE id = $1 AND (locked_until IS NULL OR locked_until <= NOW())
+		RETURNING locked_until
+	`
+
+	var lockedUntil sql.NullTime
+	err := r.dbConn.DB.QueryRowContext(
+		ctx,
+		query,
+		id,
+		policy.MaxFailedAttempts,
+		lockSeconds,
+	).Scan(&lockedUntil)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// The account got locked by a concurrent attempt, read the current lock
+			err = r.dbConn.DB.GetContext(ctx, &lockedUntil, `SELECT locked_until FROM users WHERE id = $1`, id)
+		}
+		return lockedUntil, err
+	}
+
+	return lockedUntil, nil
+}
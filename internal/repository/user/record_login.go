@@ -0,0 +1,45 @@
+package user
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RecordLogin stores a successful login. Only the login columns are written
+// and only while the account is active and unlocked, so a lock set by a
+// concurrent failed attempt or a concurrent deactivation is never undone.
+func (r *userRepository) RecordLogin(ctx context.Context, id uuid.UUID, at time.Time) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.user.RecordLogin")
+	defer span.End()
+
+	query := `
+		UPDATE users
+		SET
+			last_login_at = $2,
+			failed_login_attempts = 0,
+			lockout_count = 0,
+			locked_until = NULL,
+			updated_at = $2
+		WHERE id = $1
+			AND is_active
+			AND deleted_at IS NULL
+			AND (locked_until IS NULL OR locked_until <= NOW())
+	`
+
+	result, err := r.dbConn.DB.ExecContext(ctx, query, id, at)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrLoginRejected
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package user
+
+import (
+	"context"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// RehashPIN replaces a legacy PIN with its hash, a PIN changed meanwhile is kept
+func (r *userRepository) RehashPIN(ctx context.Context, id uuid.UUID, oldHash, newHash string) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.user.RehashPIN")
+	defer span.End()
+
+	query := `
+		UPDATE users
+		SET pin = $3
+		WHERE id = $1 AND pin = $2
+	`
+
+	_, err := r.dbConn.DB.ExecContext(ctx, query, id, oldHash, newHash)
+	return err
+}
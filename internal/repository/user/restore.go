@@ -0,0 +1,23 @@
+package user
+
+import (
+	"context"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// Restore undoes the soft delete of a user
+func (r *userRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.user.Restore")
+	defer span.End()
+
+	query := `
+		UPDATE users
+		SET deleted_at = NULL, updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NOT NULL
+	`
+
+	_, err := r.dbConn.DB.ExecContext(ctx, query, id)
+	return err
+}
@@ -0,0 +1,27 @@
+package user
+
+import (
+	"context"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// Unlock clears the lockout state of a user
+func (r *userRepository) Unlock(ctx context.Context, id uuid.UUID) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.user.Unlock")
+	defer span.End()
+
+	query := `
+		UPDATE users
+		SET 
+			failed_login_attempts = 0,
+			lockout_count = 0,
+			locked_until = NULL,
+			updated_at = NOW()
+		WHERE id = $1
+	`
+
+	_, err := r.dbConn.DB.ExecContext(ctx, query, id)
+	return err
+}
@@ -16,14 +16,18 @@ func (r *userRepository) Update(ctx context.Context, user *domain.User) error {
 		SET 
 			username = $2,
 			password_hash = $3,
-			email = $4,
-			whatsapp_number = $5,
-			pin = $6,
+			email = NULLIF($4, ''),
+			whatsapp_number = NULLIF($5, ''),
+			pin = NULLIF($6, ''),
 			is_active = $7,
 			failed_login_attempts = $8,
 			last_login_at = $9,
 			password_changed_at = $10,
-			updated_at = $11
+			updated_at = $11,
+			lockout_count = $12,
+			locked_until = $13,
+			whatsapp_verified_at = $14,
+			email_verified_at = $15
 		WHERE id = $1
 	`
 
@@ -35,12 +39,16 @@ func (r *userRepository) Update(ctx context.Context, user *domain.User) error {
 		user.PasswordHash,
 		user.Email,
 		user.WhatsAppNumber,
-		user.OTPPIN,
+		user.PINHash,
 		user.IsActive,
 		user.FailedLoginAttempts,
 		user.LastLoginAt,
 		user.PasswordChangedAt,
 		user.UpdatedAt,
+		user.LockoutCount,
+		user.LockedUntil,
+		user.WhatsAppVerifiedAt,
+		user.EmailVerifiedAt,
 	)
 
 	return err
@@ -5,6 +5,13 @@ import (
 	"github/kijunpos/internal/domain"
 )
 
+// userColumns is the column list selected into domain.User
+const userColumns = `
+		id, username, password_hash, COALESCE(email, '') AS email, email_verified_at,
+		COALESCE(whatsapp_number, '') AS whatsapp_number, whatsapp_verified_at, COALESCE(pin, '') AS pin,
+		is_active, failed_login_attempts, lockout_count, locked_until,
+		created_at, last_login_at, password_changed_at, updated_at, deleted_at`
+
 type userRepository struct {
 	dbConn *db.Connection
 }
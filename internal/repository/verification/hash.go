@@ -0,0 +1,21 @@
+package verification
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"github/kijunpos/internal/domain"
+)
+
+// HashCode returns the keyed hash under which a verification code is stored.
+// Verification codes are short, so a plain hash could be reversed by trying
+// every code; keying it with a server secret prevents that for leaked rows.
+func HashCode(secret string, purpose domain.VerificationPurpose, identifier, code string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(purpose))
+	mac.Write([]byte{0})
+	mac.Write([]byte(identifier))
+	mac.Write([]byte{0})
+	mac.Write([]byte(code))
+	return hex.EncodeToString(mac.Sum(nil))
+}
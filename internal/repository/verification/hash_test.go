@@ -0,0 +1,39 @@
+package verification
+
+import (
+	"github/kijunpos/internal/domain"
+	"testing"
+)
+
+func TestHashCode(t *testing.T) {
+	base := HashCode("secret", domain.VerificationPurposeWhatsAppVerify, "6281234567890", "123456")
+	if base != HashCode("secret", domain.VerificationPurposeWhatsAppVerify, "6281234567890", "123456") {
+		t.Fatal("HashCode() is not deterministic")
+	}
+	if len(base) != 64 {
+		t.Errorf("HashCode() = %q, want a hex encoded SHA-256", base)
+	}
+
+	tests := []struct {
+		name       string
+		secret     string
+		purpose    domain.VerificationPurpose
+		identifier string
+		code       string
+	}{
+		{name: "other secret", secret: "other", purpose: domain.VerificationPurposeWhatsAppVerify, identifier: "6281234567890", code: "123456"},
+		{name: "other purpose", secret: "secret", purpose: domain.VerificationPurposePINReset, identifier: "6281234567890", code: "123456"},
+		{name: "other identifier", secret: "secret", purpose: domain.VerificationPurposeWhatsAppVerify, identifier: "6281234567891", code: "123456"},
+		{name: "other code", secret: "secret", purpose: domain.VerificationPurposeWhatsAppVerify, identifier: "6281234567890", code: "123457"},
+		{name: "digit moved from identifier to code", secret: "secret", purpose: domain.VerificationPurposeWhatsAppVerify, identifier: "628123456789", code: "0123456"},
+		{name: "no secret", secret: "", purpose: domain.VerificationPurposeWhatsAppVerify, identifier: "6281234567890", code: "123456"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HashCode(tt.secret, tt.purpose, tt.identifier, tt.code); got == base {
+				t.Errorf("HashCode() = %q, want a hash other than the base hash", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/repository/verification"
+	"time"
+)
+
+// ConsumeVerificationCode checks a verification code and consumes it on success.
+// Both outcomes are single conditional updates so a code can never be consumed
+// twice, even by concurrent requests, nor guessed beyond the attempt limit.
+func (r *verificationRepository) ConsumeVerificationCode(ctx context.Context, purpose domain.VerificationPurpose, identifier, code string) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.verification.postgres.ConsumeVerificationCode")
+	defer span.End()
+
+	consumeQuery := `
+		UPDATE verification_codes
+		SET consumed_at = NOW()
+		WHERE identifier = $1 AND purpose = $2 AND code_hash = $3
+			AND consumed_at IS NULL AND expires_at > NOW() AND attempts < $4
+	`
+
+	result, err := r.dbConn.DB.ExecContext(
+		ctx,
+		consumeQuery,
+		identifier,
+		purpose,
+		verification.HashCode(r.secret, purpose, identifier, code),
+		r.maxAttempts,
+	)
+	if err != nil {
+		return err
+	}
+
+	consumed, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if consumed > 0 {
+		return nil
+	}
+
+	// Wrong, expired or missing code, count the attempt against the pending code
+	// and invalidate the code once the attempt limit is reached
+	attemptQuery := `
+		UPDATE verification_codes
+		SET 
+			attempts = attempts + 1,
+			consumed_at = CASE WHEN attempts + 1 >= $3 THEN NOW() ELSE NULL END
+		WHERE identifier = $1 AND purpose = $2 AND consumed_at IS NULL
+		RETURNING attempts, expires_at
+	`
+
+	var attempts int
+	var expiresAt time.Time
+	err = r.dbConn.DB.QueryRowContext(ctx, attemptQuery, identifier, purpose, r.maxAttempts).Scan(&attempts, &expiresAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return domain.ErrVerificationCodeNotFound
+		}
+		return err
+	}
+
+	if time.Now().After(expiresAt) {
+		return domain.ErrVerificationCodeExpired
+	}
+	if attempts >= r.maxAttempts {
+		return domain.ErrVerificationCodeAttemptsExceeded
+	}
+	return domain.ErrVerificationCodeInvalid
+}
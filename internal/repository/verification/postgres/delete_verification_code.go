@@ -0,0 +1,21 @@
+package postgres
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// DeleteVerificationCode deletes the pending verification code for the identifier and purpose
+func (r *verificationRepository) DeleteVerificationCode(ctx context.Context, purpose domain.VerificationPurpose, identifier string) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.verification.postgres.DeleteVerificationCode")
+	defer span.End()
+
+	query := `
+		DELETE FROM verification_codes
+		WHERE identifier = $1 AND purpose = $2
+	`
+
+	_, err := r.dbConn.DB.ExecContext(ctx, query, identifier, purpose)
+	return err
+}
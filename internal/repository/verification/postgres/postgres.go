@@ -0,0 +1,22 @@
+package postgres
+
+import (
+	"github/kijunpos/config/db"
+	"github/kijunpos/internal/domain"
+)
+
+type verificationRepository struct {
+	dbConn      *db.Connection
+	secret      string
+	maxAttempts int
+}
+
+// NewVerificationRepository creates a new PostgreSQL backed verification repository.
+// A pending code is invalidated after maxAttempts wrong codes.
+func NewVerificationRepository(dbConn *db.Connection, secret string, maxAttempts int) domain.VerificationRepository {
+	return &verificationRepository{
+		dbConn:      dbConn,
+		secret:      secret,
+		maxAttempts: maxAttempts,
+	}
+}
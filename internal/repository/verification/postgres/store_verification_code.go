@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/repository/verification"
+	"time"
+)
+
+// StoreVerificationCode stores a verification code for the identifier and purpose,
+// replacing any code that is still pending
+func (r *verificationRepository) StoreVerificationCode(ctx context.Context, purpose domain.VerificationPurpose, identifier, code string, expiration time.Duration) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.verification.postgres.StoreVerificationCode")
+	defer span.End()
+
+	query := `
+		INSERT INTO verification_codes (
+			identifier, purpose, code_hash, attempts, expires_at, consumed_at, created_at
+		) VALUES (
+			$1, $2, $3, 0, $4, NULL, $5
+		)
+		ON CONFLICT (identifier, purpose) DO UPDATE
+		SET 
+			code_hash = EXCLUDED.code_hash,
+			attempts = 0,
+			expires_at = EXCLUDED.expires_at,
+			consumed_at = NULL,
+			created_at = EXCLUDED.created_at
+	`
+
+	now := time.Now()
+	_, err := r.dbConn.DB.ExecContext(
+		ctx,
+		query,
+		identifier,
+		purpose,
+		verification.HashCode(r.secret, purpose, identifier, code),
+		now.Add(expiration),
+		now,
+	)
+
+	return err
+}
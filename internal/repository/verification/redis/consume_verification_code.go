@@ -0,0 +1,61 @@
+package redis
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/repository/verification"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// consumeScript atomically compares the code hash and either deletes the code
+// (consumed) or increments its attempt counter, deleting the code once the
+// attempt limit in ARGV[2] is reached.
+// Returns 1 when consumed, 0 when no code is pending, -1 on a wrong code and
+// -2 on a wrong code that used up the last attempt.
+var consumeScript = goredis.NewScript(`
+	local stored = redis.call("HGET", KEYS[1], "code_hash")
+	if not stored then
+		return 0
+	end
+	if stored == ARGV[1] then
+		redis.call("DEL", KEYS[1])
+		return 1
+	end
+	local attempts = redis.call("HINCRBY", KEYS[1], "attempts", 1)
+	if attempts >= tonumber(ARGV[2]) then
+		redis.call("DEL", KEYS[1])
+		return -2
+	end
+	return -1
+`)
+
+// ConsumeVerificationCode checks a verification code and consumes it on success.
+// Expired codes are removed by Redis and reported as not found.
+func (r *verificationRepository) ConsumeVerificationCode(ctx context.Context, purpose domain.VerificationPurpose, identifier, code string) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.verification.redis.ConsumeVerificationCode")
+	defer span.End()
+
+	result, err := consumeScript.Run(
+		ctx,
+		r.client,
+		[]string{codeKey(purpose, identifier)},
+		verification.HashCode(r.secret, purpose, identifier, code),
+		r.maxAttempts,
+	).Int()
+	if err != nil {
+		return err
+	}
+
+	switch result {
+	case 1:
+		return nil
+	case 0:
+		return domain.ErrVerificationCodeNotFound
+	case -2:
+		return domain.ErrVerificationCodeAttemptsExceeded
+	default:
+		return domain.ErrVerificationCodeInvalid
+	}
+}
@@ -0,0 +1,15 @@
+package redis
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// DeleteVerificationCode deletes the pending verification code for the identifier and purpose
+func (r *verificationRepository) DeleteVerificationCode(ctx context.Context, purpose domain.VerificationPurpose, identifier string) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.verification.redis.DeleteVerificationCode")
+	defer span.End()
+
+	return r.client.Del(ctx, codeKey(purpose, identifier)).Err()
+}
@@ -0,0 +1,29 @@
+package redis
+
+import (
+	"fmt"
+	"github/kijunpos/internal/domain"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+type verificationRepository struct {
+	client      *goredis.Client
+	secret      string
+	maxAttempts int
+}
+
+// NewVerificationRepository creates a new Redis backed verification repository.
+// A pending code is invalidated after maxAttempts wrong codes.
+func NewVerificationRepository(client *goredis.Client, secret string, maxAttempts int) domain.VerificationRepository {
+	return &verificationRepository{
+		client:      client,
+		secret:      secret,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// codeKey returns the key of the hash holding a pending verification code
+func codeKey(purpose domain.VerificationPurpose, identifier string) string {
+	return fmt.Sprintf("verification:%s:%s", purpose, identifier)
+}
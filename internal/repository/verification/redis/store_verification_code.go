@@ -0,0 +1,31 @@
+package redis
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/repository/verification"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// StoreVerificationCode stores a verification code for the identifier and purpose,
+// replacing any code that is still pending. Redis expires the key with the code.
+func (r *verificationRepository) StoreVerificationCode(ctx context.Context, purpose domain.VerificationPurpose, identifier, code string, expiration time.Duration) error {
+	ctx, span := apm.GetTracer().Start(ctx, "repository.verification.redis.StoreVerificationCode")
+	defer span.End()
+
+	key := codeKey(purpose, identifier)
+	_, err := r.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.HSet(ctx, key,
+			"code_hash", verification.HashCode(r.secret, purpose, identifier, code),
+			"attempts", 0,
+		)
+		pipe.Expire(ctx, key, expiration)
+		return nil
+	})
+
+	return err
+}
@@ -0,0 +1,67 @@
+package catalog
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AddVariant adds a new variant to a product of the merchant of the tenant
+func (uc *catalogUseCase) AddVariant(ctx context.Context, variant *domain.ProductVariant) (*domain.Product, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.catalog.AddVariant")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionCatalogManage); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.getProduct(ctx, tenant.MerchantID, variant.ProductID); err != nil {
+		return nil, err
+	}
+	if err := uc.checkVariantCodes(ctx, tenant.MerchantID, uuid.Nil, variant.SKU, variant.Barcode); err != nil {
+		return nil, err
+	}
+
+	variant.ID = uuid.New()
+	variant.MerchantID = tenant.MerchantID
+	variant.IsActive = true
+	variant.CreatedAt = time.Now()
+	if err := uc.productRepo.AddVariant(ctx, variant); err != nil {
+		return nil, err
+	}
+
+	return uc.getProduct(ctx, tenant.MerchantID, variant.ProductID)
+}
+
+// checkVariantCodes checks that no other variant of the merchant uses the SKU
+// or the barcode, variantID is the variant being updated, if any
+func (uc *catalogUseCase) checkVariantCodes(ctx context.Context, merchantID, variantID uuid.UUID, sku, barcode string) error {
+	existing, err := uc.productRepo.FindVariantByCode(ctx, merchantID, sku, "")
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != variantID {
+		return appErrors.NewDuplicateError("variant with this SKU already exists", nil)
+	}
+
+	if barcode == "" {
+		return nil
+	}
+	existing, err = uc.productRepo.FindVariantByCode(ctx, merchantID, "", barcode)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != variantID {
+		return appErrors.NewDuplicateError("variant with this barcode already exists", nil)
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package catalog
+
+import (
+	"github/kijunpos/internal/domain"
+)
+
+type catalogUseCase struct {
+	categoryRepo         domain.CategoryRepository
+	productRepo          domain.ProductRepository
+	outletRepo           domain.OutletRepository
+	authorizationService domain.AuthorizationService
+}
+
+// NewCatalogUseCase creates a new catalog use case
+func NewCatalogUseCase(
+	categoryRepo domain.CategoryRepository,
+	productRepo domain.ProductRepository,
+	outletRepo domain.OutletRepository,
+	authorizationService domain.AuthorizationService,
+) domain.CatalogUseCase {
+	return &catalogUseCase{
+		categoryRepo:         categoryRepo,
+		productRepo:          productRepo,
+		outletRepo:           outletRepo,
+		authorizationService: authorizationService,
+	}
+}
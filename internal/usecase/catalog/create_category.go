@@ -0,0 +1,54 @@
+package catalog
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateCategory adds a new category to the merchant of the tenant
+func (uc *catalogUseCase) CreateCategory(ctx context.Context, category *domain.Category) (*domain.Category, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.catalog.CreateCategory")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionCatalogManage); err != nil {
+		return nil, err
+	}
+	if err := uc.checkCategory(ctx, tenant.MerchantID, "parent_id", category.ParentID); err != nil {
+		return nil, err
+	}
+
+	category.ID = uuid.New()
+	category.MerchantID = tenant.MerchantID
+	category.CreatedAt = time.Now()
+	if err := uc.categoryRepo.Create(ctx, category); err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// checkCategory checks that a referenced category belongs to the merchant
+func (uc *catalogUseCase) checkCategory(ctx context.Context, merchantID uuid.UUID, field string, categoryID uuid.NullUUID) error {
+	if !categoryID.Valid {
+		return nil
+	}
+
+	category, err := uc.categoryRepo.GetByID(ctx, merchantID, categoryID.UUID)
+	if err != nil {
+		return err
+	}
+	if category == nil {
+		return appErrors.NewFieldValidationError(field, "category not found")
+	}
+	return nil
+}
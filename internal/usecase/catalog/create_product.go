@@ -0,0 +1,71 @@
+package catalog
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateProduct adds a new product with its variants to the merchant of the tenant
+func (uc *catalogUseCase) CreateProduct(ctx context.Context, product *domain.Product) (*domain.Product, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.catalog.CreateProduct")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionCatalogManage); err != nil {
+		return nil, err
+	}
+	if len(product.Variants) == 0 {
+		return nil, appErrors.NewFieldValidationError("variants", "a product needs at least one variant")
+	}
+	if err := uc.checkCategory(ctx, tenant.MerchantID, "category_id", product.CategoryID); err != nil {
+		return nil, err
+	}
+
+	// Codes must be unique within the request as well as within the merchant
+	skus := make(map[string]bool, len(product.Variants))
+	barcodes := make(map[string]bool, len(product.Variants))
+	for _, variant := range product.Variants {
+		if skus[variant.SKU] {
+			return nil, appErrors.NewDuplicateError("SKU "+variant.SKU+" is used by more than one variant", nil)
+		}
+		skus[variant.SKU] = true
+		if variant.Barcode != "" {
+			if barcodes[variant.Barcode] {
+				return nil, appErrors.NewDuplicateError("barcode "+variant.Barcode+" is used by more than one variant", nil)
+			}
+			barcodes[variant.Barcode] = true
+		}
+		if err := uc.checkVariantCodes(ctx, tenant.MerchantID, uuid.Nil, variant.SKU, variant.Barcode); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	product.ID = uuid.New()
+	product.MerchantID = tenant.MerchantID
+	product.IsActive = true
+	product.CreatedAt = now
+	for _, variant := range product.Variants {
+		variant.ID = uuid.New()
+		variant.ProductID = product.ID
+		variant.MerchantID = tenant.MerchantID
+		variant.IsActive = true
+		variant.CreatedAt = now
+		variant.OutletPrices = []*domain.OutletPrice{}
+	}
+
+	if err := uc.productRepo.Create(ctx, product); err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
@@ -0,0 +1,35 @@
+package catalog
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// DeleteCategory removes a category of the merchant of the tenant
+func (uc *catalogUseCase) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.catalog.DeleteCategory")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionCatalogManage); err != nil {
+		return err
+	}
+
+	category, err := uc.categoryRepo.GetByID(ctx, tenant.MerchantID, id)
+	if err != nil {
+		return err
+	}
+	if category == nil {
+		return appErrors.NewNotFoundError("category not found", nil)
+	}
+
+	return uc.categoryRepo.Delete(ctx, tenant.MerchantID, id)
+}
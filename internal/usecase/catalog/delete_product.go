@@ -0,0 +1,30 @@
+package catalog
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// DeleteProduct removes a product and its variants from the catalog
+func (uc *catalogUseCase) DeleteProduct(ctx context.Context, id uuid.UUID) error {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.catalog.DeleteProduct")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionCatalogManage); err != nil {
+		return err
+	}
+
+	if _, err := uc.getProduct(ctx, tenant.MerchantID, id); err != nil {
+		return err
+	}
+
+	return uc.productRepo.Delete(ctx, tenant.MerchantID, id)
+}
@@ -0,0 +1,44 @@
+package catalog
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// DeleteVariant removes a variant, the last variant of a product is only
+// removed together with the product
+func (uc *catalogUseCase) DeleteVariant(ctx context.Context, id uuid.UUID) error {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.catalog.DeleteVariant")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionCatalogManage); err != nil {
+		return err
+	}
+
+	variant, err := uc.productRepo.GetVariant(ctx, tenant.MerchantID, id)
+	if err != nil {
+		return err
+	}
+	if variant == nil {
+		return appErrors.NewNotFoundError("variant not found", nil)
+	}
+
+	product, err := uc.getProduct(ctx, tenant.MerchantID, variant.ProductID)
+	if err != nil {
+		return err
+	}
+	if len(product.Variants) <= 1 {
+		return appErrors.NewBadRequestError("a product needs at least one variant, delete the product instead", nil)
+	}
+
+	return uc.productRepo.DeleteVariant(ctx, tenant.MerchantID, id)
+}
@@ -0,0 +1,39 @@
+package catalog
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// GetProduct returns a product of the merchant of the tenant
+func (uc *catalogUseCase) GetProduct(ctx context.Context, id uuid.UUID) (*domain.Product, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.catalog.GetProduct")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionCatalogRead); err != nil {
+		return nil, err
+	}
+
+	return uc.getProduct(ctx, tenant.MerchantID, id)
+}
+
+// getProduct returns a product of the merchant or a not found error
+func (uc *catalogUseCase) getProduct(ctx context.Context, merchantID, id uuid.UUID) (*domain.Product, error) {
+	product, err := uc.productRepo.GetByID(ctx, merchantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if product == nil {
+		return nil, appErrors.NewNotFoundError("product not found", nil)
+	}
+	return product, nil
+}
@@ -0,0 +1,33 @@
+package catalog
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+)
+
+// GetProductByBarcode looks up the product of a scanned barcode
+func (uc *catalogUseCase) GetProductByBarcode(ctx context.Context, barcode string) (*domain.Product, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.catalog.GetProductByBarcode")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionCatalogRead); err != nil {
+		return nil, err
+	}
+
+	product, err := uc.productRepo.GetByBarcode(ctx, tenant.MerchantID, barcode)
+	if err != nil {
+		return nil, err
+	}
+	if product == nil {
+		return nil, appErrors.NewNotFoundError("no product has this barcode", nil)
+	}
+
+	return product, nil
+}
@@ -0,0 +1,24 @@
+package catalog
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/tenancy"
+)
+
+// ListCategories lists the categories of the merchant of the tenant
+func (uc *catalogUseCase) ListCategories(ctx context.Context) ([]*domain.Category, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.catalog.ListCategories")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionCatalogRead); err != nil {
+		return nil, err
+	}
+
+	return uc.categoryRepo.List(ctx, tenant.MerchantID)
+}
@@ -0,0 +1,52 @@
+package catalog
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/pagination"
+	"github/kijunpos/internal/pkg/tenancy"
+)
+
+// SearchProducts lists the products of the merchant of the tenant matching
+// the filter, newest first, one page at a time
+func (uc *catalogUseCase) SearchProducts(ctx context.Context, filter domain.ProductFilter, pageSize int, cursor string) (*domain.ProductPage, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.catalog.SearchProducts")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionCatalogRead); err != nil {
+		return nil, err
+	}
+
+	// Inactive products are only listed to those managing the catalog
+	if filter.IncludeInactive {
+		if err := uc.authorizationService.Authorize(ctx, domain.PermissionCatalogManage); err != nil {
+			return nil, err
+		}
+	}
+
+	pageSize = pagination.PageSize(pageSize)
+	after, err := pagination.DecodeCursor(cursor)
+	if err != nil {
+		return nil, appErrors.NewFieldValidationError("cursor", "invalid cursor")
+	}
+
+	products, err := uc.productRepo.Search(ctx, tenant.MerchantID, filter, after, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.ProductPage{Products: products}
+	if len(products) > pageSize {
+		page.Products = products[:pageSize]
+		last := page.Products[pageSize-1]
+		page.NextCursor = pagination.EncodeCursor(domain.PageCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+
+	return page, nil
+}
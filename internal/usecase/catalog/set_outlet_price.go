@@ -0,0 +1,51 @@
+package catalog
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// SetOutletPrice sets or removes the price of a variant at an outlet of the
+// merchant of the tenant
+func (uc *catalogUseCase) SetOutletPrice(ctx context.Context, variantID, outletID uuid.UUID, price *int64) error {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.catalog.SetOutletPrice")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionCatalogManage); err != nil {
+		return err
+	}
+
+	variant, err := uc.productRepo.GetVariant(ctx, tenant.MerchantID, variantID)
+	if err != nil {
+		return err
+	}
+	if variant == nil {
+		return appErrors.NewNotFoundError("variant not found", nil)
+	}
+
+	outlet, err := uc.outletRepo.GetByID(ctx, tenant.MerchantID, outletID)
+	if err != nil {
+		return err
+	}
+	if outlet == nil {
+		return appErrors.NewNotFoundError("outlet not found", nil)
+	}
+
+	if price == nil {
+		return uc.productRepo.DeleteOutletPrice(ctx, variantID, outletID)
+	}
+	return uc.productRepo.SetOutletPrice(ctx, &domain.OutletPrice{
+		VariantID: variantID,
+		OutletID:  outletID,
+		Price:     *price,
+	})
+}
@@ -0,0 +1,58 @@
+package catalog
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+)
+
+// UpdateCategory renames or moves a category of the merchant of the tenant
+func (uc *catalogUseCase) UpdateCategory(ctx context.Context, category *domain.Category) (*domain.Category, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.catalog.UpdateCategory")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionCatalogManage); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.categoryRepo.GetByID(ctx, tenant.MerchantID, category.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, appErrors.NewNotFoundError("category not found", nil)
+	}
+
+	// Walk up from the new parent, meeting the category itself means a cycle
+	parentID := category.ParentID
+	for parentID.Valid {
+		if parentID.UUID == category.ID {
+			return nil, appErrors.NewFieldValidationError("parent_id", "a category cannot be moved below itself")
+		}
+		parent, err := uc.categoryRepo.GetByID(ctx, tenant.MerchantID, parentID.UUID)
+		if err != nil {
+			return nil, err
+		}
+		if parent == nil {
+			return nil, appErrors.NewFieldValidationError("parent_id", "category not found")
+		}
+		parentID = parent.ParentID
+	}
+
+	existing.Name = category.Name
+	existing.ParentID = category.ParentID
+	existing.UpdatedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	if err := uc.categoryRepo.Update(ctx, existing); err != nil {
+		return nil, err
+	}
+
+	return existing, nil
+}
@@ -0,0 +1,46 @@
+package catalog
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+)
+
+// UpdateProduct updates the details of a product, variants are updated separately
+func (uc *catalogUseCase) UpdateProduct(ctx context.Context, product *domain.Product) (*domain.Product, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.catalog.UpdateProduct")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionCatalogManage); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.getProduct(ctx, tenant.MerchantID, product.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkCategory(ctx, tenant.MerchantID, "category_id", product.CategoryID); err != nil {
+		return nil, err
+	}
+
+	existing.CategoryID = product.CategoryID
+	existing.Name = product.Name
+	existing.Description = product.Description
+	existing.Unit = product.Unit
+	existing.BasePrice = product.BasePrice
+	existing.IsActive = product.IsActive
+	existing.TaxExempt = product.TaxExempt
+	existing.UpdatedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	if err := uc.productRepo.Update(ctx, existing); err != nil {
+		return nil, err
+	}
+
+	return existing, nil
+}
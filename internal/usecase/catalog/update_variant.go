@@ -0,0 +1,49 @@
+package catalog
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+)
+
+// UpdateVariant updates a variant of a product of the merchant of the tenant
+func (uc *catalogUseCase) UpdateVariant(ctx context.Context, variant *domain.ProductVariant) (*domain.Product, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.catalog.UpdateVariant")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionCatalogManage); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.productRepo.GetVariant(ctx, tenant.MerchantID, variant.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, appErrors.NewNotFoundError("variant not found", nil)
+	}
+	if err := uc.checkVariantCodes(ctx, tenant.MerchantID, existing.ID, variant.SKU, variant.Barcode); err != nil {
+		return nil, err
+	}
+
+	existing.SKU = variant.SKU
+	existing.Barcode = variant.Barcode
+	existing.Name = variant.Name
+	existing.Attributes = variant.Attributes
+	existing.Price = variant.Price
+	existing.IsActive = variant.IsActive
+	existing.UpdatedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	if err := uc.productRepo.UpdateVariant(ctx, existing); err != nil {
+		return nil, err
+	}
+
+	return uc.getProduct(ctx, tenant.MerchantID, existing.ProductID)
+}
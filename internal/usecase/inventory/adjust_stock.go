@@ -0,0 +1,101 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AdjustStock corrects the stock of a variant at the outlet of the tenant
+func (uc *inventoryUseCase) AdjustStock(ctx context.Context, variantID uuid.UUID, quantity int64, note string) (*domain.StockMovement, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.inventory.AdjustStock")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionInventoryManage); err != nil {
+		return nil, err
+	}
+	if quantity == 0 {
+		return nil, appErrors.NewFieldValidationError("quantity", "quantity must not be zero")
+	}
+	if err := uc.checkVariants(ctx, tenant.MerchantID, []*domain.StockItem{{VariantID: variantID, Quantity: quantity}}); err != nil {
+		return nil, err
+	}
+
+	movement := newMovement(ctx, tenant, tenant.OutletID, variantID, domain.StockMovementAdjustment, quantity, note)
+	if err := uc.record(ctx, []*domain.StockMovement{movement}); err != nil {
+		return nil, err
+	}
+
+	return movement, nil
+}
+
+// checkVariants checks that every item refers to a different variant of the merchant
+func (uc *inventoryUseCase) checkVariants(ctx context.Context, merchantID uuid.UUID, items []*domain.StockItem) error {
+	if len(items) == 0 {
+		return appErrors.NewFieldValidationError("items", "at least one item is required")
+	}
+
+	seen := make(map[uuid.UUID]bool, len(items))
+	for _, item := range items {
+		if seen[item.VariantID] {
+			return appErrors.NewFieldValidationError("items", fmt.Sprintf("variant %s is listed more than once", item.VariantID))
+		}
+		seen[item.VariantID] = true
+
+		variant, err := uc.productRepo.GetVariant(ctx, merchantID, item.VariantID)
+		if err != nil {
+			return err
+		}
+		if variant == nil {
+			return appErrors.NewNotFoundError(fmt.Sprintf("variant %s not found", item.VariantID), nil)
+		}
+	}
+	return nil
+}
+
+// newMovement creates a movement made by the authenticated caller
+func newMovement(ctx context.Context, tenant *domain.Tenant, outletID, variantID uuid.UUID, movementType domain.StockMovementType, quantity int64, note string) *domain.StockMovement {
+	movement := &domain.StockMovement{
+		ID:         uuid.New(),
+		MerchantID: tenant.MerchantID,
+		OutletID:   outletID,
+		VariantID:  variantID,
+		Type:       movementType,
+		Quantity:   quantity,
+		Note:       note,
+		CreatedAt:  time.Now(),
+	}
+	if principal, ok := domain.PrincipalFromContext(ctx); ok {
+		movement.CreatedBy = uuid.NullUUID{UUID: principal.UserID, Valid: true}
+	}
+	return movement
+}
+
+// record records the movements, reporting stock that would go negative to the client
+func (uc *inventoryUseCase) record(ctx context.Context, movements []*domain.StockMovement) error {
+	err := uc.inventoryRepo.RecordMovements(ctx, movements)
+	return insufficientStock(err)
+}
+
+// insufficientStock converts a *domain.InsufficientStockError into an AppError
+func insufficientStock(err error) error {
+	var stockErr *domain.InsufficientStockError
+	if errors.As(err, &stockErr) {
+		return appErrors.NewInsufficientStockError(
+			fmt.Sprintf("insufficient stock of variant %s, %d on hand", stockErr.VariantID, stockErr.OnHand),
+			err,
+		)
+	}
+	return err
+}
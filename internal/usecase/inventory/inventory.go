@@ -0,0 +1,27 @@
+package inventory
+
+import (
+	"github/kijunpos/internal/domain"
+)
+
+type inventoryUseCase struct {
+	inventoryRepo        domain.InventoryRepository
+	productRepo          domain.ProductRepository
+	outletRepo           domain.OutletRepository
+	authorizationService domain.AuthorizationService
+}
+
+// NewInventoryUseCase creates a new inventory use case
+func NewInventoryUseCase(
+	inventoryRepo domain.InventoryRepository,
+	productRepo domain.ProductRepository,
+	outletRepo domain.OutletRepository,
+	authorizationService domain.AuthorizationService,
+) domain.InventoryUseCase {
+	return &inventoryUseCase{
+		inventoryRepo:        inventoryRepo,
+		productRepo:          productRepo,
+		outletRepo:           outletRepo,
+		authorizationService: authorizationService,
+	}
+}
@@ -0,0 +1,47 @@
+package inventory
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/pagination"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// ListMovements lists the stock ledger of the outlet of the tenant, newest
+// first, one page at a time
+func (uc *inventoryUseCase) ListMovements(ctx context.Context, variantID uuid.UUID, pageSize int, cursor string) (*domain.StockMovementPage, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.inventory.ListMovements")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionInventoryRead); err != nil {
+		return nil, err
+	}
+
+	pageSize = pagination.PageSize(pageSize)
+	after, err := pagination.DecodeCursor(cursor)
+	if err != nil {
+		return nil, appErrors.NewFieldValidationError("cursor", "invalid cursor")
+	}
+
+	movements, err := uc.inventoryRepo.ListMovements(ctx, tenant.MerchantID, tenant.OutletID, variantID, after, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.StockMovementPage{Movements: movements}
+	if len(movements) > pageSize {
+		page.Movements = movements[:pageSize]
+		last := page.Movements[pageSize-1]
+		page.NextCursor = pagination.EncodeCursor(domain.PageCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+
+	return page, nil
+}
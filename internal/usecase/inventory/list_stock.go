@@ -0,0 +1,24 @@
+package inventory
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/tenancy"
+)
+
+// ListStock lists the stock at the outlet of the tenant
+func (uc *inventoryUseCase) ListStock(ctx context.Context, filter domain.StockLevelFilter) ([]*domain.StockLevel, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.inventory.ListStock")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionInventoryRead); err != nil {
+		return nil, err
+	}
+
+	return uc.inventoryRepo.ListLevels(ctx, tenant.MerchantID, tenant.OutletID, filter)
+}
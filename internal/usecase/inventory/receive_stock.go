@@ -0,0 +1,41 @@
+package inventory
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+)
+
+// ReceiveStock adds stock received from a supplier to the outlet of the tenant
+func (uc *inventoryUseCase) ReceiveStock(ctx context.Context, items []*domain.StockItem, note string) ([]*domain.StockMovement, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.inventory.ReceiveStock")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionInventoryManage); err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return nil, appErrors.NewFieldValidationError("items", "received quantities must be positive")
+		}
+	}
+	if err := uc.checkVariants(ctx, tenant.MerchantID, items); err != nil {
+		return nil, err
+	}
+
+	movements := make([]*domain.StockMovement, 0, len(items))
+	for _, item := range items {
+		movements = append(movements, newMovement(ctx, tenant, tenant.OutletID, item.VariantID, domain.StockMovementPurchaseReceipt, item.Quantity, note))
+	}
+	if err := uc.record(ctx, movements); err != nil {
+		return nil, err
+	}
+
+	return movements, nil
+}
@@ -0,0 +1,34 @@
+package inventory
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// SetLowStockThreshold sets or removes the low stock threshold of a variant
+// at the outlet of the tenant
+func (uc *inventoryUseCase) SetLowStockThreshold(ctx context.Context, variantID uuid.UUID, threshold *int64) error {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.inventory.SetLowStockThreshold")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionInventoryManage); err != nil {
+		return err
+	}
+	if threshold != nil && *threshold < 0 {
+		return appErrors.NewFieldValidationError("threshold", "threshold must not be negative")
+	}
+	if err := uc.checkVariants(ctx, tenant.MerchantID, []*domain.StockItem{{VariantID: variantID}}); err != nil {
+		return err
+	}
+
+	return uc.inventoryRepo.SetLowStockThreshold(ctx, tenant.MerchantID, tenant.OutletID, variantID, threshold)
+}
@@ -0,0 +1,44 @@
+package inventory
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+)
+
+// StockTake records the quantities counted on the shelves of the outlet of
+// the tenant, the ledger gets the difference with the recorded stock
+func (uc *inventoryUseCase) StockTake(ctx context.Context, items []*domain.StockItem, note string) ([]*domain.StockMovement, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.inventory.StockTake")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionInventoryManage); err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if item.Quantity < 0 {
+			return nil, appErrors.NewFieldValidationError("items", "counted quantities must not be negative")
+		}
+	}
+	if err := uc.checkVariants(ctx, tenant.MerchantID, items); err != nil {
+		return nil, err
+	}
+
+	movements := make([]*domain.StockMovement, 0, len(items))
+	for _, item := range items {
+		movement := newMovement(ctx, tenant, tenant.OutletID, item.VariantID, domain.StockMovementStockTake, 0, note)
+		movement.BalanceAfter = item.Quantity
+		movements = append(movements, movement)
+	}
+	if err := uc.record(ctx, movements); err != nil {
+		return nil, err
+	}
+
+	return movements, nil
+}
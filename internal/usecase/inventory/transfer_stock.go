@@ -0,0 +1,78 @@
+package inventory
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TransferStock moves stock from the outlet of the tenant to another outlet
+// of the merchant
+func (uc *inventoryUseCase) TransferStock(ctx context.Context, toOutletID uuid.UUID, items []*domain.StockItem, note string) (*domain.StockTransfer, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.inventory.TransferStock")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionInventoryManage); err != nil {
+		return nil, err
+	}
+	if toOutletID == tenant.OutletID {
+		return nil, appErrors.NewFieldValidationError("to_outlet_id", "stock cannot be transferred to the same outlet")
+	}
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return nil, appErrors.NewFieldValidationError("items", "transferred quantities must be positive")
+		}
+	}
+
+	toOutlet, err := uc.outletRepo.GetByID(ctx, tenant.MerchantID, toOutletID)
+	if err != nil {
+		return nil, err
+	}
+	if toOutlet == nil {
+		return nil, appErrors.NewNotFoundError("destination outlet not found", nil)
+	}
+	if !toOutlet.IsActive {
+		return nil, appErrors.NewBadRequestError("destination outlet is not active", nil)
+	}
+	if err := uc.checkVariants(ctx, tenant.MerchantID, items); err != nil {
+		return nil, err
+	}
+
+	transfer := &domain.StockTransfer{
+		ID:           uuid.New(),
+		MerchantID:   tenant.MerchantID,
+		FromOutletID: tenant.OutletID,
+		ToOutletID:   toOutletID,
+		Note:         note,
+		CreatedAt:    time.Now(),
+		Items:        items,
+	}
+	if principal, ok := domain.PrincipalFromContext(ctx); ok {
+		transfer.CreatedBy = uuid.NullUUID{UUID: principal.UserID, Valid: true}
+	}
+
+	reference := uuid.NullUUID{UUID: transfer.ID, Valid: true}
+	movements := make([]*domain.StockMovement, 0, 2*len(items))
+	for _, item := range items {
+		out := newMovement(ctx, tenant, tenant.OutletID, item.VariantID, domain.StockMovementTransferOut, -item.Quantity, note)
+		in := newMovement(ctx, tenant, toOutletID, item.VariantID, domain.StockMovementTransferIn, item.Quantity, note)
+		out.ReferenceID = reference
+		in.ReferenceID = reference
+		movements = append(movements, out, in)
+	}
+
+	if err := uc.inventoryRepo.CreateTransfer(ctx, transfer, movements); err != nil {
+		return nil, insufficientStock(err)
+	}
+
+	return transfer, nil
+}
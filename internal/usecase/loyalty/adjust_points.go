@@ -0,0 +1,70 @@
+package loyalty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AdjustPoints corrects the points balance of a customer of the merchant of
+// the tenant by hand. Points added expire like earned points.
+func (uc *loyaltyUseCase) AdjustPoints(ctx context.Context, customerID uuid.UUID, points int64, note string) (*domain.Customer, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.loyalty.AdjustPoints")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionLoyaltyManage); err != nil {
+		return nil, err
+	}
+	if points == 0 {
+		return nil, appErrors.NewFieldValidationError("points", "points must not be zero")
+	}
+	if note == "" {
+		return nil, appErrors.NewFieldValidationError("note", "note is required")
+	}
+
+	customer, err := uc.getCustomer(ctx, tenant, customerID)
+	if err != nil {
+		return nil, err
+	}
+	program, err := uc.getProgram(ctx, tenant.MerchantID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	entry := &domain.PointEntry{
+		ID:         uuid.New(),
+		MerchantID: tenant.MerchantID,
+		CustomerID: customer.ID,
+		Type:       domain.PointEntryAdjust,
+		Points:     points,
+		Note:       note,
+		CreatedAt:  now,
+	}
+	if points > 0 {
+		entry.ExpiresAt = program.ExpiresAt(now)
+	}
+	if principal, ok := domain.PrincipalFromContext(ctx); ok {
+		entry.CreatedBy = uuid.NullUUID{UUID: principal.UserID, Valid: true}
+	}
+	if err := uc.loyaltyRepo.AdjustPoints(ctx, entry); err != nil {
+		if errors.Is(err, domain.ErrInsufficientPoints) {
+			return nil, appErrors.NewFieldValidationError("points", fmt.Sprintf("customer has %d points", customer.PointsBalance))
+		}
+		return nil, err
+	}
+
+	customer.PointsBalance = entry.BalanceAfter
+	return customer, nil
+}
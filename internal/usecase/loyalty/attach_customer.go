@@ -0,0 +1,47 @@
+package loyalty
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// AttachCustomer sets the customer of a cart of the outlet of the tenant by
+// their WhatsApp number, an empty number detaches the customer. Points
+// redeemed for the cart are given up.
+func (uc *loyaltyUseCase) AttachCustomer(ctx context.Context, orderID uuid.UUID, whatsAppNumber string) (*domain.Order, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.loyalty.AttachCustomer")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderCreate); err != nil {
+		return nil, err
+	}
+
+	order, err := uc.getOpenOrder(ctx, tenant, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	order.CustomerID = uuid.NullUUID{}
+	if whatsAppNumber != "" {
+		customer, err := uc.loyaltyRepo.GetCustomerByWhatsApp(ctx, tenant.MerchantID, whatsAppNumber)
+		if err != nil {
+			return nil, err
+		}
+		if customer == nil {
+			return nil, appErrors.NewNotFoundError("customer not found, register the customer first", nil)
+		}
+		order.CustomerID = uuid.NullUUID{UUID: customer.ID, Valid: true}
+	}
+	order.PointsRedeemed, order.PointsDiscount = 0, 0
+
+	return uc.saveOrder(ctx, order)
+}
@@ -0,0 +1,50 @@
+package loyalty
+
+import (
+	"context"
+	"errors"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateCustomer registers a customer of the merchant of the tenant, linked
+// to the user registered with the same verified WhatsApp number
+func (uc *loyaltyUseCase) CreateCustomer(ctx context.Context, customer *domain.Customer) (*domain.Customer, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.loyalty.CreateCustomer")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionCustomerManage); err != nil {
+		return nil, err
+	}
+	if err := validateCustomer(customer); err != nil {
+		return nil, err
+	}
+
+	if customer.UserID, err = uc.linkedUser(ctx, customer.WhatsAppNumber); err != nil {
+		return nil, err
+	}
+	customer.ID = uuid.New()
+	customer.MerchantID = tenant.MerchantID
+	customer.PointsBalance = 0
+	customer.CreatedAt = time.Now()
+	if err := uc.loyaltyRepo.CreateCustomer(ctx, customer); err != nil {
+		if errors.Is(err, domain.ErrCustomerExists) {
+			return nil, appErrors.NewDuplicateError("a customer with this WhatsApp number already exists", err)
+		}
+		return nil, err
+	}
+
+	if err := uc.withTiers(ctx, customer); err != nil {
+		return nil, err
+	}
+	return customer, nil
+}
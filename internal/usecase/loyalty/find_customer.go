@@ -0,0 +1,34 @@
+package loyalty
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+)
+
+// FindCustomer returns the customer of the merchant of the tenant with a
+// WhatsApp number, as the cashier looks them up at the till
+func (uc *loyaltyUseCase) FindCustomer(ctx context.Context, whatsAppNumber string) (*domain.Customer, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.loyalty.FindCustomer")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionCustomerManage); err != nil {
+		return nil, err
+	}
+
+	customer, err := uc.loyaltyRepo.GetCustomerByWhatsApp(ctx, tenant.MerchantID, whatsAppNumber)
+	if err != nil {
+		return nil, err
+	}
+	if customer == nil {
+		return nil, appErrors.NewNotFoundError("customer not found", nil)
+	}
+
+	return uc.getCustomer(ctx, tenant, customer.ID)
+}
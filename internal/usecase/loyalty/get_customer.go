@@ -0,0 +1,26 @@
+package loyalty
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// GetCustomer returns a customer of the merchant of the tenant
+func (uc *loyaltyUseCase) GetCustomer(ctx context.Context, id uuid.UUID) (*domain.Customer, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.loyalty.GetCustomer")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionCustomerManage); err != nil {
+		return nil, err
+	}
+
+	return uc.getCustomer(ctx, tenant, id)
+}
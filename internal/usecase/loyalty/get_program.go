@@ -0,0 +1,24 @@
+package loyalty
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/tenancy"
+)
+
+// GetProgram returns the loyalty program of the merchant of the tenant
+func (uc *loyaltyUseCase) GetProgram(ctx context.Context) (*domain.LoyaltyProgram, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.loyalty.GetProgram")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionCustomerManage); err != nil {
+		return nil, err
+	}
+
+	return uc.getProgram(ctx, tenant.MerchantID)
+}
@@ -0,0 +1,49 @@
+package loyalty
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/pagination"
+	"github/kijunpos/internal/pkg/tenancy"
+)
+
+// ListCustomers lists the customers of the merchant of the tenant, newest
+// first, one page at a time. Balances include points due to expire until the
+// customer is read on their own.
+func (uc *loyaltyUseCase) ListCustomers(ctx context.Context, search string, pageSize int, cursor string) (*domain.CustomerPage, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.loyalty.ListCustomers")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionCustomerManage); err != nil {
+		return nil, err
+	}
+
+	pageSize = pagination.PageSize(pageSize)
+	after, err := pagination.DecodeCursor(cursor)
+	if err != nil {
+		return nil, appErrors.NewFieldValidationError("cursor", "invalid cursor")
+	}
+
+	customers, err := uc.loyaltyRepo.ListCustomers(ctx, tenant.MerchantID, search, after, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.CustomerPage{Customers: customers}
+	if len(customers) > pageSize {
+		page.Customers = customers[:pageSize]
+		last := page.Customers[pageSize-1]
+		page.NextCursor = pagination.EncodeCursor(domain.PageCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+	if err := uc.withTiers(ctx, page.Customers...); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
@@ -0,0 +1,54 @@
+package loyalty
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"time"
+)
+
+// ListMemberships lists the customers linked to the caller at every merchant.
+// Customers registered before the caller verified their WhatsApp number are
+// linked first.
+func (uc *loyaltyUseCase) ListMemberships(ctx context.Context) ([]*domain.Customer, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.loyalty.ListMemberships")
+	defer span.End()
+
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, appErrors.NewUnauthorizedError("unauthenticated", nil)
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, appErrors.NewNotFoundError("user not found", nil)
+	}
+	if user.WhatsAppNumber != "" && user.WhatsAppVerifiedAt.Valid {
+		if err := uc.loyaltyRepo.LinkUser(ctx, user.ID, user.WhatsAppNumber); err != nil {
+			return nil, err
+		}
+	}
+
+	customers, err := uc.loyaltyRepo.ListCustomersByUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	// Balances are read again once the points due have expired
+	now := time.Now()
+	for _, customer := range customers {
+		if err := uc.loyaltyRepo.ExpirePoints(ctx, customer.MerchantID, customer.ID, now); err != nil {
+			return nil, err
+		}
+	}
+	if customers, err = uc.loyaltyRepo.ListCustomersByUser(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	if err := uc.withTiers(ctx, customers...); err != nil {
+		return nil, err
+	}
+	return customers, nil
+}
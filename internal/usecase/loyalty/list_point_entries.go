@@ -0,0 +1,51 @@
+package loyalty
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/pagination"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// ListPointEntries lists the points ledger of a customer of the merchant of
+// the tenant, newest first, one page at a time
+func (uc *loyaltyUseCase) ListPointEntries(ctx context.Context, customerID uuid.UUID, pageSize int, cursor string) (*domain.PointEntryPage, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.loyalty.ListPointEntries")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionCustomerManage); err != nil {
+		return nil, err
+	}
+
+	pageSize = pagination.PageSize(pageSize)
+	after, err := pagination.DecodeCursor(cursor)
+	if err != nil {
+		return nil, appErrors.NewFieldValidationError("cursor", "invalid cursor")
+	}
+
+	// Points due are expired first so that the ledger shows their expiry
+	if _, err := uc.getCustomer(ctx, tenant, customerID); err != nil {
+		return nil, err
+	}
+	entries, err := uc.loyaltyRepo.ListPointEntries(ctx, tenant.MerchantID, customerID, after, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.PointEntryPage{Entries: entries}
+	if len(entries) > pageSize {
+		page.Entries = entries[:pageSize]
+		last := page.Entries[pageSize-1]
+		page.NextCursor = pagination.EncodeCursor(domain.PageCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+
+	return page, nil
+}
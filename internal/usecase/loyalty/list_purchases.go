@@ -0,0 +1,54 @@
+package loyalty
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/pagination"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// ListPurchases lists the completed and voided sales of a customer of the
+// merchant of the tenant at every outlet, newest first, one page at a time
+func (uc *loyaltyUseCase) ListPurchases(ctx context.Context, customerID uuid.UUID, pageSize int, cursor string) (*domain.OrderPage, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.loyalty.ListPurchases")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionCustomerManage); err != nil {
+		return nil, err
+	}
+
+	pageSize = pagination.PageSize(pageSize)
+	after, err := pagination.DecodeCursor(cursor)
+	if err != nil {
+		return nil, appErrors.NewFieldValidationError("cursor", "invalid cursor")
+	}
+
+	customer, err := uc.loyaltyRepo.GetCustomer(ctx, tenant.MerchantID, customerID)
+	if err != nil {
+		return nil, err
+	}
+	if customer == nil {
+		return nil, appErrors.NewNotFoundError("customer not found", nil)
+	}
+	orders, err := uc.orderRepo.ListByCustomer(ctx, tenant.MerchantID, customerID, after, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.OrderPage{Orders: orders}
+	if len(orders) > pageSize {
+		page.Orders = orders[:pageSize]
+		last := page.Orders[pageSize-1]
+		page.NextCursor = pagination.EncodeCursor(domain.PageCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+
+	return page, nil
+}
@@ -0,0 +1,189 @@
+package loyalty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Rules of a merchant that never saved its program, one point per 10.000
+// spent worth 100 once the program is turned on
+const (
+	defaultEarnSpend  = 10000
+	defaultEarnPoints = 1
+	defaultPointValue = 100
+)
+
+type loyaltyUseCase struct {
+	loyaltyRepo          domain.LoyaltyRepository
+	orderRepo            domain.OrderRepository
+	userRepo             domain.UserRepository
+	authorizationService domain.AuthorizationService
+}
+
+// NewLoyaltyUseCase creates a new loyalty use case
+func NewLoyaltyUseCase(
+	loyaltyRepo domain.LoyaltyRepository,
+	orderRepo domain.OrderRepository,
+	userRepo domain.UserRepository,
+	authorizationService domain.AuthorizationService,
+) domain.LoyaltyUseCase {
+	return &loyaltyUseCase{
+		loyaltyRepo:          loyaltyRepo,
+		orderRepo:            orderRepo,
+		userRepo:             userRepo,
+		authorizationService: authorizationService,
+	}
+}
+
+// getProgram returns the program of a merchant, an inactive program with the
+// default rules when it was never saved
+func (uc *loyaltyUseCase) getProgram(ctx context.Context, merchantID uuid.UUID) (*domain.LoyaltyProgram, error) {
+	program, err := uc.loyaltyRepo.GetProgram(ctx, merchantID)
+	if err != nil || program != nil {
+		return program, err
+	}
+	return &domain.LoyaltyProgram{
+		MerchantID:       merchantID,
+		EarnSpend:        defaultEarnSpend,
+		EarnPoints:       defaultEarnPoints,
+		PointValue:       defaultPointValue,
+		MaxRedeemPercent: decimal.NewFromInt(100),
+	}, nil
+}
+
+// getCustomer returns a customer of the merchant of the tenant with the
+// points due expired and its tier
+func (uc *loyaltyUseCase) getCustomer(ctx context.Context, tenant *domain.Tenant, id uuid.UUID) (*domain.Customer, error) {
+	if err := uc.loyaltyRepo.ExpirePoints(ctx, tenant.MerchantID, id, time.Now()); err != nil {
+		return nil, err
+	}
+	customer, err := uc.loyaltyRepo.GetCustomer(ctx, tenant.MerchantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if customer == nil {
+		return nil, appErrors.NewNotFoundError("customer not found", nil)
+	}
+	if err := uc.withTiers(ctx, customer); err != nil {
+		return nil, err
+	}
+	return customer, nil
+}
+
+// withTiers sets the tier of every customer from the program of its merchant
+func (uc *loyaltyUseCase) withTiers(ctx context.Context, customers ...*domain.Customer) error {
+	programs := make(map[uuid.UUID]*domain.LoyaltyProgram)
+	for _, customer := range customers {
+		program, ok := programs[customer.MerchantID]
+		if !ok {
+			var err error
+			if program, err = uc.getProgram(ctx, customer.MerchantID); err != nil {
+				return err
+			}
+			programs[customer.MerchantID] = program
+		}
+		customer.Tier = program.TierFor(customer.TotalSpend)
+	}
+	return nil
+}
+
+// getOpenOrder returns a cart of the outlet of the tenant
+func (uc *loyaltyUseCase) getOpenOrder(ctx context.Context, tenant *domain.Tenant, id uuid.UUID) (*domain.Order, error) {
+	order, err := uc.orderRepo.GetByID(ctx, tenant.MerchantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil || order.OutletID != tenant.OutletID {
+		return nil, appErrors.NewNotFoundError("order not found", nil)
+	}
+	if !order.IsEditable() {
+		return nil, appErrors.NewConflictError(fmt.Sprintf("order is %s", order.Status), nil)
+	}
+	return order, nil
+}
+
+// saveOrder recalculates and stores a cart
+func (uc *loyaltyUseCase) saveOrder(ctx context.Context, order *domain.Order) (*domain.Order, error) {
+	order.Recalculate()
+	order.UpdatedAt.Time = time.Now()
+	order.UpdatedAt.Valid = true
+
+	if err := uc.orderRepo.Update(ctx, order); err != nil {
+		if errors.Is(err, domain.ErrOrderChanged) {
+			return nil, appErrors.NewConflictError("order was changed by another request, reload it and try again", err)
+		}
+		return nil, err
+	}
+	return order, nil
+}
+
+// linkedUser returns the user registered with the verified WhatsApp number
+func (uc *loyaltyUseCase) linkedUser(ctx context.Context, whatsAppNumber string) (uuid.NullUUID, error) {
+	user, err := uc.userRepo.GetByWhatsAppNumber(ctx, whatsAppNumber)
+	if err != nil {
+		return uuid.NullUUID{}, err
+	}
+	if user == nil || !user.WhatsAppVerifiedAt.Valid {
+		return uuid.NullUUID{}, nil
+	}
+	return uuid.NullUUID{UUID: user.ID, Valid: true}, nil
+}
+
+// validateCustomer checks the fields of a customer
+func validateCustomer(customer *domain.Customer) error {
+	if customer.Name == "" {
+		return appErrors.NewFieldValidationError("name", "name is required")
+	}
+	if customer.WhatsAppNumber == "" {
+		return appErrors.NewFieldValidationError("whatsapp_number", "WhatsApp number is required")
+	}
+	return nil
+}
+
+// validateProgram checks the rules and the tiers of a program
+func validateProgram(p *domain.LoyaltyProgram) error {
+	if p.EarnSpend <= 0 {
+		return appErrors.NewFieldValidationError("earn_spend", "earn spend must be positive")
+	}
+	if p.EarnPoints < 0 {
+		return appErrors.NewFieldValidationError("earn_points", "earn points must not be negative")
+	}
+	if p.PointValue <= 0 {
+		return appErrors.NewFieldValidationError("point_value", "point value must be positive")
+	}
+	if p.MinRedeemPoints < 0 {
+		return appErrors.NewFieldValidationError("min_redeem_points", "minimum redeemed points must not be negative")
+	}
+	if p.MaxRedeemPercent.IsNegative() || p.MaxRedeemPercent.GreaterThan(decimal.NewFromInt(100)) {
+		return appErrors.NewFieldValidationError("max_redeem_percent", "maximum redeemed percentage must be between 0 and 100")
+	}
+	if p.ExpiryDays < 0 {
+		return appErrors.NewFieldValidationError("expiry_days", "expiry days must not be negative")
+	}
+
+	minSpends := make(map[int64]bool, len(p.Tiers))
+	for i, tier := range p.Tiers {
+		field := fmt.Sprintf("tiers[%d]", i)
+		if tier.Name == "" {
+			return appErrors.NewFieldValidationError(field+".name", "name is required")
+		}
+		if tier.MinSpend < 0 {
+			return appErrors.NewFieldValidationError(field+".min_spend", "minimum spend must not be negative")
+		}
+		if minSpends[tier.MinSpend] {
+			return appErrors.NewFieldValidationError(field+".min_spend", "another tier has the same minimum spend")
+		}
+		minSpends[tier.MinSpend] = true
+		if tier.Multiplier.LessThan(decimal.NewFromInt(1)) {
+			return appErrors.NewFieldValidationError(field+".multiplier", "multiplier must be at least 1")
+		}
+	}
+	return nil
+}
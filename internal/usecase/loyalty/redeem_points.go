@@ -0,0 +1,70 @@
+package loyalty
+
+import (
+	"context"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// RedeemPoints pays part of a cart of the outlet of the tenant with points of
+// its customer, worth the point value each. The points are only taken at
+// checkout, zero points cancel the redemption.
+func (uc *loyaltyUseCase) RedeemPoints(ctx context.Context, orderID uuid.UUID, points int64) (*domain.Order, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.loyalty.RedeemPoints")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderCreate); err != nil {
+		return nil, err
+	}
+	if points < 0 {
+		return nil, appErrors.NewFieldValidationError("points", "points must not be negative")
+	}
+
+	order, err := uc.getOpenOrder(ctx, tenant, orderID)
+	if err != nil {
+		return nil, err
+	}
+	order.PointsRedeemed, order.PointsDiscount = 0, 0
+	if points == 0 {
+		return uc.saveOrder(ctx, order)
+	}
+	if !order.CustomerID.Valid {
+		return nil, appErrors.NewBadRequestError("order has no customer, attach a customer first", nil)
+	}
+
+	program, err := uc.getProgram(ctx, tenant.MerchantID)
+	if err != nil {
+		return nil, err
+	}
+	if !program.IsActive {
+		return nil, appErrors.NewConflictError("loyalty program is not active", nil)
+	}
+	if points < program.MinRedeemPoints {
+		return nil, appErrors.NewFieldValidationError("points", fmt.Sprintf("at least %d points must be redeemed", program.MinRedeemPoints))
+	}
+	customer, err := uc.getCustomer(ctx, tenant, order.CustomerID.UUID)
+	if err != nil {
+		return nil, err
+	}
+	if points > customer.PointsBalance {
+		return nil, appErrors.NewFieldValidationError("points", fmt.Sprintf("customer has %d points", customer.PointsBalance))
+	}
+
+	// The limit is a part of the total without the previous redemption
+	order.Recalculate()
+	if most := program.MaxRedeemAmount(order) / program.PointValue; points > most {
+		return nil, appErrors.NewFieldValidationError("points", fmt.Sprintf("at most %d points can be redeemed for this order", most))
+	}
+	order.PointsRedeemed = points
+	order.PointsDiscount = points * program.PointValue
+	return uc.saveOrder(ctx, order)
+}
@@ -0,0 +1,54 @@
+package loyalty
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+)
+
+// UpdateCustomer changes the name, the WhatsApp number and the email of a
+// customer of the merchant of the tenant. A new number links the customer to
+// the user registered with it.
+func (uc *loyaltyUseCase) UpdateCustomer(ctx context.Context, customer *domain.Customer) (*domain.Customer, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.loyalty.UpdateCustomer")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionCustomerManage); err != nil {
+		return nil, err
+	}
+	if err := validateCustomer(customer); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.getCustomer(ctx, tenant, customer.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing.Name = customer.Name
+	existing.Email = customer.Email
+	if existing.WhatsAppNumber != customer.WhatsAppNumber {
+		existing.WhatsAppNumber = customer.WhatsAppNumber
+		if existing.UserID, err = uc.linkedUser(ctx, customer.WhatsAppNumber); err != nil {
+			return nil, err
+		}
+	}
+	existing.UpdatedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	if err := uc.loyaltyRepo.UpdateCustomer(ctx, existing); err != nil {
+		if errors.Is(err, domain.ErrCustomerExists) {
+			return nil, appErrors.NewDuplicateError("a customer with this WhatsApp number already exists", err)
+		}
+		return nil, err
+	}
+
+	return existing, nil
+}
@@ -0,0 +1,54 @@
+package loyalty
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UpdateProgram replaces the rules and the tiers of the loyalty program of the
+// merchant of the tenant. Points already earned keep their expiry.
+func (uc *loyaltyUseCase) UpdateProgram(ctx context.Context, program *domain.LoyaltyProgram) (*domain.LoyaltyProgram, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.loyalty.UpdateProgram")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionLoyaltyManage); err != nil {
+		return nil, err
+	}
+	if err := validateProgram(program); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.loyaltyRepo.GetProgram(ctx, tenant.MerchantID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	program.MerchantID = tenant.MerchantID
+	program.CreatedAt = now
+	program.UpdatedAt = sql.NullTime{}
+	if existing != nil {
+		program.CreatedAt = existing.CreatedAt
+		program.UpdatedAt = sql.NullTime{Time: now, Valid: true}
+	}
+	for _, tier := range program.Tiers {
+		tier.ID = uuid.New()
+		tier.MerchantID = tenant.MerchantID
+	}
+	program.SortTiers()
+	if err := uc.loyaltyRepo.SaveProgram(ctx, program); err != nil {
+		return nil, err
+	}
+
+	return program, nil
+}
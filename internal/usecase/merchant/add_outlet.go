@@ -0,0 +1,43 @@
+package merchant
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AddOutlet adds a new outlet to the merchant of the tenant
+func (uc *merchantUseCase) AddOutlet(ctx context.Context, outlet *domain.Outlet) (*domain.Outlet, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.merchant.AddOutlet")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOutletManage); err != nil {
+		return nil, err
+	}
+
+	if outlet.Timezone == "" {
+		outlet.Timezone = domain.DefaultTimezone
+	}
+	if _, err := outlet.Location(); err != nil || outlet.Timezone == "Local" {
+		return nil, appErrors.NewFieldValidationError("timezone", "timezone must be an IANA time zone, e.g. Asia/Jakarta")
+	}
+
+	outlet.ID = uuid.New()
+	outlet.MerchantID = tenant.MerchantID
+	outlet.IsActive = true
+	outlet.CreatedAt = time.Now()
+	if err := uc.outletRepo.Create(ctx, outlet); err != nil {
+		return nil, err
+	}
+
+	return outlet, nil
+}
@@ -0,0 +1,34 @@
+package merchant
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateMerchant creates a new merchant owned by the caller
+func (uc *merchantUseCase) CreateMerchant(ctx context.Context, name string) (*domain.Merchant, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.merchant.CreateMerchant")
+	defer span.End()
+
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, appErrors.NewUnauthorizedError("unauthenticated", nil)
+	}
+
+	merchant := &domain.Merchant{
+		ID:          uuid.New(),
+		Name:        name,
+		OwnerUserID: principal.UserID,
+		CreatedAt:   time.Now(),
+	}
+	if err := uc.merchantRepo.Create(ctx, merchant); err != nil {
+		return nil, err
+	}
+
+	return merchant, nil
+}
@@ -0,0 +1,30 @@
+package merchant
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+)
+
+// GetMerchant returns the merchant of the tenant
+func (uc *merchantUseCase) GetMerchant(ctx context.Context) (*domain.Merchant, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.merchant.GetMerchant")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	merchant, err := uc.merchantRepo.GetByID(ctx, tenant.MerchantID)
+	if err != nil {
+		return nil, err
+	}
+	if merchant == nil {
+		return nil, appErrors.NewNotFoundError("merchant not found", nil)
+	}
+
+	return merchant, nil
+}
@@ -0,0 +1,128 @@
+package merchant
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InviteStaff adds an existing user to the merchant of the tenant with the
+// given role and assigns the user to the outlet
+func (uc *merchantUseCase) InviteStaff(ctx context.Context, outletID uuid.UUID, identifier, role string) (*domain.MerchantMember, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.merchant.InviteStaff")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionStaffManage); err != nil {
+		return nil, err
+	}
+	if role != domain.RoleManager && role != domain.RoleCashier {
+		return nil, appErrors.NewFieldValidationError("role", "staff can only be invited as manager or cashier")
+	}
+	if err := uc.authorizeGrant(ctx, role); err != nil {
+		return nil, err
+	}
+
+	outlet, err := uc.outletRepo.GetByID(ctx, tenant.MerchantID, outletID)
+	if err != nil {
+		return nil, err
+	}
+	if outlet == nil {
+		return nil, appErrors.NewNotFoundError("outlet not found", nil)
+	}
+
+	// Only owners invite staff to outlets they are not assigned to themselves
+	principal, _ := domain.PrincipalFromContext(ctx)
+	if tenant.Role != domain.RoleOwner {
+		isStaff, err := uc.outletRepo.IsStaff(ctx, outletID, principal.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if !isStaff {
+			return nil, appErrors.NewForbiddenError("you are not assigned to this outlet", nil)
+		}
+	}
+
+	user, err := uc.findUser(ctx, identifier)
+	if err != nil {
+		return nil, err
+	}
+	if user.ID == principal.UserID {
+		return nil, appErrors.NewBadRequestError("you cannot invite yourself", nil)
+	}
+
+	existing, err := uc.merchantRepo.GetMember(ctx, tenant.MerchantID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil && existing.Role == domain.RoleOwner {
+		return nil, appErrors.NewBadRequestError("the owner of the merchant cannot be invited as staff", nil)
+	}
+
+	member := &domain.MerchantMember{
+		MerchantID: tenant.MerchantID,
+		UserID:     user.ID,
+		Role:       role,
+		CreatedAt:  time.Now(),
+	}
+	if existing != nil {
+		member.CreatedAt = existing.CreatedAt
+	}
+	if err := uc.merchantRepo.SaveMember(ctx, member); err != nil {
+		return nil, err
+	}
+	if err := uc.outletRepo.AddStaff(ctx, outletID, user.ID); err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// authorizeGrant checks that the caller has every permission of the role it hands out
+func (uc *merchantUseCase) authorizeGrant(ctx context.Context, roleName string) error {
+	role, err := uc.roleRepo.GetByName(ctx, roleName)
+	if err != nil {
+		return err
+	}
+	if role == nil {
+		return appErrors.NewNotFoundError("role not found", nil)
+	}
+
+	for _, permission := range role.Permissions {
+		granted, err := uc.authorizationService.HasPermission(ctx, permission)
+		if err != nil {
+			return err
+		}
+		if !granted {
+			return appErrors.NewForbiddenError("the role grants permissions you do not have", nil)
+		}
+	}
+	return nil
+}
+
+// findUser looks up an active account by WhatsApp number or email
+func (uc *merchantUseCase) findUser(ctx context.Context, identifier string) (*domain.User, error) {
+	var user *domain.User
+	var err error
+	if strings.HasPrefix(identifier, "+") {
+		user, err = uc.userRepo.GetByWhatsAppNumber(ctx, identifier)
+	} else {
+		user, err = uc.userRepo.GetByEmail(ctx, identifier)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || !user.IsActive {
+		return nil, appErrors.NewNotFoundError("no active account with this email or phone number", nil)
+	}
+	return user, nil
+}
@@ -0,0 +1,21 @@
+package merchant
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+)
+
+// ListMyMerchants lists the merchants the caller is a member of
+func (uc *merchantUseCase) ListMyMerchants(ctx context.Context) ([]*domain.Merchant, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.merchant.ListMyMerchants")
+	defer span.End()
+
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, appErrors.NewUnauthorizedError("unauthenticated", nil)
+	}
+
+	return uc.merchantRepo.ListByUserID(ctx, principal.UserID)
+}
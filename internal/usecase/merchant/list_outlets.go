@@ -0,0 +1,21 @@
+package merchant
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/tenancy"
+)
+
+// ListOutlets lists the outlets of the merchant of the tenant
+func (uc *merchantUseCase) ListOutlets(ctx context.Context) ([]*domain.Outlet, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.merchant.ListOutlets")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.outletRepo.ListByMerchantID(ctx, tenant.MerchantID)
+}
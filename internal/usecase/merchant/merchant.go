@@ -0,0 +1,30 @@
+package merchant
+
+import (
+	"github/kijunpos/internal/domain"
+)
+
+type merchantUseCase struct {
+	merchantRepo         domain.MerchantRepository
+	outletRepo           domain.OutletRepository
+	userRepo             domain.UserRepository
+	roleRepo             domain.RoleRepository
+	authorizationService domain.AuthorizationService
+}
+
+// NewMerchantUseCase creates a new merchant use case
+func NewMerchantUseCase(
+	merchantRepo domain.MerchantRepository,
+	outletRepo domain.OutletRepository,
+	userRepo domain.UserRepository,
+	roleRepo domain.RoleRepository,
+	authorizationService domain.AuthorizationService,
+) domain.MerchantUseCase {
+	return &merchantUseCase{
+		merchantRepo:         merchantRepo,
+		outletRepo:           outletRepo,
+		userRepo:             userRepo,
+		roleRepo:             roleRepo,
+		authorizationService: authorizationService,
+	}
+}
@@ -0,0 +1,57 @@
+package merchant
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// ResolveTenant returns the tenant of a request after checking the membership
+// of the caller. Owners act on every outlet, other members only on the
+// outlets they are assigned to.
+func (uc *merchantUseCase) ResolveTenant(ctx context.Context, merchantID, outletID uuid.UUID) (*domain.Tenant, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.merchant.ResolveTenant")
+	defer span.End()
+
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, appErrors.NewUnauthorizedError("unauthenticated", nil)
+	}
+
+	// Unknown merchants are reported like foreign ones
+	member, err := uc.merchantRepo.GetMember(ctx, merchantID, principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, appErrors.NewForbiddenError("you are not a member of this merchant", nil)
+	}
+
+	tenant := &domain.Tenant{MerchantID: merchantID, Role: member.Role}
+	if outletID == uuid.Nil {
+		return tenant, nil
+	}
+
+	outlet, err := uc.outletRepo.GetByID(ctx, merchantID, outletID)
+	if err != nil {
+		return nil, err
+	}
+	if outlet == nil {
+		return nil, appErrors.NewNotFoundError("outlet not found", nil)
+	}
+	if member.Role != domain.RoleOwner {
+		isStaff, err := uc.outletRepo.IsStaff(ctx, outletID, principal.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if !isStaff {
+			return nil, appErrors.NewForbiddenError("you are not assigned to this outlet", nil)
+		}
+	}
+
+	tenant.OutletID = outletID
+	return tenant, nil
+}
@@ -0,0 +1,88 @@
+package order
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AddItem adds a variant to a cart of the outlet of the tenant
+func (uc *orderUseCase) AddItem(ctx context.Context, orderID, variantID uuid.UUID, quantity int64, discount domain.Discount) (*domain.Order, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.order.AddItem")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderCreate); err != nil {
+		return nil, err
+	}
+	if quantity <= 0 {
+		return nil, appErrors.NewFieldValidationError("quantity", "quantity must be positive")
+	}
+	if err := uc.checkDiscount(ctx, "discount", discount); err != nil {
+		return nil, err
+	}
+
+	order, err := uc.getOpenOrder(ctx, tenant, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if discount.Type == "" {
+		for _, item := range order.Items {
+			if item.VariantID == variantID && item.DiscountType == "" {
+				item.Quantity += quantity
+				return uc.save(ctx, order)
+			}
+		}
+	}
+
+	variant, err := uc.productRepo.GetVariant(ctx, tenant.MerchantID, variantID)
+	if err != nil {
+		return nil, err
+	}
+	if variant == nil {
+		return nil, appErrors.NewNotFoundError("variant not found", nil)
+	}
+	product, err := uc.productRepo.GetByID(ctx, tenant.MerchantID, variant.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if product == nil {
+		return nil, appErrors.NewNotFoundError("product not found", nil)
+	}
+	if !product.IsActive || !variant.IsActive {
+		return nil, appErrors.NewFieldValidationError("variant_id", "variant is not for sale")
+	}
+
+	name := product.Name
+	if variant.Name != "" {
+		name += " - " + variant.Name
+	}
+
+	order.Items = append(order.Items, &domain.OrderItem{
+		ID:            uuid.New(),
+		OrderID:       order.ID,
+		ProductID:     product.ID,
+		VariantID:     variant.ID,
+		CategoryID:    product.CategoryID,
+		SKU:           variant.SKU,
+		Name:          name,
+		Unit:          product.Unit,
+		Quantity:      quantity,
+		UnitPrice:     variant.PriceAt(product, tenant.OutletID),
+		DiscountType:  discount.Type,
+		DiscountValue: discount.Value,
+		TaxExempt:     product.TaxExempt,
+		CreatedAt:     time.Now(),
+	})
+
+	return uc.save(ctx, order)
+}
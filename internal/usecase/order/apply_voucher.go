@@ -0,0 +1,69 @@
+package order
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ApplyVoucher attaches a voucher to a cart of the outlet of the tenant,
+// replacing the voucher it had
+func (uc *orderUseCase) ApplyVoucher(ctx context.Context, orderID uuid.UUID, code string) (*domain.Order, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.order.ApplyVoucher")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderCreate); err != nil {
+		return nil, err
+	}
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return nil, appErrors.NewFieldValidationError("code", "code is required")
+	}
+
+	order, err := uc.getOpenOrder(ctx, tenant, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	voucher, err := uc.promotionRepo.GetVoucherByCode(ctx, tenant.MerchantID, code)
+	if err != nil {
+		return nil, err
+	}
+	if voucher == nil {
+		return nil, appErrors.NewNotFoundError("voucher not found", nil)
+	}
+	if voucher.RedeemedAt.Valid {
+		return nil, appErrors.NewConflictError("voucher was already redeemed", domain.ErrVoucherRedeemed)
+	}
+	promotion, err := uc.promotionRepo.GetByID(ctx, tenant.MerchantID, voucher.PromotionID)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := uc.outletLocation(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+	if promotion == nil || !promotion.RunsAt(order.OutletID, loc, time.Now()) {
+		return nil, appErrors.NewBadRequestError("voucher is not valid at this outlet or time", nil)
+	}
+
+	order.VoucherID = uuid.NullUUID{UUID: voucher.ID, Valid: true}
+	order.VoucherCode = voucher.Code
+	if _, err := uc.applyPromotions(ctx, order); err != nil {
+		return nil, err
+	}
+	if !voucherApplied(order, voucher) {
+		return nil, appErrors.NewBadRequestError("voucher does not apply to the items of the order", nil)
+	}
+	return uc.save(ctx, order)
+}
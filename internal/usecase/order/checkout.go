@@ -0,0 +1,249 @@
+package order
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/logger"
+	"github/kijunpos/internal/pkg/payment"
+	"github/kijunpos/internal/pkg/tenancy"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Checkout pays and completes a cart of the outlet of the tenant. The sale is
+// stored, its payments recorded, its store credit spent, its items taken out
+// of stock, the points of its customer redeemed and earned and its cash put
+// into the drawer of the open shift of the caller at once.
+func (uc *orderUseCase) Checkout(ctx context.Context, orderID uuid.UUID, payments []*domain.Payment, idempotencyKey string) (*domain.Order, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.order.Checkout")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderCreate); err != nil {
+		return nil, err
+	}
+	if idempotencyKey == "" {
+		return nil, appErrors.NewFieldValidationError("idempotency_key", "idempotency key is required")
+	}
+
+	// A repeated checkout returns the sale of the first one
+	if order, err := uc.checkedOut(ctx, tenant, orderID, idempotencyKey); order != nil || err != nil {
+		return order, err
+	}
+
+	order, err := uc.getOrder(ctx, tenant, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != domain.OrderStatusOpen {
+		return nil, appErrors.NewConflictError(fmt.Sprintf("order is %s", order.Status), nil)
+	}
+	if len(order.Items) == 0 {
+		return nil, appErrors.NewBadRequestError("order has no items", nil)
+	}
+
+	shift, err := uc.openShift(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	if shift == nil {
+		return nil, appErrors.NewConflictError("no shift is open, open a shift before checking out", nil)
+	}
+
+	// Promotions are evaluated once more, e.g. a happy hour may have ended.
+	// A voucher whose promotion no longer applies is left unredeemed.
+	hadVoucher := order.VoucherID.Valid
+	voucher, err := uc.applyPromotions(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+	if hadVoucher && voucher == nil {
+		return nil, appErrors.NewConflictError("voucher was redeemed by another sale, remove it from the order", domain.ErrVoucherRedeemed)
+	}
+	if voucher != nil && !voucherApplied(order, voucher) {
+		order.VoucherID = uuid.NullUUID{}
+		order.VoucherCode = ""
+	}
+	program, err := uc.checkPoints(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPayments(order, payments); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for i, p := range payments {
+		p.ID = uuid.New()
+		p.OrderID = order.ID
+		p.MerchantID = order.MerchantID
+		p.CreatedAt = now
+		if err := payment.Charge(ctx, uc.gateways, p); err != nil {
+			uc.refundAll(ctx, payments[:i])
+			return nil, err
+		}
+		if p.Status != domain.PaymentStatusCaptured {
+			uc.refundAll(ctx, payments[:i+1])
+			return nil, appErrors.NewFieldValidationError(
+				fmt.Sprintf("payments[%d].method", i),
+				fmt.Sprintf("%s payments are confirmed later, add them with AddPayment before checkout", p.Method),
+			)
+		}
+	}
+	order.Payments = append(order.Payments, payments...)
+	order.Status = domain.OrderStatusCompleted
+	order.ShiftID = uuid.NullUUID{UUID: shift.ID, Valid: true}
+	order.IdempotencyKey.String = idempotencyKey
+	order.IdempotencyKey.Valid = true
+	order.UpdatedAt.Time = now
+	order.UpdatedAt.Valid = true
+	order.CompletedAt.Time = now
+	order.CompletedAt.Valid = true
+
+	movements := stockMovements(ctx, order, domain.StockMovementSale, -1)
+	cash := cashMovement(ctx, order, shift.ID, domain.CashMovementSale, 1)
+	var points []*domain.PointEntry
+	if program != nil {
+		points = pointEntries(ctx, order, domain.PointEntryRedeem, order.PointsRedeemed, 0, sql.NullTime{})
+		points = append(points, pointEntries(ctx, order, domain.PointEntryEarn, 0, order.PointsEarned, program.ExpiresAt(now))...)
+	}
+	err = uc.orderRepo.Checkout(ctx, order, payments, movements, points, cash)
+	if err == nil {
+		return order, nil
+	}
+	uc.refundAll(ctx, payments)
+
+	if errors.Is(err, domain.ErrOrderChanged) || errors.Is(err, domain.ErrDuplicateIdempotencyKey) {
+		// A concurrent request with the same key may have completed the sale first
+		if completed, checkErr := uc.checkedOut(ctx, tenant, orderID, idempotencyKey); completed != nil || checkErr != nil {
+			return completed, checkErr
+		}
+	}
+	if errors.Is(err, domain.ErrPaymentChanged) {
+		return nil, appErrors.NewConflictError("payments of the order were changed by another request, reload it and try again", err)
+	}
+	if errors.Is(err, domain.ErrShiftClosed) {
+		return nil, appErrors.NewConflictError("shift was closed, open a shift before checking out", err)
+	}
+	if errors.Is(err, domain.ErrVoucherRedeemed) {
+		return nil, appErrors.NewConflictError("voucher was redeemed by another sale, remove it from the order", err)
+	}
+	if errors.Is(err, domain.ErrPromotionExhausted) {
+		return nil, appErrors.NewConflictError("a promotion of the order reached its usage limit, reload the order and try again", err)
+	}
+	if errors.Is(err, domain.ErrInsufficientPoints) {
+		return nil, appErrors.NewConflictError("customer no longer has the points redeemed, redeem points again", err)
+	}
+	if errors.Is(err, domain.ErrInsufficientStoreCredit) {
+		return nil, appErrors.NewConflictError("store credit not found or its balance does not cover the payment", err)
+	}
+	return nil, insufficientStock(changed(err))
+}
+
+// checkPoints checks the points redeemed for the order against the program
+// of the merchant and sets the points the sale earns its customer. It
+// returns the program, nil when the order has no customer.
+func (uc *orderUseCase) checkPoints(ctx context.Context, order *domain.Order) (*domain.LoyaltyProgram, error) {
+	if !order.CustomerID.Valid {
+		return nil, nil
+	}
+
+	program, err := uc.loyaltyRepo.GetProgram(ctx, order.MerchantID)
+	if err != nil {
+		return nil, err
+	}
+	customer, err := uc.loyaltyRepo.GetCustomer(ctx, order.MerchantID, order.CustomerID.UUID)
+	if err != nil {
+		return nil, err
+	}
+	if program == nil || customer == nil {
+		if order.PointsRedeemed > 0 {
+			return nil, appErrors.NewConflictError("points can no longer be redeemed, cancel the redemption", nil)
+		}
+		order.PointsEarned = 0
+		return nil, nil
+	}
+
+	// Promotions evaluated again may leave less to pay with points
+	if order.PointsRedeemed > 0 && (!program.IsActive || order.PointsDiscount > program.MaxRedeemAmount(order)) {
+		return nil, appErrors.NewConflictError("points redeemed no longer apply to the order, redeem points again", nil)
+	}
+	order.PointsEarned = program.EarnedPoints(order.Total, program.TierFor(customer.TotalSpend))
+	return program, nil
+}
+
+// checkedOut returns the order already checked out with the idempotency key,
+// if any. A key used for another order is rejected.
+func (uc *orderUseCase) checkedOut(ctx context.Context, tenant *domain.Tenant, orderID uuid.UUID, idempotencyKey string) (*domain.Order, error) {
+	order, err := uc.orderRepo.GetByIdempotencyKey(ctx, tenant.MerchantID, idempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil {
+		return nil, nil
+	}
+	if order.ID != orderID {
+		return nil, appErrors.NewDuplicateError("idempotency key was already used for another order", nil)
+	}
+	return order, nil
+}
+
+// refundAll gives back or cancels the payments charged for a checkout that
+// failed, the cashier hands back cash still at the till
+func (uc *orderUseCase) refundAll(ctx context.Context, payments []*domain.Payment) {
+	for _, p := range payments {
+		if p.Provider == "" || (p.Status != domain.PaymentStatusCaptured && !p.Status.IsOpen()) {
+			continue
+		}
+		if err := payment.Refund(ctx, uc.gateways, p); err != nil {
+			logger.GetLogger().WithContext(ctx).Errorf("failed to refund payment %s of a failed checkout: %v", p.ID, err)
+		}
+	}
+}
+
+// checkPayments checks that the captured payments of the order and the new
+// payments pay its total exactly and computes the change of cash payments.
+// Store credit payments carry the code of the credit as reference.
+func checkPayments(order *domain.Order, payments []*domain.Payment) error {
+	var paid int64
+	for _, existing := range order.Payments {
+		if existing.Status.IsOpen() {
+			return appErrors.NewConflictError(fmt.Sprintf("payment %s is still pending", existing.ID), nil)
+		}
+		if existing.Status == domain.PaymentStatusCaptured {
+			paid += existing.Amount
+		}
+	}
+
+	for i, p := range payments {
+		field := fmt.Sprintf("payments[%d]", i)
+		if p.Amount <= 0 {
+			return appErrors.NewFieldValidationError(field+".amount", "amount must be positive")
+		}
+		if p.Method == domain.PaymentMethodStoreCredit {
+			p.Reference = strings.ToUpper(strings.TrimSpace(p.Reference))
+			if p.Reference == "" {
+				return appErrors.NewFieldValidationError(field+".reference", "store credit code is required")
+			}
+		}
+		if !p.CalculateChange() {
+			return appErrors.NewFieldValidationError(field+".tendered", "tendered cash must cover the amount")
+		}
+		paid += p.Amount
+	}
+
+	if paid != order.Total {
+		return appErrors.NewFieldValidationError("payments", fmt.Sprintf("payments add up to %d, order total is %d", paid, order.Total))
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package order
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateOrder opens an empty cart at the outlet of the tenant for the caller.
+// The tax rules applying at the outlet are copied into the cart.
+func (uc *orderUseCase) CreateOrder(ctx context.Context, note string) (*domain.Order, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.order.CreateOrder")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderCreate); err != nil {
+		return nil, err
+	}
+
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, appErrors.NewUnauthorizedError("authentication required", nil)
+	}
+
+	outlet, err := uc.outletRepo.GetByID(ctx, tenant.MerchantID, tenant.OutletID)
+	if err != nil {
+		return nil, err
+	}
+	if outlet == nil {
+		return nil, appErrors.NewNotFoundError("outlet not found", nil)
+	}
+
+	rules, err := uc.taxRuleRepo.ListByMerchant(ctx, tenant.MerchantID)
+	if err != nil {
+		return nil, err
+	}
+
+	orderID := uuid.New()
+	order := &domain.Order{
+		ID:         orderID,
+		MerchantID: tenant.MerchantID,
+		OutletID:   tenant.OutletID,
+		CashierID:  principal.UserID,
+		Status:     domain.OrderStatusOpen,
+		Note:       note,
+		Version:    1,
+		CreatedAt:  time.Now(),
+		Items:      []*domain.OrderItem{},
+		Payments:   []*domain.Payment{},
+		Taxes:      domain.NewOrderTaxes(orderID, domain.EffectiveTaxRules(rules, tenant.OutletID)),
+	}
+	if err := uc.orderRepo.Create(ctx, order); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
@@ -0,0 +1,26 @@
+package order
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// GetOrder returns an order of the outlet of the tenant
+func (uc *orderUseCase) GetOrder(ctx context.Context, id uuid.UUID) (*domain.Order, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.order.GetOrder")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderRead); err != nil {
+		return nil, err
+	}
+
+	return uc.getOrder(ctx, tenant, id)
+}
@@ -0,0 +1,36 @@
+package order
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// HoldOrder parks a cart of the outlet of the tenant so it can be resumed
+// later, the note tells the carts apart
+func (uc *orderUseCase) HoldOrder(ctx context.Context, orderID uuid.UUID, note string) (*domain.Order, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.order.HoldOrder")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderCreate); err != nil {
+		return nil, err
+	}
+
+	order, err := uc.getOpenOrder(ctx, tenant, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	order.Status = domain.OrderStatusHeld
+	if note != "" {
+		order.Note = note
+	}
+	return uc.save(ctx, order)
+}
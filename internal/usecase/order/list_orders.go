@@ -0,0 +1,45 @@
+package order
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/pagination"
+	"github/kijunpos/internal/pkg/tenancy"
+)
+
+// ListOrders lists the orders of the outlet of the tenant, newest first, one
+// page at a time
+func (uc *orderUseCase) ListOrders(ctx context.Context, filter domain.OrderFilter, pageSize int, cursor string) (*domain.OrderPage, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.order.ListOrders")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderRead); err != nil {
+		return nil, err
+	}
+
+	pageSize = pagination.PageSize(pageSize)
+	after, err := pagination.DecodeCursor(cursor)
+	if err != nil {
+		return nil, appErrors.NewFieldValidationError("cursor", "invalid cursor")
+	}
+
+	orders, err := uc.orderRepo.List(ctx, tenant.MerchantID, tenant.OutletID, filter, after, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.OrderPage{Orders: orders}
+	if len(orders) > pageSize {
+		page.Orders = orders[:pageSize]
+		last := page.Orders[pageSize-1]
+		page.NextCursor = pagination.EncodeCursor(domain.PageCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+
+	return page, nil
+}
@@ -0,0 +1,308 @@
+package order
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type orderUseCase struct {
+	orderRepo            domain.OrderRepository
+	productRepo          domain.ProductRepository
+	outletRepo           domain.OutletRepository
+	paymentRepo          domain.PaymentRepository
+	shiftRepo            domain.ShiftRepository
+	taxRuleRepo          domain.TaxRuleRepository
+	promotionRepo        domain.PromotionRepository
+	loyaltyRepo          domain.LoyaltyRepository
+	gateways             domain.PaymentGateways
+	authorizationService domain.AuthorizationService
+}
+
+// NewOrderUseCase creates a new order use case
+func NewOrderUseCase(
+	orderRepo domain.OrderRepository,
+	productRepo domain.ProductRepository,
+	outletRepo domain.OutletRepository,
+	paymentRepo domain.PaymentRepository,
+	shiftRepo domain.ShiftRepository,
+	taxRuleRepo domain.TaxRuleRepository,
+	promotionRepo domain.PromotionRepository,
+	loyaltyRepo domain.LoyaltyRepository,
+	gateways domain.PaymentGateways,
+	authorizationService domain.AuthorizationService,
+) domain.OrderUseCase {
+	return &orderUseCase{
+		orderRepo:            orderRepo,
+		productRepo:          productRepo,
+		outletRepo:           outletRepo,
+		paymentRepo:          paymentRepo,
+		shiftRepo:            shiftRepo,
+		taxRuleRepo:          taxRuleRepo,
+		promotionRepo:        promotionRepo,
+		loyaltyRepo:          loyaltyRepo,
+		gateways:             gateways,
+		authorizationService: authorizationService,
+	}
+}
+
+// getOrder returns an order of the outlet of the tenant
+func (uc *orderUseCase) getOrder(ctx context.Context, tenant *domain.Tenant, id uuid.UUID) (*domain.Order, error) {
+	order, err := uc.orderRepo.GetByID(ctx, tenant.MerchantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil || order.OutletID != tenant.OutletID {
+		return nil, appErrors.NewNotFoundError("order not found", nil)
+	}
+	return order, nil
+}
+
+// getOpenOrder returns an order of the outlet of the tenant whose items can
+// still change
+func (uc *orderUseCase) getOpenOrder(ctx context.Context, tenant *domain.Tenant, id uuid.UUID) (*domain.Order, error) {
+	order, err := uc.getOrder(ctx, tenant, id)
+	if err != nil {
+		return nil, err
+	}
+	if !order.IsEditable() {
+		return nil, appErrors.NewConflictError(fmt.Sprintf("order is %s", order.Status), nil)
+	}
+	return order, nil
+}
+
+// openShift returns the open shift of the caller at the outlet of the tenant,
+// nil when there is none
+func (uc *orderUseCase) openShift(ctx context.Context, tenant *domain.Tenant) (*domain.Shift, error) {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	return uc.shiftRepo.GetOpen(ctx, tenant.MerchantID, tenant.OutletID, principal.UserID)
+}
+
+// applyPromotions evaluates the running promotions and the voucher of the
+// order, recalculates it and returns the voucher. A voucher redeemed by
+// another sale meanwhile is removed from the order.
+func (uc *orderUseCase) applyPromotions(ctx context.Context, order *domain.Order) (*domain.Voucher, error) {
+	loc, err := uc.outletLocation(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	promotions, err := uc.promotionRepo.ListRunning(ctx, order.MerchantID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var voucher *domain.Voucher
+	if order.VoucherID.Valid {
+		if voucher, err = uc.promotionRepo.GetVoucher(ctx, order.MerchantID, order.VoucherID.UUID); err != nil {
+			return nil, err
+		}
+		if voucher == nil || voucher.RedeemedAt.Valid {
+			voucher = nil
+			order.VoucherID = uuid.NullUUID{}
+			order.VoucherCode = ""
+		}
+	}
+
+	order.ApplyPromotions(promotions, voucher, loc, now)
+	return voucher, nil
+}
+
+// outletLocation returns the time zone of the outlet of the order, promotion
+// schedules run on its local time
+func (uc *orderUseCase) outletLocation(ctx context.Context, order *domain.Order) (*time.Location, error) {
+	outlet, err := uc.outletRepo.GetByID(ctx, order.MerchantID, order.OutletID)
+	if err != nil {
+		return nil, err
+	}
+	if outlet == nil {
+		return nil, appErrors.NewNotFoundError("outlet not found", nil)
+	}
+	loc, err := outlet.Location()
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone of outlet %s: %w", outlet.ID, err)
+	}
+	return loc, nil
+}
+
+// voucherApplied reports whether the promotion of the voucher of the order
+// discounts the order
+func voucherApplied(order *domain.Order, voucher *domain.Voucher) bool {
+	for _, p := range order.Promotions {
+		if p.PromotionID == voucher.PromotionID {
+			return true
+		}
+	}
+	return false
+}
+
+// save applies the promotions to the order, recalculates and stores it. A
+// redemption of points is cancelled, the customer redeems again for the new
+// total.
+func (uc *orderUseCase) save(ctx context.Context, order *domain.Order) (*domain.Order, error) {
+	order.PointsRedeemed, order.PointsDiscount = 0, 0
+	if _, err := uc.applyPromotions(ctx, order); err != nil {
+		return nil, err
+	}
+	order.UpdatedAt.Time = time.Now()
+	order.UpdatedAt.Valid = true
+
+	if err := uc.orderRepo.Update(ctx, order); err != nil {
+		return nil, changed(err)
+	}
+	return order, nil
+}
+
+// checkDiscount validates a manual discount, which requires the discount permission
+func (uc *orderUseCase) checkDiscount(ctx context.Context, field string, discount domain.Discount) error {
+	switch discount.Type {
+	case "":
+		if !discount.Value.IsZero() {
+			return appErrors.NewFieldValidationError(field, "discount type is required")
+		}
+		return nil
+	case domain.DiscountTypeAmount:
+		if !discount.Value.IsPositive() || !discount.Value.IsInteger() {
+			return appErrors.NewFieldValidationError(field, "discount amount must be a positive whole amount")
+		}
+	case domain.DiscountTypePercent:
+		if !discount.Value.IsPositive() || discount.Value.GreaterThan(decimal.NewFromInt(100)) {
+			return appErrors.NewFieldValidationError(field, "discount percentage must be greater than 0 and at most 100")
+		}
+	default:
+		return appErrors.NewFieldValidationError(field, "unknown discount type")
+	}
+
+	return uc.authorizationService.Authorize(ctx, domain.PermissionOrderDiscount)
+}
+
+// changed converts domain.ErrOrderChanged into an AppError
+func changed(err error) error {
+	if errors.Is(err, domain.ErrOrderChanged) {
+		return appErrors.NewConflictError("order was changed by another request, reload it and try again", err)
+	}
+	return err
+}
+
+// insufficientStock converts a *domain.InsufficientStockError into an AppError
+func insufficientStock(err error) error {
+	var stockErr *domain.InsufficientStockError
+	if errors.As(err, &stockErr) {
+		return appErrors.NewInsufficientStockError(
+			fmt.Sprintf("insufficient stock of variant %s, %d on hand", stockErr.VariantID, stockErr.OnHand),
+			err,
+		)
+	}
+	return err
+}
+
+// cashMovement creates the cash ledger entry of the captured cash payments of
+// the order, nil when none was paid in cash. sign is 1 for cash received and
+// -1 for cash given back.
+func cashMovement(ctx context.Context, order *domain.Order, shiftID uuid.UUID, movementType domain.CashMovementType, sign int64) *domain.CashMovement {
+	var cash int64
+	for _, p := range order.Payments {
+		if p.Method == domain.PaymentMethodCash && p.Status == domain.PaymentStatusCaptured {
+			cash += p.Amount
+		}
+	}
+	if cash == 0 {
+		return nil
+	}
+
+	var createdBy uuid.UUID
+	if principal, ok := domain.PrincipalFromContext(ctx); ok {
+		createdBy = principal.UserID
+	}
+	return &domain.CashMovement{
+		ID:          uuid.New(),
+		MerchantID:  order.MerchantID,
+		ShiftID:     shiftID,
+		Type:        movementType,
+		Amount:      sign * cash,
+		ReferenceID: uuid.NullUUID{UUID: order.ID, Valid: true},
+		CreatedBy:   createdBy,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// pointEntries creates the points ledger entries of the customer of the
+// order, points taken first. Entries without points are left out.
+func pointEntries(ctx context.Context, order *domain.Order, entryType domain.PointEntryType, taken, added int64, expiresAt sql.NullTime) []*domain.PointEntry {
+	if !order.CustomerID.Valid {
+		return nil
+	}
+
+	var createdBy uuid.NullUUID
+	if principal, ok := domain.PrincipalFromContext(ctx); ok {
+		createdBy = uuid.NullUUID{UUID: principal.UserID, Valid: true}
+	}
+
+	now := time.Now()
+	var entries []*domain.PointEntry
+	for _, points := range []int64{-taken, added} {
+		if points == 0 {
+			continue
+		}
+		entry := &domain.PointEntry{
+			ID:         uuid.New(),
+			MerchantID: order.MerchantID,
+			CustomerID: order.CustomerID.UUID,
+			OrderID:    uuid.NullUUID{UUID: order.ID, Valid: true},
+			Type:       entryType,
+			Points:     points,
+			CreatedBy:  createdBy,
+			CreatedAt:  now,
+		}
+		if points > 0 {
+			entry.ExpiresAt = expiresAt
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// stockMovements creates one movement per variant of the order, sign is -1
+// for stock leaving the outlet and 1 for stock coming back
+func stockMovements(ctx context.Context, order *domain.Order, movementType domain.StockMovementType, sign int64) []*domain.StockMovement {
+	var createdBy uuid.NullUUID
+	if principal, ok := domain.PrincipalFromContext(ctx); ok {
+		createdBy = uuid.NullUUID{UUID: principal.UserID, Valid: true}
+	}
+
+	now := time.Now()
+	byVariant := make(map[uuid.UUID]*domain.StockMovement, len(order.Items))
+	movements := make([]*domain.StockMovement, 0, len(order.Items))
+	for _, item := range order.Items {
+		if movement, ok := byVariant[item.VariantID]; ok {
+			movement.Quantity += sign * item.Quantity
+			continue
+		}
+
+		movement := &domain.StockMovement{
+			ID:          uuid.New(),
+			MerchantID:  order.MerchantID,
+			OutletID:    order.OutletID,
+			VariantID:   item.VariantID,
+			Type:        movementType,
+			Quantity:    sign * item.Quantity,
+			ReferenceID: uuid.NullUUID{UUID: order.ID, Valid: true},
+			CreatedBy:   createdBy,
+			CreatedAt:   now,
+		}
+		byVariant[item.VariantID] = movement
+		movements = append(movements, movement)
+	}
+	return movements
+}
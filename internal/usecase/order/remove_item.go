@@ -0,0 +1,39 @@
+package order
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// RemoveItem removes an item from a cart of the outlet of the tenant
+func (uc *orderUseCase) RemoveItem(ctx context.Context, orderID, itemID uuid.UUID) (*domain.Order, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.order.RemoveItem")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderCreate); err != nil {
+		return nil, err
+	}
+
+	order, err := uc.getOpenOrder(ctx, tenant, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, item := range order.Items {
+		if item.ID == itemID {
+			order.Items = append(order.Items[:i], order.Items[i+1:]...)
+			return uc.save(ctx, order)
+		}
+	}
+
+	return nil, appErrors.NewNotFoundError("order item not found", nil)
+}
@@ -0,0 +1,33 @@
+package order
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// RemoveVoucher detaches the voucher of a cart of the outlet of the tenant
+func (uc *orderUseCase) RemoveVoucher(ctx context.Context, orderID uuid.UUID) (*domain.Order, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.order.RemoveVoucher")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderCreate); err != nil {
+		return nil, err
+	}
+
+	order, err := uc.getOpenOrder(ctx, tenant, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	order.VoucherID = uuid.NullUUID{}
+	order.VoucherCode = ""
+	return uc.save(ctx, order)
+}
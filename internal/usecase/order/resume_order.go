@@ -0,0 +1,36 @@
+package order
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// ResumeOrder reopens a held cart of the outlet of the tenant
+func (uc *orderUseCase) ResumeOrder(ctx context.Context, orderID uuid.UUID) (*domain.Order, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.order.ResumeOrder")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderCreate); err != nil {
+		return nil, err
+	}
+
+	order, err := uc.getOrder(ctx, tenant, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != domain.OrderStatusHeld {
+		return nil, appErrors.NewConflictError("order is not held", nil)
+	}
+
+	order.Status = domain.OrderStatusOpen
+	return uc.save(ctx, order)
+}
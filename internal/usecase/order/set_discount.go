@@ -0,0 +1,37 @@
+package order
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// SetDiscount sets the discount of a whole cart of the outlet of the tenant,
+// the zero discount removes it
+func (uc *orderUseCase) SetDiscount(ctx context.Context, orderID uuid.UUID, discount domain.Discount) (*domain.Order, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.order.SetDiscount")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderCreate); err != nil {
+		return nil, err
+	}
+	if err := uc.checkDiscount(ctx, "discount", discount); err != nil {
+		return nil, err
+	}
+
+	order, err := uc.getOpenOrder(ctx, tenant, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	order.DiscountType = discount.Type
+	order.DiscountValue = discount.Value
+	return uc.save(ctx, order)
+}
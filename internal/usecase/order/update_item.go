@@ -0,0 +1,54 @@
+package order
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// UpdateItem changes the quantity and the discount of an item of a cart of
+// the outlet of the tenant
+func (uc *orderUseCase) UpdateItem(ctx context.Context, orderID, itemID uuid.UUID, quantity int64, discount domain.Discount) (*domain.Order, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.order.UpdateItem")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderCreate); err != nil {
+		return nil, err
+	}
+	if quantity <= 0 {
+		return nil, appErrors.NewFieldValidationError("quantity", "quantity must be positive")
+	}
+
+	order, err := uc.getOpenOrder(ctx, tenant, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range order.Items {
+		if item.ID != itemID {
+			continue
+		}
+
+		// Keeping a discount given earlier does not need the discount permission
+		if item.DiscountType != discount.Type || !item.DiscountValue.Equal(discount.Value) {
+			if err := uc.checkDiscount(ctx, "discount", discount); err != nil {
+				return nil, err
+			}
+		}
+
+		item.Quantity = quantity
+		item.DiscountType = discount.Type
+		item.DiscountValue = discount.Value
+		return uc.save(ctx, order)
+	}
+
+	return nil, appErrors.NewNotFoundError("order item not found", nil)
+}
@@ -0,0 +1,119 @@
+package order
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/payment"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VoidOrder cancels an order of the outlet of the tenant. Cashiers may void
+// their own carts, voiding other carts and completed sales requires the void
+// permission. The items of a completed sale are returned to stock and the
+// points it redeemed and earned are reversed, a sale with refunds cannot be
+// voided anymore.
+func (uc *orderUseCase) VoidOrder(ctx context.Context, orderID uuid.UUID, reason string) (*domain.Order, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.order.VoidOrder")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderCreate); err != nil {
+		return nil, err
+	}
+	if reason == "" {
+		return nil, appErrors.NewFieldValidationError("reason", "reason is required")
+	}
+
+	order, err := uc.getOrder(ctx, tenant, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status == domain.OrderStatusVoided {
+		return nil, appErrors.NewConflictError("order is already voided", nil)
+	}
+	if order.RefundedTotal > 0 {
+		return nil, appErrors.NewConflictError("order has refunds, refund the remaining items instead", nil)
+	}
+
+	principal, _ := domain.PrincipalFromContext(ctx)
+	ownCart := order.Status != domain.OrderStatusCompleted && principal != nil && principal.UserID == order.CashierID
+	if !ownCart {
+		if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderVoid); err != nil {
+			return nil, err
+		}
+	}
+
+	// The cash of a sale is given back from the drawer of the open shift of the caller
+	var movements []*domain.StockMovement
+	var points []*domain.PointEntry
+	var cash *domain.CashMovement
+	if order.Status == domain.OrderStatusCompleted {
+		movements = stockMovements(ctx, order, domain.StockMovementReturn, 1)
+
+		// Points given back expire as if they were earned now
+		program, err := uc.loyaltyRepo.GetProgram(ctx, order.MerchantID)
+		if err != nil {
+			return nil, err
+		}
+		var expiresAt sql.NullTime
+		if program != nil {
+			expiresAt = program.ExpiresAt(time.Now())
+		}
+		points = pointEntries(ctx, order, domain.PointEntryVoid, order.PointsEarned, order.PointsRedeemed, expiresAt)
+
+		cash = cashMovement(ctx, order, uuid.Nil, domain.CashMovementVoid, -1)
+		if cash != nil {
+			shift, err := uc.openShift(ctx, tenant)
+			if err != nil {
+				return nil, err
+			}
+			if shift == nil {
+				return nil, appErrors.NewConflictError("no shift is open, open a shift to give back the cash of the sale", nil)
+			}
+			cash.ShiftID = shift.ID
+		}
+	}
+
+	// Payments are settled first, a failed refund leaves the order as it is
+	for _, p := range order.Payments {
+		if p.Status != domain.PaymentStatusCaptured && !p.Status.IsOpen() {
+			continue
+		}
+		from := p.Status
+		if err := payment.Refund(ctx, uc.gateways, p); err != nil {
+			return nil, err
+		}
+		if err := uc.paymentRepo.UpdateStatus(ctx, p, from); err != nil {
+			if errors.Is(err, domain.ErrPaymentChanged) {
+				return nil, appErrors.NewConflictError("payments of the order were changed by another request, reload it and try again", err)
+			}
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	order.Status = domain.OrderStatusVoided
+	order.VoidReason = reason
+	order.UpdatedAt.Time = now
+	order.UpdatedAt.Valid = true
+	order.VoidedAt.Time = now
+	order.VoidedAt.Valid = true
+	if err := uc.orderRepo.Void(ctx, order, movements, points, cash); err != nil {
+		if errors.Is(err, domain.ErrShiftClosed) {
+			return nil, appErrors.NewConflictError("shift was closed, open a shift to give back the cash of the sale", err)
+		}
+		return nil, changed(err)
+	}
+
+	return order, nil
+}
@@ -0,0 +1,70 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/payment"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AddPayment starts a payment for a cart of the outlet of the tenant. Cash
+// and card payments are captured at once, QRIS and e-wallet payments return
+// pending with the QR code the customer scans.
+func (uc *paymentUseCase) AddPayment(ctx context.Context, orderID uuid.UUID, p *domain.Payment) (*domain.Payment, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.payment.AddPayment")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderCreate); err != nil {
+		return nil, err
+	}
+	if p.Amount <= 0 {
+		return nil, appErrors.NewFieldValidationError("amount", "amount must be positive")
+	}
+	if !p.CalculateChange() {
+		return nil, appErrors.NewFieldValidationError("tendered", "tendered cash must cover the amount")
+	}
+
+	order, err := uc.orderRepo.GetByID(ctx, tenant.MerchantID, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil || order.OutletID != tenant.OutletID {
+		return nil, appErrors.NewNotFoundError("order not found", nil)
+	}
+	if !order.IsEditable() {
+		return nil, appErrors.NewConflictError(fmt.Sprintf("order is %s", order.Status), nil)
+	}
+
+	outstanding := order.Total
+	for _, existing := range order.Payments {
+		if existing.Status == domain.PaymentStatusCaptured || existing.Status.IsOpen() {
+			outstanding -= existing.Amount
+		}
+	}
+	if p.Amount > outstanding {
+		return nil, appErrors.NewFieldValidationError("amount", fmt.Sprintf("amount exceeds the outstanding %d", outstanding))
+	}
+
+	p.ID = uuid.New()
+	p.OrderID = order.ID
+	p.MerchantID = order.MerchantID
+	p.CreatedAt = time.Now()
+	if err := payment.Charge(ctx, uc.gateways, p); err != nil {
+		return nil, err
+	}
+	if err := uc.paymentRepo.Create(ctx, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
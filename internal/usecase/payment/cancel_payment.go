@@ -0,0 +1,55 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// CancelPayment cancels a payment of a cart of the outlet of the tenant, e.g.
+// when the customer switches to another tender. Refunding a captured payment
+// other than cash, which is still in the till, requires the void permission.
+func (uc *paymentUseCase) CancelPayment(ctx context.Context, id uuid.UUID) (*domain.Payment, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.payment.CancelPayment")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderCreate); err != nil {
+		return nil, err
+	}
+
+	p, order, err := uc.getPayment(ctx, tenant, id)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status == domain.OrderStatusCompleted || order.Status == domain.OrderStatusVoided {
+		return nil, appErrors.NewConflictError(fmt.Sprintf("order is %s, void the order instead", order.Status), nil)
+	}
+
+	switch {
+	case p.Status.IsOpen():
+	case p.Status == domain.PaymentStatusCaptured:
+		if p.Method != domain.PaymentMethodCash {
+			if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderVoid); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, appErrors.NewConflictError(fmt.Sprintf("payment is %s", p.Status), nil)
+	}
+
+	// The gateway cancels a payment the customer has not paid yet
+	if err := uc.refund(ctx, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
@@ -0,0 +1,44 @@
+package payment
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/payment"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// GetPayment returns a payment of the outlet of the tenant. The gateway is
+// asked for the status of a pending payment, in case its notification is late.
+func (uc *paymentUseCase) GetPayment(ctx context.Context, id uuid.UUID) (*domain.Payment, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.payment.GetPayment")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderRead); err != nil {
+		return nil, err
+	}
+
+	p, _, err := uc.getPayment(ctx, tenant, id)
+	if err != nil {
+		return nil, err
+	}
+	if !p.Status.IsOpen() {
+		return p, nil
+	}
+
+	result, err := payment.Status(ctx, uc.gateways, p)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.sync(ctx, p, result); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
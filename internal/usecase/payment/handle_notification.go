@@ -0,0 +1,55 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+)
+
+// HandleNotification applies a notification a gateway sent to its webhook.
+// The status is read back from the gateway rather than taken from the
+// notification, and notifications may arrive more than once.
+func (uc *paymentUseCase) HandleNotification(ctx context.Context, provider string, payload []byte, signature string) error {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.payment.HandleNotification")
+	defer span.End()
+
+	gateway, ok := uc.gateways.ByName(provider)
+	if !ok {
+		return appErrors.NewNotFoundError("payment gateway not found", nil)
+	}
+	notifier, ok := gateway.(domain.PaymentNotifier)
+	if !ok {
+		return appErrors.NewBadRequestError("payment gateway does not send notifications", nil)
+	}
+
+	notification, err := notifier.ParseNotification(payload, signature)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidNotificationSignature) {
+			return appErrors.NewUnauthorizedError("invalid notification signature", err)
+		}
+		return appErrors.NewBadRequestError("invalid notification", err)
+	}
+
+	p, err := uc.paymentRepo.GetByProviderReference(ctx, provider, notification.Reference)
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		return appErrors.NewNotFoundError("payment not found", nil)
+	}
+
+	result, err := gateway.Status(ctx, p.ProviderReference)
+	if err != nil {
+		return err
+	}
+
+	err = uc.sync(ctx, p, result)
+	var appErr *appErrors.AppError
+	if errors.As(err, &appErr) && appErr.Category == appErrors.CategoryConflict {
+		// A concurrent request applied the same status
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,109 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"github/kijunpos/internal/domain"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/payment"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type paymentUseCase struct {
+	paymentRepo          domain.PaymentRepository
+	orderRepo            domain.OrderRepository
+	gateways             domain.PaymentGateways
+	authorizationService domain.AuthorizationService
+}
+
+// NewPaymentUseCase creates a new payment use case
+func NewPaymentUseCase(
+	paymentRepo domain.PaymentRepository,
+	orderRepo domain.OrderRepository,
+	gateways domain.PaymentGateways,
+	authorizationService domain.AuthorizationService,
+) domain.PaymentUseCase {
+	return &paymentUseCase{
+		paymentRepo:          paymentRepo,
+		orderRepo:            orderRepo,
+		gateways:             gateways,
+		authorizationService: authorizationService,
+	}
+}
+
+// getPayment returns a payment of an order of the outlet of the tenant together with the order
+func (uc *paymentUseCase) getPayment(ctx context.Context, tenant *domain.Tenant, id uuid.UUID) (*domain.Payment, *domain.Order, error) {
+	p, err := uc.paymentRepo.GetByID(ctx, tenant.MerchantID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if p == nil {
+		return nil, nil, appErrors.NewNotFoundError("payment not found", nil)
+	}
+
+	order, err := uc.orderRepo.GetByID(ctx, tenant.MerchantID, p.OrderID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if order == nil || order.OutletID != tenant.OutletID {
+		return nil, nil, appErrors.NewNotFoundError("payment not found", nil)
+	}
+	return p, order, nil
+}
+
+// sync stores the status the gateway reports for a payment. The money of a
+// payment captured after it was cancelled or after its order was voided is
+// given back.
+func (uc *paymentUseCase) sync(ctx context.Context, p *domain.Payment, result *domain.PaymentResult) error {
+	switch {
+	case p.Status.IsOpen() && result.Status != p.Status:
+		if err := uc.setStatus(ctx, p, result.Status); err != nil {
+			return err
+		}
+		if p.Status != domain.PaymentStatusCaptured {
+			return nil
+		}
+
+		order, err := uc.orderRepo.GetByID(ctx, p.MerchantID, p.OrderID)
+		if err != nil {
+			return err
+		}
+		if order != nil && order.Status == domain.OrderStatusVoided {
+			return uc.refund(ctx, p)
+		}
+		return nil
+	case p.Status == domain.PaymentStatusVoided && result.Status == domain.PaymentStatusCaptured:
+		return uc.refund(ctx, p)
+	default:
+		return nil
+	}
+}
+
+// refund refunds a payment through its gateway and stores the outcome
+func (uc *paymentUseCase) refund(ctx context.Context, p *domain.Payment) error {
+	from := p.Status
+	if err := payment.Refund(ctx, uc.gateways, p); err != nil {
+		return err
+	}
+	return uc.store(ctx, p, from)
+}
+
+// setStatus changes the status of a payment and stores it
+func (uc *paymentUseCase) setStatus(ctx context.Context, p *domain.Payment, status domain.PaymentStatus) error {
+	from := p.Status
+	p.Status = status
+	p.UpdatedAt.Time = time.Now()
+	p.UpdatedAt.Valid = true
+	return uc.store(ctx, p, from)
+}
+
+// store stores the status of a payment changed from the status from
+func (uc *paymentUseCase) store(ctx context.Context, p *domain.Payment, from domain.PaymentStatus) error {
+	err := uc.paymentRepo.UpdateStatus(ctx, p, from)
+	if errors.Is(err, domain.ErrPaymentChanged) {
+		return appErrors.NewConflictError("payment was changed by another request, reload it and try again", err)
+	}
+	return err
+}
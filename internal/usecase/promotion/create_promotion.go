@@ -0,0 +1,39 @@
+package promotion
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreatePromotion adds a promotion to the merchant of the tenant
+func (uc *promotionUseCase) CreatePromotion(ctx context.Context, promotion *domain.Promotion) (*domain.Promotion, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.promotion.CreatePromotion")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionPromotionManage); err != nil {
+		return nil, err
+	}
+
+	promotion.UsageCount = 0
+	if err := uc.validatePromotion(ctx, tenant, promotion); err != nil {
+		return nil, err
+	}
+
+	promotion.ID = uuid.New()
+	promotion.MerchantID = tenant.MerchantID
+	promotion.CreatedAt = time.Now()
+	if err := uc.promotionRepo.Create(ctx, promotion); err != nil {
+		return nil, err
+	}
+
+	return promotion, nil
+}
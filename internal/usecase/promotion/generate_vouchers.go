@@ -0,0 +1,70 @@
+package promotion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GenerateVouchers creates single-use vouchers with random codes for a
+// promotion of the merchant of the tenant
+func (uc *promotionUseCase) GenerateVouchers(ctx context.Context, promotionID uuid.UUID, count int) ([]*domain.Voucher, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.promotion.GenerateVouchers")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionPromotionManage); err != nil {
+		return nil, err
+	}
+	if count < 1 || count > maxVouchers {
+		return nil, appErrors.NewFieldValidationError("count", fmt.Sprintf("count must be between 1 and %d", maxVouchers))
+	}
+
+	promotion, err := uc.getPromotion(ctx, tenant, promotionID)
+	if err != nil {
+		return nil, err
+	}
+	if !promotion.RequiresVoucher {
+		return nil, appErrors.NewBadRequestError("promotion applies without a voucher", nil)
+	}
+
+	now := time.Now()
+	codes := make(map[string]bool, count)
+	vouchers := make([]*domain.Voucher, 0, count)
+	for len(vouchers) < count {
+		code, err := voucherCode()
+		if err != nil {
+			return nil, err
+		}
+		if codes[code] {
+			continue
+		}
+		codes[code] = true
+		vouchers = append(vouchers, &domain.Voucher{
+			ID:          uuid.New(),
+			MerchantID:  tenant.MerchantID,
+			PromotionID: promotion.ID,
+			Code:        code,
+			CreatedAt:   now,
+		})
+	}
+
+	if err := uc.promotionRepo.CreateVouchers(ctx, vouchers); err != nil {
+		if errors.Is(err, domain.ErrVoucherCodeExists) {
+			return nil, appErrors.NewConflictError("a generated code is taken, generate the vouchers again", err)
+		}
+		return nil, err
+	}
+
+	return vouchers, nil
+}
@@ -0,0 +1,26 @@
+package promotion
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// GetPromotion retrieves a promotion of the merchant of the tenant
+func (uc *promotionUseCase) GetPromotion(ctx context.Context, id uuid.UUID) (*domain.Promotion, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.promotion.GetPromotion")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionPromotionManage); err != nil {
+		return nil, err
+	}
+
+	return uc.getPromotion(ctx, tenant, id)
+}
@@ -0,0 +1,24 @@
+package promotion
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/tenancy"
+)
+
+// ListPromotions lists the promotions of the merchant of the tenant, newest first
+func (uc *promotionUseCase) ListPromotions(ctx context.Context) ([]*domain.Promotion, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.promotion.ListPromotions")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionPromotionManage); err != nil {
+		return nil, err
+	}
+
+	return uc.promotionRepo.List(ctx, tenant.MerchantID)
+}
@@ -0,0 +1,50 @@
+package promotion
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/pagination"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// ListVouchers lists the vouchers of a promotion of the merchant of the
+// tenant, newest first, one page at a time
+func (uc *promotionUseCase) ListVouchers(ctx context.Context, promotionID uuid.UUID, pageSize int, cursor string) (*domain.VoucherPage, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.promotion.ListVouchers")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionPromotionManage); err != nil {
+		return nil, err
+	}
+
+	pageSize = pagination.PageSize(pageSize)
+	after, err := pagination.DecodeCursor(cursor)
+	if err != nil {
+		return nil, appErrors.NewFieldValidationError("cursor", "invalid cursor")
+	}
+
+	if _, err := uc.getPromotion(ctx, tenant, promotionID); err != nil {
+		return nil, err
+	}
+	vouchers, err := uc.promotionRepo.ListVouchers(ctx, tenant.MerchantID, promotionID, after, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.VoucherPage{Vouchers: vouchers}
+	if len(vouchers) > pageSize {
+		page.Vouchers = vouchers[:pageSize]
+		last := page.Vouchers[pageSize-1]
+		page.NextCursor = pagination.EncodeCursor(domain.PageCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+
+	return page, nil
+}
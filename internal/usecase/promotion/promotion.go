@@ -0,0 +1,190 @@
+package promotion
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Voucher codes are drawn from letters and digits that cannot be mistaken
+// for one another when read out or typed in
+const (
+	voucherAlphabet   = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	voucherCodeLength = 10
+	maxVouchers       = 1000
+)
+
+type promotionUseCase struct {
+	promotionRepo        domain.PromotionRepository
+	outletRepo           domain.OutletRepository
+	categoryRepo         domain.CategoryRepository
+	productRepo          domain.ProductRepository
+	authorizationService domain.AuthorizationService
+}
+
+// NewPromotionUseCase creates a new promotion use case
+func NewPromotionUseCase(
+	promotionRepo domain.PromotionRepository,
+	outletRepo domain.OutletRepository,
+	categoryRepo domain.CategoryRepository,
+	productRepo domain.ProductRepository,
+	authorizationService domain.AuthorizationService,
+) domain.PromotionUseCase {
+	return &promotionUseCase{
+		promotionRepo:        promotionRepo,
+		outletRepo:           outletRepo,
+		categoryRepo:         categoryRepo,
+		productRepo:          productRepo,
+		authorizationService: authorizationService,
+	}
+}
+
+// getPromotion returns a promotion of the merchant of the tenant
+func (uc *promotionUseCase) getPromotion(ctx context.Context, tenant *domain.Tenant, id uuid.UUID) (*domain.Promotion, error) {
+	promotion, err := uc.promotionRepo.GetByID(ctx, tenant.MerchantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if promotion == nil {
+		return nil, appErrors.NewNotFoundError("promotion not found", nil)
+	}
+	return promotion, nil
+}
+
+// validatePromotion checks the rules of a promotion for its type and that its
+// outlet, category and product belong to the merchant of the tenant
+func (uc *promotionUseCase) validatePromotion(ctx context.Context, tenant *domain.Tenant, p *domain.Promotion) error {
+	if p.Name == "" {
+		return appErrors.NewFieldValidationError("name", "name is required")
+	}
+
+	switch p.DiscountType {
+	case domain.DiscountTypeAmount:
+		if !p.DiscountValue.IsPositive() || !p.DiscountValue.IsInteger() {
+			return appErrors.NewFieldValidationError("discount_value", "discount amount must be a positive whole amount")
+		}
+	case domain.DiscountTypePercent:
+		if !p.DiscountValue.IsPositive() || p.DiscountValue.GreaterThan(decimal.NewFromInt(100)) {
+			return appErrors.NewFieldValidationError("discount_value", "discount percentage must be greater than 0 and at most 100")
+		}
+	default:
+		return appErrors.NewFieldValidationError("discount_type", "unknown discount type")
+	}
+
+	if p.CategoryID.Valid && p.ProductID.Valid {
+		return appErrors.NewFieldValidationError("category_id", "a promotion applies to a category or a product, not both")
+	}
+	switch p.Type {
+	case domain.PromotionTypeItemDiscount:
+	case domain.PromotionTypeBuyXGetY:
+		if p.BuyQuantity <= 0 || p.GetQuantity <= 0 {
+			return appErrors.NewFieldValidationError("buy_quantity", "buy and get quantities must be positive")
+		}
+		if p.DiscountType != domain.DiscountTypePercent {
+			return appErrors.NewFieldValidationError("discount_type", "buy x get y discounts a percentage of the units given")
+		}
+	case domain.PromotionTypeOrderDiscount:
+		if p.CategoryID.Valid || p.ProductID.Valid {
+			return appErrors.NewFieldValidationError("category_id", "an order discount applies to the whole order")
+		}
+	default:
+		return appErrors.NewFieldValidationError("type", "invalid promotion type")
+	}
+	if p.Type != domain.PromotionTypeBuyXGetY && (p.BuyQuantity != 0 || p.GetQuantity != 0) {
+		return appErrors.NewFieldValidationError("buy_quantity", "buy and get quantities only apply to buy x get y")
+	}
+	if p.Type != domain.PromotionTypeOrderDiscount && p.MinSpend != 0 {
+		return appErrors.NewFieldValidationError("min_spend", "minimum spend only applies to order discounts")
+	}
+	if p.MinSpend < 0 {
+		return appErrors.NewFieldValidationError("min_spend", "minimum spend must not be negative")
+	}
+
+	if err := validateWindow(p); err != nil {
+		return err
+	}
+	if p.UsageLimit.Valid && p.UsageLimit.Int64 < max(1, p.UsageCount) {
+		return appErrors.NewFieldValidationError("usage_limit", fmt.Sprintf("usage limit must be at least %d", max(1, p.UsageCount)))
+	}
+
+	if p.OutletID.Valid {
+		outlet, err := uc.outletRepo.GetByID(ctx, tenant.MerchantID, p.OutletID.UUID)
+		if err != nil {
+			return err
+		}
+		if outlet == nil {
+			return appErrors.NewFieldValidationError("outlet_id", "outlet not found")
+		}
+	}
+	if p.CategoryID.Valid {
+		category, err := uc.categoryRepo.GetByID(ctx, tenant.MerchantID, p.CategoryID.UUID)
+		if err != nil {
+			return err
+		}
+		if category == nil {
+			return appErrors.NewFieldValidationError("category_id", "category not found")
+		}
+	}
+	if p.ProductID.Valid {
+		product, err := uc.productRepo.GetByID(ctx, tenant.MerchantID, p.ProductID.UUID)
+		if err != nil {
+			return err
+		}
+		if product == nil {
+			return appErrors.NewFieldValidationError("product_id", "product not found")
+		}
+	}
+	return nil
+}
+
+// validateWindow checks the period, the weekdays and the daily window of a
+// promotion and normalizes the daily window
+func validateWindow(p *domain.Promotion) error {
+	if p.StartsAt.IsZero() {
+		return appErrors.NewFieldValidationError("starts_at", "start time is required")
+	}
+	if p.EndsAt.Valid && !p.EndsAt.Time.After(p.StartsAt) {
+		return appErrors.NewFieldValidationError("ends_at", "end time must be after the start time")
+	}
+	if p.Weekdays < 0 || p.Weekdays >= 1<<7 {
+		return appErrors.NewFieldValidationError("weekdays", "invalid weekdays")
+	}
+	if p.DailyFrom == "" && p.DailyUntil == "" {
+		return nil
+	}
+	from, err := time.Parse("15:04", p.DailyFrom)
+	if err != nil {
+		return appErrors.NewFieldValidationError("daily_from", "daily_from must be a time in HH:MM format")
+	}
+	until, err := time.Parse("15:04", p.DailyUntil)
+	if err != nil {
+		return appErrors.NewFieldValidationError("daily_until", "daily_until must be a time in HH:MM format")
+	}
+	// Windows are compared as text, so 9:00 is stored as 09:00
+	p.DailyFrom, p.DailyUntil = from.Format("15:04"), until.Format("15:04")
+	if p.DailyFrom == p.DailyUntil {
+		return appErrors.NewFieldValidationError("daily_until", "daily window must not be empty")
+	}
+	return nil
+}
+
+// voucherCode returns a random voucher code
+func voucherCode() (string, error) {
+	code := make([]byte, voucherCodeLength)
+	limit := big.NewInt(int64(len(voucherAlphabet)))
+	for i := range code {
+		n, err := rand.Int(rand.Reader, limit)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate voucher code: %w", err)
+		}
+		code[i] = voucherAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
@@ -0,0 +1,45 @@
+package promotion
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+)
+
+// UpdatePromotion changes a promotion of the merchant of the tenant, its type
+// and usage count stay the same
+func (uc *promotionUseCase) UpdatePromotion(ctx context.Context, promotion *domain.Promotion) (*domain.Promotion, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.promotion.UpdatePromotion")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionPromotionManage); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.getPromotion(ctx, tenant, promotion.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	promotion.MerchantID = existing.MerchantID
+	promotion.Type = existing.Type
+	promotion.UsageCount = existing.UsageCount
+	promotion.CreatedAt = existing.CreatedAt
+	if err := uc.validatePromotion(ctx, tenant, promotion); err != nil {
+		return nil, err
+	}
+
+	promotion.UpdatedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	if err := uc.promotionRepo.Update(ctx, promotion); err != nil {
+		return nil, err
+	}
+
+	return promotion, nil
+}
@@ -0,0 +1,42 @@
+package receipt
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/receipt"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// GetReceipt renders the receipt of a sale of the outlet of the tenant, voided
+// sales are marked as such
+func (uc *receiptUseCase) GetReceipt(ctx context.Context, orderID uuid.UUID, format domain.ReceiptFormat, width domain.PaperWidth) (*domain.ReceiptDocument, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.receipt.GetReceipt")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderRead); err != nil {
+		return nil, err
+	}
+	switch format {
+	case domain.ReceiptFormatText, domain.ReceiptFormatESCPOS, domain.ReceiptFormatPDF:
+	default:
+		return nil, appErrors.NewFieldValidationError("format", "format must be text, escpos or pdf")
+	}
+	if _, err := receipt.Columns(width); err != nil {
+		return nil, appErrors.NewFieldValidationError("paper_width", "paper width must be 58 or 80")
+	}
+
+	r, err := uc.getReceipt(ctx, tenant, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return receipt.Render(r, format, width)
+}
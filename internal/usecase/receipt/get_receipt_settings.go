@@ -0,0 +1,24 @@
+package receipt
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/tenancy"
+)
+
+// GetReceiptSettings returns the receipt settings of the outlet of the tenant
+func (uc *receiptUseCase) GetReceiptSettings(ctx context.Context) (*domain.ReceiptSettings, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.receipt.GetReceiptSettings")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderRead); err != nil {
+		return nil, err
+	}
+
+	return uc.getSettings(ctx, tenant)
+}
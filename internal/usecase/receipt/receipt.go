@@ -0,0 +1,109 @@
+package receipt
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	appErrors "github/kijunpos/internal/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+type receiptUseCase struct {
+	receiptRepo          domain.ReceiptRepository
+	orderRepo            domain.OrderRepository
+	merchantRepo         domain.MerchantRepository
+	outletRepo           domain.OutletRepository
+	userRepo             domain.UserRepository
+	emailService         domain.EmailService
+	whatsAppService      domain.WhatsAppService
+	authorizationService domain.AuthorizationService
+}
+
+// NewReceiptUseCase creates a new receipt use case
+func NewReceiptUseCase(
+	receiptRepo domain.ReceiptRepository,
+	orderRepo domain.OrderRepository,
+	merchantRepo domain.MerchantRepository,
+	outletRepo domain.OutletRepository,
+	userRepo domain.UserRepository,
+	emailService domain.EmailService,
+	whatsAppService domain.WhatsAppService,
+	authorizationService domain.AuthorizationService,
+) domain.ReceiptUseCase {
+	return &receiptUseCase{
+		receiptRepo:          receiptRepo,
+		orderRepo:            orderRepo,
+		merchantRepo:         merchantRepo,
+		outletRepo:           outletRepo,
+		userRepo:             userRepo,
+		emailService:         emailService,
+		whatsAppService:      whatsAppService,
+		authorizationService: authorizationService,
+	}
+}
+
+// getSettings returns the receipt settings of the outlet of the tenant, empty
+// settings when they were never saved
+func (uc *receiptUseCase) getSettings(ctx context.Context, tenant *domain.Tenant) (*domain.ReceiptSettings, error) {
+	settings, err := uc.receiptRepo.GetSettings(ctx, tenant.MerchantID, tenant.OutletID)
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		settings = &domain.ReceiptSettings{MerchantID: tenant.MerchantID, OutletID: tenant.OutletID}
+	}
+	return settings, nil
+}
+
+// getReceipt collects what is printed on the receipt of a completed or voided
+// sale of the outlet of the tenant
+func (uc *receiptUseCase) getReceipt(ctx context.Context, tenant *domain.Tenant, orderID uuid.UUID) (*domain.Receipt, error) {
+	order, err := uc.orderRepo.GetByID(ctx, tenant.MerchantID, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil || order.OutletID != tenant.OutletID {
+		return nil, appErrors.NewNotFoundError("order not found", nil)
+	}
+	if !order.Number.Valid {
+		return nil, appErrors.NewConflictError("order is not checked out yet", nil)
+	}
+
+	merchant, err := uc.merchantRepo.GetByID(ctx, tenant.MerchantID)
+	if err != nil {
+		return nil, err
+	}
+	if merchant == nil {
+		return nil, appErrors.NewNotFoundError("merchant not found", nil)
+	}
+	outlet, err := uc.outletRepo.GetByID(ctx, tenant.MerchantID, tenant.OutletID)
+	if err != nil {
+		return nil, err
+	}
+	if outlet == nil {
+		return nil, appErrors.NewNotFoundError("outlet not found", nil)
+	}
+	settings, err := uc.getSettings(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	// A deleted cashier is left off the receipt
+	var cashierName string
+	cashier, err := uc.userRepo.GetByID(ctx, order.CashierID)
+	if err != nil {
+		return nil, err
+	}
+	if cashier != nil {
+		cashierName = cashier.UserName
+	}
+
+	return &domain.Receipt{
+		MerchantName: merchant.Name,
+		Outlet:       outlet,
+		Settings:     settings,
+		Order:        order,
+		CashierName:  cashierName,
+		PrintedAt:    order.CompletedAt.Time,
+	}, nil
+}
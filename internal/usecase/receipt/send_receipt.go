@@ -0,0 +1,65 @@
+package receipt
+
+import (
+	"context"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/receipt"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// SendReceipt sends the receipt of a completed sale of the outlet of the
+// tenant. Emails carry the 80mm text receipt with the PDF attached, WhatsApp
+// messages the 58mm text receipt which fits on a phone screen.
+func (uc *receiptUseCase) SendReceipt(ctx context.Context, orderID uuid.UUID, channel domain.ReceiptChannel, recipient string) error {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.receipt.SendReceipt")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderCreate); err != nil {
+		return err
+	}
+	if recipient == "" {
+		return appErrors.NewFieldValidationError("recipient", "recipient is required")
+	}
+
+	r, err := uc.getReceipt(ctx, tenant, orderID)
+	if err != nil {
+		return err
+	}
+	if r.Order.Status != domain.OrderStatusCompleted {
+		return appErrors.NewConflictError("only receipts of completed sales can be sent", nil)
+	}
+
+	message := &domain.ReceiptMessage{
+		Subject: fmt.Sprintf("Receipt No. %d from %s", r.Order.Number.Int64, r.MerchantName),
+	}
+	switch channel {
+	case domain.ReceiptChannelEmail:
+		text, err := receipt.Render(r, domain.ReceiptFormatText, domain.PaperWidth80)
+		if err != nil {
+			return err
+		}
+		if message.Attachment, err = receipt.Render(r, domain.ReceiptFormatPDF, domain.PaperWidth80); err != nil {
+			return err
+		}
+		message.Text = string(text.Content)
+		return uc.emailService.SendReceipt(ctx, recipient, message)
+	case domain.ReceiptChannelWhatsApp:
+		text, err := receipt.Render(r, domain.ReceiptFormatText, domain.PaperWidth58)
+		if err != nil {
+			return err
+		}
+		message.Text = string(text.Content)
+		return uc.whatsAppService.SendReceipt(ctx, recipient, message)
+	default:
+		return appErrors.NewFieldValidationError("channel", "channel must be email or whatsapp")
+	}
+}
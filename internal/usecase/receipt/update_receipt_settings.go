@@ -0,0 +1,42 @@
+package receipt
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/receipt"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+)
+
+// UpdateReceiptSettings replaces the receipt settings of the outlet of the
+// tenant, an empty logo removes it
+func (uc *receiptUseCase) UpdateReceiptSettings(ctx context.Context, settings *domain.ReceiptSettings) (*domain.ReceiptSettings, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.receipt.UpdateReceiptSettings")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOutletManage); err != nil {
+		return nil, err
+	}
+
+	if len(settings.Logo) == 0 {
+		settings.Logo = nil
+	} else if _, err := receipt.DecodeLogo(settings.Logo); err != nil {
+		return nil, appErrors.NewFieldValidationError("logo", "logo must be a PNG or JPEG image of at most 256 KB and 576x576 pixels")
+	}
+
+	settings.MerchantID = tenant.MerchantID
+	settings.OutletID = tenant.OutletID
+	settings.UpdatedAt.Time = time.Now()
+	settings.UpdatedAt.Valid = true
+	if err := uc.receiptRepo.SaveSettings(ctx, settings); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,35 @@
+package refund
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// GetRefund returns a refund of the outlet of the tenant
+func (uc *refundUseCase) GetRefund(ctx context.Context, id uuid.UUID) (*domain.Refund, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.refund.GetRefund")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderRead); err != nil {
+		return nil, err
+	}
+
+	refund, err := uc.refundRepo.GetByID(ctx, tenant.MerchantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if refund == nil || refund.OutletID != tenant.OutletID {
+		return nil, appErrors.NewNotFoundError("refund not found", nil)
+	}
+
+	return refund, nil
+}
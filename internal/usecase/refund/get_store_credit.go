@@ -0,0 +1,35 @@
+package refund
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+	"strings"
+)
+
+// GetStoreCredit returns a store credit of the merchant of the tenant by its
+// code. Credits can be spent at every outlet of the merchant.
+func (uc *refundUseCase) GetStoreCredit(ctx context.Context, code string) (*domain.StoreCredit, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.refund.GetStoreCredit")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderCreate); err != nil {
+		return nil, err
+	}
+
+	credit, err := uc.refundRepo.GetStoreCreditByCode(ctx, tenant.MerchantID, strings.ToUpper(strings.TrimSpace(code)))
+	if err != nil {
+		return nil, err
+	}
+	if credit == nil {
+		return nil, appErrors.NewNotFoundError("store credit not found", nil)
+	}
+
+	return credit, nil
+}
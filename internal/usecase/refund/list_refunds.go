@@ -0,0 +1,31 @@
+package refund
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// ListRefunds lists the refunds of an order of the outlet of the tenant
+func (uc *refundUseCase) ListRefunds(ctx context.Context, orderID uuid.UUID) ([]*domain.Refund, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.refund.ListRefunds")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderRead); err != nil {
+		return nil, err
+	}
+
+	order, err := uc.getOrder(ctx, tenant, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.refundRepo.ListByOrder(ctx, tenant.MerchantID, order.ID)
+}
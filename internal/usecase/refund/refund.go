@@ -0,0 +1,271 @@
+package refund
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/payment"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// storeCreditAlphabet leaves out characters that are easily mixed up, e.g. 0 and O
+const storeCreditAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+type refundUseCase struct {
+	refundRepo           domain.RefundRepository
+	orderRepo            domain.OrderRepository
+	shiftRepo            domain.ShiftRepository
+	loyaltyRepo          domain.LoyaltyRepository
+	gateways             domain.PaymentGateways
+	authorizationService domain.AuthorizationService
+	approvalThreshold    int64
+}
+
+// NewRefundUseCase creates a new refund use case. Refunds of more than
+// approvalThreshold require the refund approval permission.
+func NewRefundUseCase(
+	refundRepo domain.RefundRepository,
+	orderRepo domain.OrderRepository,
+	shiftRepo domain.ShiftRepository,
+	loyaltyRepo domain.LoyaltyRepository,
+	gateways domain.PaymentGateways,
+	authorizationService domain.AuthorizationService,
+	approvalThreshold int64,
+) domain.RefundUseCase {
+	return &refundUseCase{
+		refundRepo:           refundRepo,
+		orderRepo:            orderRepo,
+		shiftRepo:            shiftRepo,
+		loyaltyRepo:          loyaltyRepo,
+		gateways:             gateways,
+		authorizationService: authorizationService,
+		approvalThreshold:    approvalThreshold,
+	}
+}
+
+// getOrder returns an order of the outlet of the tenant
+func (uc *refundUseCase) getOrder(ctx context.Context, tenant *domain.Tenant, id uuid.UUID) (*domain.Order, error) {
+	order, err := uc.orderRepo.GetByID(ctx, tenant.MerchantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil || order.OutletID != tenant.OutletID {
+		return nil, appErrors.NewNotFoundError("order not found", nil)
+	}
+	return order, nil
+}
+
+// finish gives the money of a pending refund back and completes it. Parts
+// given back before are skipped, so a refund that failed halfway is finished
+// by repeating it. The refund is attributed to the open shift of the caller,
+// which is required to give cash back from its drawer.
+func (uc *refundUseCase) finish(ctx context.Context, order *domain.Order, refund *domain.Refund) (*domain.Refund, error) {
+	cash, err := uc.cashMovement(ctx, order, refund)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, part := range refund.Payments {
+		if part.Status == domain.PaymentStatusRefunded {
+			continue
+		}
+		p := findPayment(order, part.PaymentID)
+		if p == nil {
+			return nil, fmt.Errorf("payment %s of refund %s not found", part.PaymentID, refund.ID)
+		}
+		if _, err := payment.RefundPart(ctx, uc.gateways, p, part.Amount); err != nil {
+			return nil, err
+		}
+
+		part.Status = domain.PaymentStatusRefunded
+		part.UpdatedAt.Time = time.Now()
+		part.UpdatedAt.Valid = true
+		if err := uc.refundRepo.UpdatePayment(ctx, part); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	if refund.Tender == domain.RefundTenderStoreCredit && refund.Amount > 0 {
+		code, err := generateStoreCreditCode()
+		if err != nil {
+			return nil, err
+		}
+		refund.StoreCredit = &domain.StoreCredit{
+			ID:         uuid.New(),
+			MerchantID: refund.MerchantID,
+			RefundID:   refund.ID,
+			Code:       code,
+			Amount:     refund.Amount,
+			Balance:    refund.Amount,
+			CreatedAt:  now,
+		}
+	}
+
+	refund.Status = domain.RefundStatusCompleted
+	refund.CompletedAt.Time = now
+	refund.CompletedAt.Valid = true
+	points, err := uc.pointEntries(ctx, order, refund)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.refundRepo.Complete(ctx, refund, restockMovements(order, refund), points, cash); err != nil {
+		if errors.Is(err, domain.ErrRefundChanged) {
+			// A concurrent repeat of the refund completed it first
+			return uc.refundRepo.GetByID(ctx, refund.MerchantID, refund.ID)
+		}
+		if errors.Is(err, domain.ErrShiftClosed) {
+			return nil, appErrors.NewConflictError("shift was closed, open a shift and repeat the refund", err)
+		}
+		return nil, err
+	}
+
+	return refund, nil
+}
+
+// cashMovement sets the shift of the refund to the open shift of the caller and
+// creates the cash ledger entry of its cash parts, nil when no cash is given back
+func (uc *refundUseCase) cashMovement(ctx context.Context, order *domain.Order, refund *domain.Refund) (*domain.CashMovement, error) {
+	var cash int64
+	for _, part := range refund.Payments {
+		if part.Method == domain.PaymentMethodCash {
+			cash += part.Amount
+		}
+	}
+
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, appErrors.NewUnauthorizedError("unauthenticated", nil)
+	}
+	shift, err := uc.shiftRepo.GetOpen(ctx, order.MerchantID, order.OutletID, principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if shift == nil {
+		if cash > 0 {
+			return nil, appErrors.NewConflictError("no shift is open, open a shift to give back cash", nil)
+		}
+		return nil, nil
+	}
+
+	refund.ShiftID = uuid.NullUUID{UUID: shift.ID, Valid: true}
+	if cash == 0 {
+		return nil, nil
+	}
+	return &domain.CashMovement{
+		ID:          uuid.New(),
+		MerchantID:  refund.MerchantID,
+		ShiftID:     shift.ID,
+		Type:        domain.CashMovementRefund,
+		Amount:      -cash,
+		ReferenceID: uuid.NullUUID{UUID: refund.ID, Valid: true},
+		Reason:      refund.Reason,
+		CreatedBy:   principal.UserID,
+		CreatedAt:   time.Now(),
+	}, nil
+}
+
+// pointEntries creates the entries taking back the share of the points the
+// sale earned its customer and giving back the share of the points it
+// redeemed, nil when the sale has no customer. Points given back expire as if
+// they were earned now.
+func (uc *refundUseCase) pointEntries(ctx context.Context, order *domain.Order, refund *domain.Refund) ([]*domain.PointEntry, error) {
+	if !order.CustomerID.Valid || (refund.PointsTaken == 0 && refund.PointsReturned == 0) {
+		return nil, nil
+	}
+
+	var expiresAt sql.NullTime
+	if refund.PointsReturned > 0 {
+		program, err := uc.loyaltyRepo.GetProgram(ctx, order.MerchantID)
+		if err != nil {
+			return nil, err
+		}
+		if program != nil {
+			expiresAt = program.ExpiresAt(refund.CompletedAt.Time)
+		}
+	}
+
+	var entries []*domain.PointEntry
+	for _, points := range []int64{-refund.PointsTaken, refund.PointsReturned} {
+		if points == 0 {
+			continue
+		}
+		entry := &domain.PointEntry{
+			ID:         uuid.New(),
+			MerchantID: order.MerchantID,
+			CustomerID: order.CustomerID.UUID,
+			OrderID:    uuid.NullUUID{UUID: order.ID, Valid: true},
+			Type:       domain.PointEntryRefund,
+			Points:     points,
+			CreatedBy:  uuid.NullUUID{UUID: refund.CreatedBy, Valid: true},
+			CreatedAt:  refund.CompletedAt.Time,
+		}
+		if points > 0 {
+			entry.ExpiresAt = expiresAt
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// findPayment returns the payment of the order with the ID, nil when there is none
+func findPayment(order *domain.Order, id uuid.UUID) *domain.Payment {
+	for _, p := range order.Payments {
+		if p.ID == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// restockMovements creates one movement per variant returned to stock by the refund
+func restockMovements(order *domain.Order, refund *domain.Refund) []*domain.StockMovement {
+	byVariant := make(map[uuid.UUID]*domain.StockMovement, len(refund.Items))
+	movements := make([]*domain.StockMovement, 0, len(refund.Items))
+	for _, item := range refund.Items {
+		if !item.Restock {
+			continue
+		}
+		if movement, ok := byVariant[item.VariantID]; ok {
+			movement.Quantity += item.Quantity
+			continue
+		}
+
+		movement := &domain.StockMovement{
+			ID:          uuid.New(),
+			MerchantID:  order.MerchantID,
+			OutletID:    order.OutletID,
+			VariantID:   item.VariantID,
+			Type:        domain.StockMovementReturn,
+			Quantity:    item.Quantity,
+			ReferenceID: uuid.NullUUID{UUID: refund.ID, Valid: true},
+			Note:        refund.Reason,
+			CreatedBy:   uuid.NullUUID{UUID: refund.CreatedBy, Valid: true},
+			CreatedAt:   refund.CompletedAt.Time,
+		}
+		byVariant[item.VariantID] = movement
+		movements = append(movements, movement)
+	}
+	return movements
+}
+
+// generateStoreCreditCode generates a random code to spend a store credit with
+func generateStoreCreditCode() (string, error) {
+	code := make([]byte, 10)
+	size := big.NewInt(int64(len(storeCreditAlphabet)))
+	for i := range code {
+		n, err := rand.Int(rand.Reader, size)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate store credit code: %w", err)
+		}
+		code[i] = storeCreditAlphabet[n.Int64()]
+	}
+	return "SC" + string(code), nil
+}
@@ -0,0 +1,220 @@
+package refund
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Refund gives back items of a completed sale of the outlet of the tenant.
+// The amount of every item is its share of the order total, so discounts and
+// tax are given back too. Refunds taking the refunded total of the sale above
+// the approval threshold require the refund approval permission of a manager,
+// so splitting a large refund does not avoid the approval. The points the sale
+// earned and redeemed are reversed in proportion to the refund.
+func (uc *refundUseCase) Refund(ctx context.Context, orderID uuid.UUID, refund *domain.Refund, idempotencyKey string) (*domain.Refund, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.refund.Refund")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderRefund); err != nil {
+		return nil, err
+	}
+	if idempotencyKey == "" {
+		return nil, appErrors.NewFieldValidationError("idempotency_key", "idempotency key is required")
+	}
+	if refund.Reason == "" {
+		return nil, appErrors.NewFieldValidationError("reason", "reason is required")
+	}
+	if refund.Tender != domain.RefundTenderOriginal && refund.Tender != domain.RefundTenderStoreCredit {
+		return nil, appErrors.NewFieldValidationError("tender", "unknown tender")
+	}
+
+	// A repeated refund returns the first one
+	if existing, err := uc.repeated(ctx, tenant, orderID, idempotencyKey); existing != nil || err != nil {
+		return existing, err
+	}
+
+	order, err := uc.getOrder(ctx, tenant, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != domain.OrderStatusCompleted {
+		return nil, appErrors.NewConflictError(fmt.Sprintf("order is %s, only completed orders can be refunded", order.Status), nil)
+	}
+
+	items, err := refundItems(order, refund.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	principal, _ := domain.PrincipalFromContext(ctx)
+	refund.ID = uuid.New()
+	refund.MerchantID = order.MerchantID
+	refund.OutletID = order.OutletID
+	refund.OrderID = order.ID
+	refund.Status = domain.RefundStatusPending
+	refund.IdempotencyKey = idempotencyKey
+	refund.CreatedBy = principal.UserID
+	refund.CreatedAt = time.Now()
+	refund.Items = items
+	refund.Amount = 0
+	for _, item := range items {
+		item.ID = uuid.New()
+		item.RefundID = refund.ID
+		refund.Amount += item.Amount
+	}
+
+	if order.RefundedTotal+refund.Amount > uc.approvalThreshold {
+		if err := uc.authorizationService.Authorize(ctx, domain.PermissionOrderRefundApprove); err != nil {
+			return nil, err
+		}
+		refund.ApprovedBy = uuid.NullUUID{UUID: principal.UserID, Valid: true}
+	}
+
+	// The refunded total before the refund is read under the version the
+	// refund is created with, so the shares of refunds add up exactly
+	if order.CustomerID.Valid {
+		refund.PointsTaken, refund.PointsReturned = order.RefundPoints(refund.Amount)
+	}
+
+	refund.Payments = []*domain.RefundPayment{}
+	if refund.Tender == domain.RefundTenderOriginal {
+		if refund.Payments, err = refundPayments(order, refund); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.refundRepo.Create(ctx, refund, order); err != nil {
+		if errors.Is(err, domain.ErrOrderChanged) || errors.Is(err, domain.ErrDuplicateIdempotencyKey) {
+			// A concurrent request with the same key may have created the refund first
+			if existing, checkErr := uc.repeated(ctx, tenant, orderID, idempotencyKey); existing != nil || checkErr != nil {
+				return existing, checkErr
+			}
+			return nil, appErrors.NewConflictError("order was changed by another request, reload it and try again", err)
+		}
+		if errors.Is(err, domain.ErrPaymentChanged) {
+			return nil, appErrors.NewConflictError("payments of the order were changed by another request, reload it and try again", err)
+		}
+		return nil, err
+	}
+
+	return uc.finish(ctx, order, refund)
+}
+
+// repeated returns the refund already created with the idempotency key, if
+// any, finishing it when it is still pending. A key used for another order is
+// rejected.
+func (uc *refundUseCase) repeated(ctx context.Context, tenant *domain.Tenant, orderID uuid.UUID, idempotencyKey string) (*domain.Refund, error) {
+	refund, err := uc.refundRepo.GetByIdempotencyKey(ctx, tenant.MerchantID, idempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	if refund == nil {
+		return nil, nil
+	}
+	if refund.OrderID != orderID {
+		return nil, appErrors.NewDuplicateError("idempotency key was already used for another order", nil)
+	}
+	if refund.Status == domain.RefundStatusCompleted {
+		return refund, nil
+	}
+
+	order, err := uc.getOrder(ctx, tenant, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.finish(ctx, order, refund)
+}
+
+// refundItems checks the requested items against the order and computes
+// their amounts. Without requested items every item left is refunded and
+// returned to stock.
+func refundItems(order *domain.Order, requested []*domain.RefundItem) ([]*domain.RefundItem, error) {
+	if len(requested) == 0 {
+		for _, item := range order.Items {
+			if left := item.Quantity - item.RefundedQuantity; left > 0 {
+				requested = append(requested, &domain.RefundItem{OrderItemID: item.ID, Quantity: left, Restock: true})
+			}
+		}
+		if len(requested) == 0 {
+			return nil, appErrors.NewConflictError("every item of the order was already refunded", nil)
+		}
+	}
+
+	seen := make(map[uuid.UUID]bool, len(requested))
+	for i, refundItem := range requested {
+		field := fmt.Sprintf("items[%d]", i)
+		item := findItem(order, refundItem.OrderItemID)
+		if item == nil {
+			return nil, appErrors.NewFieldValidationError(field+".order_item_id", "item is not part of the order")
+		}
+		if seen[item.ID] {
+			return nil, appErrors.NewFieldValidationError(field+".order_item_id", "item is listed more than once")
+		}
+		seen[item.ID] = true
+
+		left := item.Quantity - item.RefundedQuantity
+		if refundItem.Quantity <= 0 {
+			return nil, appErrors.NewFieldValidationError(field+".quantity", "quantity must be positive")
+		}
+		if refundItem.Quantity > left {
+			return nil, appErrors.NewFieldValidationError(field+".quantity", fmt.Sprintf("only %d of the item are left to refund", left))
+		}
+
+		refundItem.VariantID = item.VariantID
+		refundItem.Amount = order.RefundAmount(item, refundItem.Quantity)
+	}
+	return requested, nil
+}
+
+// findItem returns the item of the order with the ID, nil when there is none
+func findItem(order *domain.Order, id uuid.UUID) *domain.OrderItem {
+	for _, item := range order.Items {
+		if item.ID == id {
+			return item
+		}
+	}
+	return nil
+}
+
+// refundPayments splits the amount of a refund across the captured payments
+// of the order that are not given back yet, latest payment first
+func refundPayments(order *domain.Order, refund *domain.Refund) ([]*domain.RefundPayment, error) {
+	parts := []*domain.RefundPayment{}
+	remaining := refund.Amount
+	for i := len(order.Payments) - 1; i >= 0 && remaining > 0; i-- {
+		p := order.Payments[i]
+		left := p.Amount - p.RefundedAmount
+		if p.Status != domain.PaymentStatusCaptured || left <= 0 {
+			continue
+		}
+
+		amount := min(left, remaining)
+		parts = append(parts, &domain.RefundPayment{
+			ID:        uuid.New(),
+			RefundID:  refund.ID,
+			PaymentID: p.ID,
+			Method:    p.Method,
+			Amount:    amount,
+			Status:    domain.PaymentStatusPending,
+			CreatedAt: refund.CreatedAt,
+		})
+		remaining -= amount
+	}
+
+	if remaining > 0 {
+		return nil, appErrors.NewConflictError("payments of the order do not cover the refund, refund it as store credit", nil)
+	}
+	return parts, nil
+}
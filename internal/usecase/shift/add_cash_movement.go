@@ -0,0 +1,66 @@
+package shift
+
+import (
+	"context"
+	"errors"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AddCashMovement records cash put into or taken out of the drawer of the open
+// shift of the caller. The amount is always positive, cash out is stored as a
+// negative movement.
+func (uc *shiftUseCase) AddCashMovement(ctx context.Context, movementType domain.CashMovementType, amount int64, reason string) (*domain.CashMovement, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.shift.AddCashMovement")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionShiftOperate); err != nil {
+		return nil, err
+	}
+
+	if movementType != domain.CashMovementCashIn && movementType != domain.CashMovementCashOut {
+		return nil, appErrors.NewFieldValidationError("type", "type must be cash_in or cash_out")
+	}
+	if amount <= 0 {
+		return nil, appErrors.NewFieldValidationError("amount", "amount must be greater than zero")
+	}
+	if reason == "" {
+		return nil, appErrors.NewFieldValidationError("reason", "reason is required")
+	}
+
+	shift, err := uc.openShift(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	if movementType == domain.CashMovementCashOut {
+		amount = -amount
+	}
+	movement := &domain.CashMovement{
+		ID:         uuid.New(),
+		MerchantID: tenant.MerchantID,
+		ShiftID:    shift.ID,
+		Type:       movementType,
+		Amount:     amount,
+		Reason:     reason,
+		CreatedBy:  shift.UserID,
+		CreatedAt:  time.Now(),
+	}
+	if err := uc.shiftRepo.AddCashMovement(ctx, movement); err != nil {
+		if errors.Is(err, domain.ErrShiftClosed) {
+			return nil, appErrors.NewConflictError("shift was closed by another request", err)
+		}
+		return nil, err
+	}
+
+	return movement, nil
+}
@@ -0,0 +1,61 @@
+package shift
+
+import (
+	"context"
+	"errors"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CloseShift closes a shift of the outlet of the tenant with the cash counted
+// in its drawer and returns its Z report. Cashiers close their own shifts,
+// closing the shift of another cashier requires the shift manage permission.
+func (uc *shiftUseCase) CloseShift(ctx context.Context, id uuid.UUID, countedCash int64, note string) (*domain.ShiftReport, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.shift.CloseShift")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionShiftOperate); err != nil {
+		return nil, err
+	}
+	if countedCash < 0 {
+		return nil, appErrors.NewFieldValidationError("counted_cash", "counted cash cannot be negative")
+	}
+
+	shift, err := uc.getShift(ctx, tenant, id)
+	if err != nil {
+		return nil, err
+	}
+	if shift.Status != domain.ShiftStatusOpen {
+		return nil, appErrors.NewConflictError("shift is already closed", nil)
+	}
+
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shift.Status = domain.ShiftStatusClosed
+	shift.CountedCash.Int64 = countedCash
+	shift.CountedCash.Valid = true
+	shift.ClosingNote = note
+	shift.ClosedAt.Time = time.Now()
+	shift.ClosedAt.Valid = true
+	shift.ClosedBy = uuid.NullUUID{UUID: caller.UserID, Valid: true}
+	if err := uc.shiftRepo.Close(ctx, shift); err != nil {
+		if errors.Is(err, domain.ErrShiftClosed) {
+			return nil, appErrors.NewConflictError("shift is already closed", err)
+		}
+		return nil, err
+	}
+
+	return uc.shiftRepo.Report(ctx, shift)
+}
@@ -0,0 +1,24 @@
+package shift
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/tenancy"
+)
+
+// GetCurrentShift returns the open shift of the caller at the outlet of the tenant
+func (uc *shiftUseCase) GetCurrentShift(ctx context.Context) (*domain.Shift, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.shift.GetCurrentShift")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionShiftOperate); err != nil {
+		return nil, err
+	}
+
+	return uc.openShift(ctx, tenant)
+}
@@ -0,0 +1,32 @@
+package shift
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// GetShiftReport returns the report of a shift of the outlet of the tenant, an
+// X report while the shift is open and its Z report once it is closed
+func (uc *shiftUseCase) GetShiftReport(ctx context.Context, id uuid.UUID) (*domain.ShiftReport, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.shift.GetShiftReport")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionShiftOperate); err != nil {
+		return nil, err
+	}
+
+	shift, err := uc.getShift(ctx, tenant, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.shiftRepo.Report(ctx, shift)
+}
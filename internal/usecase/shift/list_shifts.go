@@ -0,0 +1,58 @@
+package shift
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/pagination"
+	"github/kijunpos/internal/pkg/tenancy"
+)
+
+// ListShifts lists the shifts of the outlet of the tenant, newest first, one
+// page at a time. Without the shift manage permission only the shifts of the
+// caller are listed.
+func (uc *shiftUseCase) ListShifts(ctx context.Context, filter domain.ShiftFilter, pageSize int, cursor string) (*domain.ShiftPage, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.shift.ListShifts")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionShiftOperate); err != nil {
+		return nil, err
+	}
+
+	manager, err := uc.authorizationService.HasPermission(ctx, domain.PermissionShiftManage)
+	if err != nil {
+		return nil, err
+	}
+	if !manager {
+		caller, err := principal(ctx)
+		if err != nil {
+			return nil, err
+		}
+		filter.UserID = caller.UserID
+	}
+
+	pageSize = pagination.PageSize(pageSize)
+	after, err := pagination.DecodeCursor(cursor)
+	if err != nil {
+		return nil, appErrors.NewFieldValidationError("cursor", "invalid cursor")
+	}
+
+	shifts, err := uc.shiftRepo.List(ctx, tenant.MerchantID, tenant.OutletID, filter, after, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.ShiftPage{Shifts: shifts}
+	if len(shifts) > pageSize {
+		page.Shifts = shifts[:pageSize]
+		last := page.Shifts[pageSize-1]
+		page.NextCursor = pagination.EncodeCursor(domain.PageCursor{CreatedAt: last.OpenedAt, ID: last.ID})
+	}
+
+	return page, nil
+}
@@ -0,0 +1,55 @@
+package shift
+
+import (
+	"context"
+	"errors"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OpenShift opens a shift for the caller at the outlet of the tenant, a
+// cashier has at most one open shift per outlet
+func (uc *shiftUseCase) OpenShift(ctx context.Context, openingFloat int64, note string) (*domain.Shift, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.shift.OpenShift")
+	defer span.End()
+
+	tenant, err := tenancy.RequireOutlet(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionShiftOperate); err != nil {
+		return nil, err
+	}
+	if openingFloat < 0 {
+		return nil, appErrors.NewFieldValidationError("opening_float", "opening float cannot be negative")
+	}
+
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shift := &domain.Shift{
+		ID:           uuid.New(),
+		MerchantID:   tenant.MerchantID,
+		OutletID:     tenant.OutletID,
+		UserID:       caller.UserID,
+		Status:       domain.ShiftStatusOpen,
+		OpeningFloat: openingFloat,
+		OpeningNote:  note,
+		OpenedAt:     time.Now(),
+	}
+	if err := uc.shiftRepo.Open(ctx, shift); err != nil {
+		if errors.Is(err, domain.ErrShiftAlreadyOpen) {
+			return nil, appErrors.NewConflictError("you already have an open shift at this outlet, close it first", err)
+		}
+		return nil, err
+	}
+
+	return shift, nil
+}
@@ -0,0 +1,74 @@
+package shift
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	appErrors "github/kijunpos/internal/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+type shiftUseCase struct {
+	shiftRepo            domain.ShiftRepository
+	authorizationService domain.AuthorizationService
+}
+
+// NewShiftUseCase creates a new shift use case
+func NewShiftUseCase(
+	shiftRepo domain.ShiftRepository,
+	authorizationService domain.AuthorizationService,
+) domain.ShiftUseCase {
+	return &shiftUseCase{
+		shiftRepo:            shiftRepo,
+		authorizationService: authorizationService,
+	}
+}
+
+// principal returns the authenticated caller
+func principal(ctx context.Context) (*domain.Principal, error) {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, appErrors.NewUnauthorizedError("unauthenticated", nil)
+	}
+	return principal, nil
+}
+
+// getShift returns a shift of the outlet of the tenant. Shifts of other
+// cashiers require the shift manage permission.
+func (uc *shiftUseCase) getShift(ctx context.Context, tenant *domain.Tenant, id uuid.UUID) (*domain.Shift, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shift, err := uc.shiftRepo.GetByID(ctx, tenant.MerchantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if shift == nil || shift.OutletID != tenant.OutletID {
+		return nil, appErrors.NewNotFoundError("shift not found", nil)
+	}
+	if shift.UserID != caller.UserID {
+		if err := uc.authorizationService.Authorize(ctx, domain.PermissionShiftManage); err != nil {
+			return nil, err
+		}
+	}
+	return shift, nil
+}
+
+// openShift returns the open shift of the caller at the outlet of the tenant
+func (uc *shiftUseCase) openShift(ctx context.Context, tenant *domain.Tenant) (*domain.Shift, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shift, err := uc.shiftRepo.GetOpen(ctx, tenant.MerchantID, tenant.OutletID, caller.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if shift == nil {
+		return nil, appErrors.NewNotFoundError("no shift is open", nil)
+	}
+	return shift, nil
+}
@@ -0,0 +1,56 @@
+package tax
+
+import (
+	"context"
+	"errors"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateTaxRule adds a tax rule to the merchant of the tenant, or to one of
+// its outlets when the rule has an outlet
+func (uc *taxUseCase) CreateTaxRule(ctx context.Context, rule *domain.TaxRule) (*domain.TaxRule, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.tax.CreateTaxRule")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionTaxManage); err != nil {
+		return nil, err
+	}
+
+	if rule.Type != domain.TaxTypeServiceCharge && rule.Type != domain.TaxTypeVAT {
+		return nil, appErrors.NewFieldValidationError("type", "invalid tax type")
+	}
+	if err := validateRule(rule.Type, rule.Rate, rule.Inclusive); err != nil {
+		return nil, err
+	}
+	if rule.OutletID.Valid {
+		outlet, err := uc.outletRepo.GetByID(ctx, tenant.MerchantID, rule.OutletID.UUID)
+		if err != nil {
+			return nil, err
+		}
+		if outlet == nil {
+			return nil, appErrors.NewFieldValidationError("outlet_id", "outlet not found")
+		}
+	}
+
+	rule.ID = uuid.New()
+	rule.MerchantID = tenant.MerchantID
+	rule.CreatedAt = time.Now()
+	if err := uc.taxRuleRepo.Create(ctx, rule); err != nil {
+		if errors.Is(err, domain.ErrTaxRuleExists) {
+			return nil, appErrors.NewDuplicateError("a tax rule of this type already exists, update it instead", err)
+		}
+		return nil, err
+	}
+
+	return rule, nil
+}
@@ -0,0 +1,30 @@
+package tax
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/tenancy"
+
+	"github.com/google/uuid"
+)
+
+// DeleteTaxRule removes a tax rule of the merchant of the tenant
+func (uc *taxUseCase) DeleteTaxRule(ctx context.Context, id uuid.UUID) error {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.tax.DeleteTaxRule")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionTaxManage); err != nil {
+		return err
+	}
+
+	if _, err := uc.getRule(ctx, tenant, id); err != nil {
+		return err
+	}
+
+	return uc.taxRuleRepo.Delete(ctx, tenant.MerchantID, id)
+}
@@ -0,0 +1,25 @@
+package tax
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/tenancy"
+)
+
+// ListTaxRules lists the tax rules of the merchant of the tenant and of all
+// its outlets
+func (uc *taxUseCase) ListTaxRules(ctx context.Context) ([]*domain.TaxRule, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.tax.ListTaxRules")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionTaxManage); err != nil {
+		return nil, err
+	}
+
+	return uc.taxRuleRepo.ListByMerchant(ctx, tenant.MerchantID)
+}
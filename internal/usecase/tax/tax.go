@@ -0,0 +1,52 @@
+package tax
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	appErrors "github/kijunpos/internal/pkg/errors"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type taxUseCase struct {
+	taxRuleRepo          domain.TaxRuleRepository
+	outletRepo           domain.OutletRepository
+	authorizationService domain.AuthorizationService
+}
+
+// NewTaxUseCase creates a new tax use case
+func NewTaxUseCase(
+	taxRuleRepo domain.TaxRuleRepository,
+	outletRepo domain.OutletRepository,
+	authorizationService domain.AuthorizationService,
+) domain.TaxUseCase {
+	return &taxUseCase{
+		taxRuleRepo:          taxRuleRepo,
+		outletRepo:           outletRepo,
+		authorizationService: authorizationService,
+	}
+}
+
+// getRule returns a tax rule of the merchant of the tenant
+func (uc *taxUseCase) getRule(ctx context.Context, tenant *domain.Tenant, id uuid.UUID) (*domain.TaxRule, error) {
+	rule, err := uc.taxRuleRepo.GetByID(ctx, tenant.MerchantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if rule == nil {
+		return nil, appErrors.NewNotFoundError("tax rule not found", nil)
+	}
+	return rule, nil
+}
+
+// validateRule checks the rate and the inclusiveness of a rule of the given type
+func validateRule(ruleType domain.TaxType, rate decimal.Decimal, inclusive bool) error {
+	if rate.IsNegative() || rate.GreaterThan(decimal.NewFromInt(100)) {
+		return appErrors.NewFieldValidationError("rate", "rate must be between 0 and 100")
+	}
+	if inclusive && ruleType != domain.TaxTypeVAT {
+		return appErrors.NewFieldValidationError("inclusive", "only VAT can be included in prices")
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package tax
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	"github/kijunpos/internal/pkg/tenancy"
+	"time"
+)
+
+// UpdateTaxRule changes the name, the rate and the inclusiveness of a tax rule
+// of the merchant of the tenant, its type and outlet stay the same
+func (uc *taxUseCase) UpdateTaxRule(ctx context.Context, rule *domain.TaxRule) (*domain.TaxRule, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.tax.UpdateTaxRule")
+	defer span.End()
+
+	tenant, err := tenancy.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionTaxManage); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.getRule(ctx, tenant, rule.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateRule(existing.Type, rule.Rate, rule.Inclusive); err != nil {
+		return nil, err
+	}
+
+	existing.Name = rule.Name
+	existing.Rate = rule.Rate
+	existing.Inclusive = rule.Inclusive
+	existing.UpdatedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	if err := uc.taxRuleRepo.Update(ctx, existing); err != nil {
+		return nil, err
+	}
+
+	return existing, nil
+}
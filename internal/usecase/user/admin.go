@@ -0,0 +1,52 @@
+package user
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	appErrors "github/kijunpos/internal/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+type adminUserUseCase struct {
+	userRepo             domain.UserRepository
+	verificationRepo     domain.VerificationRepository
+	refreshTokenRepo     domain.RefreshTokenRepository
+	roleRepo             domain.RoleRepository
+	emailService         domain.EmailService
+	authorizationService domain.AuthorizationService
+	verificationPolicy   domain.VerificationPolicy
+}
+
+// NewAdminUserUseCase creates a new back office user management use case
+func NewAdminUserUseCase(
+	userRepo domain.UserRepository,
+	verificationRepo domain.VerificationRepository,
+	refreshTokenRepo domain.RefreshTokenRepository,
+	roleRepo domain.RoleRepository,
+	emailService domain.EmailService,
+	authorizationService domain.AuthorizationService,
+	verificationPolicy domain.VerificationPolicy,
+) domain.AdminUserUseCase {
+	return &adminUserUseCase{
+		userRepo:             userRepo,
+		verificationRepo:     verificationRepo,
+		refreshTokenRepo:     refreshTokenRepo,
+		roleRepo:             roleRepo,
+		emailService:         emailService,
+		authorizationService: authorizationService,
+		verificationPolicy:   verificationPolicy,
+	}
+}
+
+// getUser loads a user that is not deleted
+func (uc *adminUserUseCase) getUser(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	user, err := uc.userRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, appErrors.NewNotFoundError("user not found", nil)
+	}
+	return user, nil
+}
@@ -0,0 +1,60 @@
+package user
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// AssignRole gives a role to a user, the role takes effect with the next access token
+func (uc *adminUserUseCase) AssignRole(ctx context.Context, userID uuid.UUID, role string) ([]string, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.AssignRole")
+	defer span.End()
+
+	if err := uc.authorizeRoleChange(ctx, userID, role); err != nil {
+		return nil, err
+	}
+
+	if err := uc.roleRepo.AssignToUser(ctx, userID, role); err != nil {
+		return nil, err
+	}
+
+	return uc.userRepo.GetRoles(ctx, userID)
+}
+
+// authorizeRoleChange checks that the caller may give the role to or take it
+// from the user. Staff cannot change their own roles nor hand out permissions
+// they do not have themselves.
+func (uc *adminUserUseCase) authorizeRoleChange(ctx context.Context, userID uuid.UUID, roleName string) error {
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionRoleAssign); err != nil {
+		return err
+	}
+	if principal, _ := domain.PrincipalFromContext(ctx); principal.UserID == userID {
+		return appErrors.NewBadRequestError("you cannot change your own roles", nil)
+	}
+
+	role, err := uc.roleRepo.GetByName(ctx, roleName)
+	if err != nil {
+		return err
+	}
+	if role == nil {
+		return appErrors.NewNotFoundError("role not found", nil)
+	}
+	for _, permission := range role.Permissions {
+		granted, err := uc.authorizationService.HasPermission(ctx, permission)
+		if err != nil {
+			return err
+		}
+		if !granted {
+			return appErrors.NewForbiddenError("the role grants permissions you do not have", nil)
+		}
+	}
+
+	if _, err := uc.getUser(ctx, userID); err != nil {
+		return err
+	}
+	return nil
+}
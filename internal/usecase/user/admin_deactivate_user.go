@@ -0,0 +1,43 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DeactivateUser disables an account and revokes all of its sessions
+func (uc *adminUserUseCase) DeactivateUser(ctx context.Context, id uuid.UUID) error {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.DeactivateUser")
+	defer span.End()
+
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionUserManage); err != nil {
+		return err
+	}
+	// Staff cannot lock themselves out of the back office
+	if principal, _ := domain.PrincipalFromContext(ctx); principal.UserID == id {
+		return appErrors.NewBadRequestError("you cannot deactivate your own account", nil)
+	}
+
+	user, err := uc.getUser(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !user.IsActive {
+		return appErrors.NewBadRequestError("user account is already inactive", nil)
+	}
+
+	user.IsActive = false
+	user.UpdatedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return err
+	}
+
+	// Access tokens expire on their own, refresh tokens are revoked right away
+	return uc.refreshTokenRepo.RevokeAllByUserID(ctx, id)
+}
@@ -0,0 +1,60 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ForceResetPassword clears the password of an account, signs out all of its
+// sessions and emails a password reset code, the user sets a new password with
+// VerifyPasswordReset
+func (uc *adminUserUseCase) ForceResetPassword(ctx context.Context, id uuid.UUID) (string, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.ForceResetPassword")
+	defer span.End()
+
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionUserManage); err != nil {
+		return "", err
+	}
+
+	user, err := uc.getUser(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if user.Email == "" {
+		return "", appErrors.NewBadRequestError("user has no email address to send the reset code to", nil)
+	}
+
+	verificationCode, err := generateVerificationCode()
+	if err != nil {
+		return "", err
+	}
+	if err := uc.verificationRepo.StoreVerificationCode(ctx, domain.VerificationPurposePasswordReset, user.Email, verificationCode, verificationCodeExpiration); err != nil {
+		return "", fmt.Errorf("failed to store verification code: %w", err)
+	}
+
+	// The old password stops working before the code is sent
+	user.PasswordHash = ""
+	user.UpdatedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return "", err
+	}
+	if err := uc.refreshTokenRepo.RevokeAllByUserID(ctx, id); err != nil {
+		return "", err
+	}
+
+	if err := uc.emailService.SendVerificationCode(ctx, user.Email, verificationCode); err != nil {
+		return "", fmt.Errorf("failed to send password reset code: %w", err)
+	}
+
+	if uc.verificationPolicy.EchoCode {
+		return verificationCode, nil
+	}
+	return "", nil
+}
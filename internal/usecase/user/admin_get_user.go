@@ -0,0 +1,33 @@
+package user
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// GetUser returns any account by ID, including soft deleted accounts
+func (uc *adminUserUseCase) GetUser(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.GetUser")
+	defer span.End()
+
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionUserRead); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetByIDWithDeleted(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, appErrors.NewNotFoundError("user not found", nil)
+	}
+
+	// Clear sensitive data before returning
+	user.PasswordHash = ""
+	user.PINHash = ""
+	return user, nil
+}
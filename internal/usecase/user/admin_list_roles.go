@@ -0,0 +1,19 @@
+package user
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+)
+
+// ListRoles lists the roles together with the permissions they grant
+func (uc *adminUserUseCase) ListRoles(ctx context.Context) ([]*domain.Role, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.ListRoles")
+	defer span.End()
+
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionRoleRead); err != nil {
+		return nil, err
+	}
+
+	return uc.roleRepo.List(ctx)
+}
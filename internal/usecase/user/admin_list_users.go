@@ -0,0 +1,46 @@
+package user
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"github/kijunpos/internal/pkg/pagination"
+)
+
+// ListUsers lists the users matching the filter, newest first, one page at a time
+func (uc *adminUserUseCase) ListUsers(ctx context.Context, filter domain.UserFilter, pageSize int, cursor string) (*domain.UserPage, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.ListUsers")
+	defer span.End()
+
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionUserRead); err != nil {
+		return nil, err
+	}
+
+	pageSize = pagination.PageSize(pageSize)
+	after, err := pagination.DecodeCursor(cursor)
+	if err != nil {
+		return nil, appErrors.NewFieldValidationError("cursor", "invalid cursor")
+	}
+
+	// One extra user tells whether there is a next page
+	users, err := uc.userRepo.List(ctx, filter, after, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &domain.UserPage{Users: users}
+	if len(users) > pageSize {
+		page.Users = users[:pageSize]
+		last := page.Users[pageSize-1]
+		page.NextCursor = pagination.EncodeCursor(domain.PageCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+
+	// Clear sensitive data before returning
+	for _, user := range page.Users {
+		user.PasswordHash = ""
+		user.PINHash = ""
+	}
+
+	return page, nil
+}
@@ -0,0 +1,38 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReactivateUser enables an account that was deactivated
+func (uc *adminUserUseCase) ReactivateUser(ctx context.Context, id uuid.UUID) error {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.ReactivateUser")
+	defer span.End()
+
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionUserManage); err != nil {
+		return err
+	}
+
+	user, err := uc.getUser(ctx, id)
+	if err != nil {
+		return err
+	}
+	if user.IsActive {
+		return appErrors.NewBadRequestError("user account is already active", nil)
+	}
+	// WhatsApp registrations stay inactive until the number is confirmed
+	if user.Email == "" && !user.WhatsAppVerifiedAt.Valid {
+		return appErrors.NewBadRequestError("user has not verified the whatsapp number yet", nil)
+	}
+
+	user.IsActive = true
+	user.UpdatedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	return uc.userRepo.Update(ctx, user)
+}
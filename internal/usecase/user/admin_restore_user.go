@@ -0,0 +1,61 @@
+package user
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// RestoreUser undoes the soft delete of an account, as long as its username,
+// email and WhatsApp number have not been taken by another account since
+func (uc *adminUserUseCase) RestoreUser(ctx context.Context, id uuid.UUID) error {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.RestoreUser")
+	defer span.End()
+
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionUserManage); err != nil {
+		return err
+	}
+
+	user, err := uc.userRepo.GetByIDWithDeleted(ctx, id)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return appErrors.NewNotFoundError("user not found", nil)
+	}
+	if !user.DeletedAt.Valid {
+		return appErrors.NewBadRequestError("user account is not deleted", nil)
+	}
+
+	// Deleted accounts release their identifiers, see the partial unique indexes
+	existingUser, err := uc.userRepo.GetByUsername(ctx, user.UserName)
+	if err != nil {
+		return err
+	}
+	if existingUser != nil {
+		return appErrors.NewDuplicateError("username is already used by another account", nil)
+	}
+	if user.Email != "" {
+		existingUser, err := uc.userRepo.GetByEmail(ctx, user.Email)
+		if err != nil {
+			return err
+		}
+		if existingUser != nil {
+			return appErrors.NewDuplicateError("email is already used by another account", nil)
+		}
+	}
+	if user.WhatsAppNumber != "" {
+		existingUser, err := uc.userRepo.GetByWhatsAppNumber(ctx, user.WhatsAppNumber)
+		if err != nil {
+			return err
+		}
+		if existingUser != nil {
+			return appErrors.NewDuplicateError("whatsapp number is already used by another account", nil)
+		}
+	}
+
+	return uc.userRepo.Restore(ctx, id)
+}
@@ -0,0 +1,25 @@
+package user
+
+import (
+	"context"
+	"github/kijunpos/internal/pkg/apm"
+
+	"github.com/google/uuid"
+)
+
+// RevokeRole takes a role away from a user, the user keeps it until the
+// current access token expires
+func (uc *adminUserUseCase) RevokeRole(ctx context.Context, userID uuid.UUID, role string) ([]string, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.RevokeRole")
+	defer span.End()
+
+	if err := uc.authorizeRoleChange(ctx, userID, role); err != nil {
+		return nil, err
+	}
+
+	if err := uc.roleRepo.RevokeFromUser(ctx, userID, role); err != nil {
+		return nil, err
+	}
+
+	return uc.userRepo.GetRoles(ctx, userID)
+}
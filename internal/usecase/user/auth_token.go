@@ -0,0 +1,53 @@
+package user
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// issueAuthToken signs a new access token and stores a new refresh token
+// belonging to the given token family
+func (uc *userUseCase) issueAuthToken(ctx context.Context, user *domain.User, authType domain.AuthType, familyID uuid.UUID) (*domain.AuthToken, error) {
+	roles, err := uc.userRepo.GetRoles(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, accessExpiresAt, err := uc.tokenService.GenerateAccessToken(ctx, domain.TokenClaims{
+		UserID:   user.ID,
+		UserName: user.UserName,
+		AuthType: authType,
+		Roles:    roles,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, refreshTokenHash, refreshExpiresAt, err := uc.tokenService.GenerateRefreshToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.refreshTokenRepo.Create(ctx, &domain.RefreshToken{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		FamilyID:  familyID,
+		TokenHash: refreshTokenHash,
+		AuthType:  authType,
+		ExpiresAt: refreshExpiresAt,
+		CreatedAt: time.Now(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &domain.AuthToken{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessExpiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshExpiresAt,
+		User:                  user,
+	}, nil
+}
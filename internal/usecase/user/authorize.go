@@ -0,0 +1,22 @@
+package user
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	appErrors "github/kijunpos/internal/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// authorizeSelfOr allows the call when the authenticated caller is the given
+// user or has the permission to act on the accounts of other users
+func (uc *userUseCase) authorizeSelfOr(ctx context.Context, userID uuid.UUID, permission domain.Permission) error {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return appErrors.NewUnauthorizedError("unauthenticated", nil)
+	}
+	if principal.UserID == userID {
+		return nil
+	}
+	return uc.authorizationService.Authorize(ctx, permission)
+}
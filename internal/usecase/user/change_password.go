@@ -0,0 +1,70 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ChangePassword replaces the password of the authenticated user and signs out
+// all of its sessions. Wrong current passwords count as failed logins.
+func (uc *userUseCase) ChangePassword(ctx context.Context, currentPassword, newPassword string) error {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.ChangePassword")
+	defer span.End()
+
+	// Validate input
+	if currentPassword == "" {
+		return appErrors.NewValidationError("current password is required", nil)
+	}
+	if newPassword == "" {
+		return appErrors.NewValidationError("new password is required", nil)
+	}
+	if newPassword == currentPassword {
+		return appErrors.NewValidationError("new password must be different from the current password", nil)
+	}
+
+	user, err := uc.getCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Reject temporarily locked accounts before checking the credential
+	if user.IsLocked(time.Now()) {
+		return accountLockedError(user.LockedUntil.Time)
+	}
+	if user.PasswordHash == "" {
+		return appErrors.NewBadRequestError("password login is not enabled for this account", nil)
+	}
+
+	// Verify current password
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(currentPassword)); err != nil {
+		return uc.recordFailedLogin(ctx, user, appErrors.NewBadRequestError("invalid current password", nil))
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	user.PasswordHash = string(hashedPassword)
+	user.FailedLoginAttempts = 0
+	user.PasswordChangedAt = sql.NullTime{Time: now, Valid: true}
+	user.UpdatedAt = sql.NullTime{Time: now, Valid: true}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return err
+	}
+
+	// Sessions signed in with the old password must not outlive it
+	if err := uc.refreshTokenRepo.RevokeAllByUserID(ctx, user.ID); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	return nil
+}
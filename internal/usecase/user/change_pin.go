@@ -0,0 +1,60 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"time"
+)
+
+// ChangePIN replaces the WhatsApp login PIN of the authenticated user.
+// Wrong current PINs count as failed logins so the endpoint cannot be used to
+// brute-force the PIN around the lockout policy.
+func (uc *userUseCase) ChangePIN(ctx context.Context, currentPIN, newPIN string) error {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.ChangePIN")
+	defer span.End()
+
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return appErrors.NewUnauthorizedError("unauthenticated", nil)
+	}
+
+	// Validate input
+	if currentPIN == "" {
+		return appErrors.NewValidationError("current PIN is required", nil)
+	}
+	if err := validatePIN(newPIN); err != nil {
+		return err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, principal.UserID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return appErrors.NewNotFoundError("user not found", nil)
+	}
+
+	// Reject temporarily locked accounts before checking the credential
+	if user.IsLocked(time.Now()) {
+		return accountLockedError(user.LockedUntil.Time)
+	}
+
+	// Verify current PIN
+	if match, _ := comparePIN(user.PINHash, currentPIN); !match {
+		return uc.recordFailedLogin(ctx, user, appErrors.NewBadRequestError("invalid PIN", nil))
+	}
+
+	pinHash, err := hashPIN(newPIN)
+	if err != nil {
+		return err
+	}
+
+	user.PINHash = pinHash
+	user.FailedLoginAttempts = 0
+	user.UpdatedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	return uc.userRepo.Update(ctx, user)
+}
@@ -0,0 +1,61 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// DeleteAccount deletes the account of the authenticated user and signs out all
+// of its sessions. The caller confirms with the password when signed in by
+// email or the PIN when signed in by WhatsApp.
+func (uc *userUseCase) DeleteAccount(ctx context.Context, credential string) error {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.DeleteAccount")
+	defer span.End()
+
+	// Validate input
+	if credential == "" {
+		return appErrors.NewValidationError("credential is required", nil)
+	}
+
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return appErrors.NewUnauthorizedError("unauthenticated", nil)
+	}
+
+	user, err := uc.getCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Reject temporarily locked accounts before checking the credential
+	if user.IsLocked(time.Now()) {
+		return accountLockedError(user.LockedUntil.Time)
+	}
+
+	switch principal.AuthType {
+	case domain.AuthTypeWhatsApp:
+		if match, _ := comparePIN(user.PINHash, credential); !match {
+			return uc.recordFailedLogin(ctx, user, appErrors.NewBadRequestError("invalid PIN", nil))
+		}
+	default:
+		if bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(credential)) != nil {
+			return uc.recordFailedLogin(ctx, user, appErrors.NewBadRequestError("invalid password", nil))
+		}
+	}
+
+	if err := uc.userRepo.Delete(ctx, user.ID); err != nil {
+		return err
+	}
+
+	if err := uc.refreshTokenRepo.RevokeAllByUserID(ctx, user.ID); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	return nil
+}
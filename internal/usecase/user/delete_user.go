@@ -2,8 +2,9 @@ package user
 
 import (
 	"context"
-	"errors"
+	"github/kijunpos/internal/domain"
 	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
 
 	"github.com/google/uuid"
 )
@@ -13,13 +14,18 @@ func (uc *userUseCase) DeleteUser(ctx context.Context, id uuid.UUID) error {
 	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.DeleteUser")
 	defer span.End()
 
+	// Only the user themselves or staff allowed to manage users may delete the account
+	if err := uc.authorizeSelfOr(ctx, id, domain.PermissionUserManage); err != nil {
+		return err
+	}
+
 	// Check if user exists
 	existingUser, err := uc.userRepo.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
 	if existingUser == nil {
-		return errors.New("user not found")
+		return appErrors.NewNotFoundError("user not found", nil)
 	}
 
 	return uc.userRepo.Delete(ctx, id)
@@ -0,0 +1,46 @@
+package user
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+)
+
+// GetMe returns the account of the authenticated user
+func (uc *userUseCase) GetMe(ctx context.Context) (*domain.User, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.GetMe")
+	defer span.End()
+
+	user, err := uc.getCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Clear sensitive data before returning
+	user.PasswordHash = ""
+	user.PINHash = ""
+	return user, nil
+}
+
+// getCurrentUser loads the account of the authenticated user. Access tokens
+// outlive a deactivation, so inactive accounts are rejected here.
+func (uc *userUseCase) getCurrentUser(ctx context.Context) (*domain.User, error) {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, appErrors.NewUnauthorizedError("unauthenticated", nil)
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, appErrors.NewNotFoundError("user not found", nil)
+	}
+	if !user.IsActive {
+		return nil, appErrors.NewForbiddenError("user account is not active", nil)
+	}
+
+	return user, nil
+}
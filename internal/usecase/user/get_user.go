@@ -2,9 +2,9 @@ package user
 
 import (
 	"context"
-	"errors"
 	"github/kijunpos/internal/domain"
 	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
 
 	"github.com/google/uuid"
 )
@@ -14,12 +14,17 @@ func (uc *userUseCase) GetUserByID(ctx context.Context, id uuid.UUID) (*domain.U
 	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.GetUserByID")
 	defer span.End()
 
+	// Only the user themselves or staff allowed to view users may view the account
+	if err := uc.authorizeSelfOr(ctx, id, domain.PermissionUserRead); err != nil {
+		return nil, err
+	}
+
 	user, err := uc.userRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 	if user == nil {
-		return nil, errors.New("user not found")
+		return nil, appErrors.NewNotFoundError("user not found", nil)
 	}
 
 	// Clear password hash before returning
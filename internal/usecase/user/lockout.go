@@ -0,0 +1,51 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// recordFailedLogin counts a failed login attempt for the user and returns the
+// error to report to the caller, failure unless the attempt triggered a lockout
+func (uc *userUseCase) recordFailedLogin(ctx context.Context, user *domain.User, failure error) error {
+	lockedUntil, err := uc.userRepo.RecordFailedLogin(ctx, user.ID, uc.lockoutPolicy)
+	if err != nil {
+		return fmt.Errorf("failed to record failed login: %w", err)
+	}
+
+	if lockedUntil.Valid && lockedUntil.Time.After(time.Now()) {
+		return accountLockedError(lockedUntil.Time)
+	}
+
+	return failure
+}
+
+// loginRejected returns the error for a correct credential of an account that
+// was locked or deactivated while the credential was checked
+func (uc *userUseCase) loginRejected(ctx context.Context, id uuid.UUID) error {
+	user, err := uc.userRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if user != nil && user.IsLocked(time.Now()) {
+		return accountLockedError(user.LockedUntil.Time)
+	}
+	return appErrors.NewForbiddenError("user account is not active", nil)
+}
+
+// accountLockedError creates the error returned for temporarily locked accounts
+func accountLockedError(lockedUntil time.Time) error {
+	retryAfter := time.Until(lockedUntil).Round(time.Second)
+	if retryAfter < time.Second {
+		retryAfter = time.Second
+	}
+	return appErrors.NewAccountLockedError(
+		fmt.Sprintf("account temporarily locked, try again in %s", retryAfter),
+		nil,
+	)
+}
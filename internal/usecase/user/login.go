@@ -6,22 +6,24 @@ import (
 	"errors"
 	"github/kijunpos/internal/domain"
 	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
 	"time"
 
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
-// Login authenticates a user based on auth type
-func (uc *userUseCase) Login(ctx context.Context, authType domain.AuthType, identifier, credential string) (*domain.User, error) {
+// Login authenticates a user based on auth type and issues a new token pair
+func (uc *userUseCase) Login(ctx context.Context, authType domain.AuthType, identifier, credential string) (*domain.AuthToken, error) {
 	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.Login")
 	defer span.End()
 
 	// Validate input
 	if identifier == "" {
-		return nil, errors.New("identifier is required")
+		return nil, appErrors.NewValidationError("identifier is required", nil)
 	}
 	if credential == "" {
-		return nil, errors.New("credential is required")
+		return nil, appErrors.NewValidationError("credential is required", nil)
 	}
 
 	var user *domain.User
@@ -44,21 +46,23 @@ func (uc *userUseCase) Login(ctx context.Context, authType domain.AuthType, iden
 		}
 
 		if user == nil {
-			return nil, errors.New("invalid username/email or password")
+			return nil, appErrors.NewUnauthorizedError("invalid username/email or password", nil)
 		}
 
 		// Check if user is active
 		if !user.IsActive {
-			return nil, errors.New("user account is not active")
+			return nil, appErrors.NewForbiddenError("user account is not active", nil)
+		}
+
+		// Reject temporarily locked accounts before checking the credential
+		if user.IsLocked(time.Now()) {
+			return nil, accountLockedError(user.LockedUntil.Time)
 		}
 
 		// Verify password
 		err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(credential))
 		if err != nil {
-			// Increment failed login attempts
-			user.FailedLoginAttempts++
-			_ = uc.userRepo.Update(ctx, user) // Ignore error for simplicity
-			return nil, errors.New("invalid username/email or password")
+			return nil, uc.recordFailedLogin(ctx, user, appErrors.NewUnauthorizedError("invalid username/email or password", nil))
 		}
 
 	case domain.AuthTypeWhatsApp:
@@ -68,38 +72,66 @@ func (uc *userUseCase) Login(ctx context.Context, authType domain.AuthType, iden
 			return nil, err
 		}
 		if user == nil {
-			return nil, errors.New("invalid WhatsApp number or PIN")
+			return nil, appErrors.NewUnauthorizedError("invalid WhatsApp number or PIN", nil)
 		}
 
 		// Check if user is active
 		if !user.IsActive {
-			return nil, errors.New("user account is not active")
+			return nil, appErrors.NewForbiddenError("user account is not active", nil)
+		}
+
+		// Reject temporarily locked accounts before checking the credential
+		if user.IsLocked(time.Now()) {
+			return nil, accountLockedError(user.LockedUntil.Time)
 		}
 
 		// Verify PIN
-		if user.OTPPIN != credential {
-			// Increment failed login attempts
-			user.FailedLoginAttempts++
-			_ = uc.userRepo.Update(ctx, user) // Ignore error for simplicity
-			return nil, errors.New("invalid WhatsApp number or PIN")
+		match, needsRehash := comparePIN(user.PINHash, credential)
+		if !match {
+			return nil, uc.recordFailedLogin(ctx, user, appErrors.NewUnauthorizedError("invalid WhatsApp number or PIN", nil))
+		}
+
+		// The number has to be confirmed with the registration code first, this
+		// is only told to callers who know the PIN
+		if !user.WhatsAppVerifiedAt.Valid {
+			return nil, appErrors.NewBadRequestError("whatsapp number is not verified", nil)
+		}
+
+		// Replace a legacy plaintext PIN with its hash
+		if needsRehash {
+			pinHash, err := hashPIN(credential)
+			if err != nil {
+				return nil, err
+			}
+			if err := uc.userRepo.RehashPIN(ctx, user.ID, user.PINHash, pinHash); err != nil {
+				return nil, err
+			}
 		}
 
 	default:
-		return nil, errors.New("invalid auth type")
+		return nil, appErrors.NewValidationError("invalid auth type", nil)
 	}
 
-	// Update last login time and reset failed login attempts
+	// Update last login time and reset failed login attempts and lockout
+	// backoff. The account may have been locked by concurrent failed attempts
+	// or deactivated since it was read, then the login is rejected.
 	now := time.Now()
+	if err := uc.userRepo.RecordLogin(ctx, user.ID, now); err != nil {
+		if errors.Is(err, domain.ErrLoginRejected) {
+			return nil, uc.loginRejected(ctx, user.ID)
+		}
+		return nil, err
+	}
 	user.LastLoginAt = sql.NullTime{Time: now, Valid: true}
 	user.FailedLoginAttempts = 0
+	user.LockoutCount = 0
+	user.LockedUntil = sql.NullTime{}
 	user.UpdatedAt = sql.NullTime{Time: now, Valid: true}
-	
-	if err := uc.userRepo.Update(ctx, user); err != nil {
-		return nil, err
-	}
 
 	// Clear sensitive data before returning
 	user.PasswordHash = ""
-	user.OTPPIN = ""
-	return user, nil
+	user.PINHash = ""
+
+	// Every login starts a new refresh token family
+	return uc.issueAuthToken(ctx, user, authType, uuid.New())
 }
@@ -0,0 +1,28 @@
+package user
+
+import (
+	"context"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+)
+
+// Logout revokes the refresh token and every token rotated from the same login
+func (uc *userUseCase) Logout(ctx context.Context, refreshToken string) error {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.Logout")
+	defer span.End()
+
+	// Validate input
+	if refreshToken == "" {
+		return appErrors.NewValidationError("refresh token is required", nil)
+	}
+
+	storedToken, err := uc.refreshTokenRepo.GetByTokenHash(ctx, uc.tokenService.HashRefreshToken(refreshToken))
+	if err != nil {
+		return err
+	}
+	if storedToken == nil {
+		return appErrors.NewUnauthorizedError("invalid refresh token", nil)
+	}
+
+	return uc.refreshTokenRepo.RevokeFamily(ctx, storedToken.FamilyID)
+}
@@ -0,0 +1,50 @@
+package user
+
+import (
+	"crypto/subtle"
+	appErrors "github/kijunpos/internal/pkg/errors"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// pinLength is the number of digits of a WhatsApp login PIN
+const pinLength = 6
+
+// validatePIN checks that a PIN consists of exactly six digits
+func validatePIN(pin string) error {
+	if len(pin) != pinLength {
+		return appErrors.NewValidationError("PIN must be 6 digits", nil)
+	}
+	for _, c := range pin {
+		if c < '0' || c > '9' {
+			return appErrors.NewValidationError("PIN must be 6 digits", nil)
+		}
+	}
+	return nil
+}
+
+// hashPIN hashes a PIN with the same KDF used for passwords
+func hashPIN(pin string) (string, error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(pin), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hashed), nil
+}
+
+// comparePIN checks a PIN against the stored value.
+// Rows written before PINs were hashed still hold the plaintext PIN; those are
+// compared in constant time and reported through needsRehash so the caller can
+// replace them with a hash after a successful login.
+func comparePIN(storedPIN, pin string) (match bool, needsRehash bool) {
+	if storedPIN == "" {
+		return false, false
+	}
+
+	if _, err := bcrypt.Cost([]byte(storedPIN)); err != nil {
+		// Legacy plaintext PIN
+		return subtle.ConstantTimeCompare([]byte(storedPIN), []byte(pin)) == 1, true
+	}
+
+	return bcrypt.CompareHashAndPassword([]byte(storedPIN), []byte(pin)) == nil, false
+}
@@ -0,0 +1,183 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"strings"
+	"time"
+)
+
+// RequestPINReset starts the "forgot PIN" flow for the account identified by a
+// WhatsApp number or email address. It returns the verified channels of the
+// account and, when a channel is given, sends a verification code through it.
+// Channels the account has not verified are rejected.
+func (uc *userUseCase) RequestPINReset(ctx context.Context, identifier string, channel domain.VerificationChannel) ([]domain.VerificationChannel, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.RequestPINReset")
+	defer span.End()
+
+	// Validate input
+	if identifier == "" {
+		return nil, appErrors.NewValidationError("identifier is required", nil)
+	}
+
+	user, err := uc.getPINUser(ctx, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	// Requests are limited per account, whichever identifier was used, and
+	// per identifier when it has no account. Listing the channels has its own
+	// key so that a code can be requested right after the channels were listed.
+	throttleKey := identifier
+	if user != nil {
+		throttleKey = user.ID.String()
+	}
+	if channel == "" {
+		throttleKey += ":channels"
+	}
+	if err := uc.throttleVerificationCode(ctx, domain.VerificationPurposePINReset, throttleKey); err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, appErrors.NewNotFoundError("no account with PIN login found for this identifier", nil)
+	}
+
+	channels := user.VerificationChannels()
+
+	// Without a channel the caller only asks which channels can be used
+	if channel == "" {
+		return channels, nil
+	}
+	if !hasChannel(channels, channel) {
+		return nil, appErrors.NewBadRequestError(fmt.Sprintf("verification channel %s is not available for this account", channel), nil)
+	}
+
+	// Generate verification code
+	verificationCode, err := generateVerificationCode()
+	if err != nil {
+		return nil, err
+	}
+
+	// PIN reset codes are keyed by user ID, whichever identifier was used
+	if err := uc.verificationRepo.StoreVerificationCode(ctx, domain.VerificationPurposePINReset, user.ID.String(), verificationCode, verificationCodeExpiration); err != nil {
+		return nil, fmt.Errorf("failed to store verification code: %w", err)
+	}
+
+	// Send the verification code in the background, a slow provider must not
+	// hold the request
+	go uc.sendPINResetCode(context.WithoutCancel(ctx), user, channel, verificationCode)
+
+	return channels, nil
+}
+
+// sendPINResetCode sends a PIN reset code through a channel of the user, the
+// code is deleted again when it cannot be sent
+func (uc *userUseCase) sendPINResetCode(ctx context.Context, user *domain.User, channel domain.VerificationChannel, verificationCode string) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.sendPINResetCode")
+	defer span.End()
+
+	var err error
+	switch channel {
+	case domain.VerificationChannelWhatsApp:
+		err = uc.whatsAppService.SendVerificationCode(ctx, user.WhatsAppNumber, verificationCode)
+	case domain.VerificationChannelEmail:
+		err = uc.emailService.SendVerificationCode(ctx, user.Email, verificationCode)
+	}
+	if err != nil {
+		apm.TraceError(ctx, fmt.Errorf("failed to send PIN reset code: %w", err))
+		_ = uc.verificationRepo.DeleteVerificationCode(ctx, domain.VerificationPurposePINReset, user.ID.String())
+	}
+}
+
+// VerifyPINReset verifies the PIN reset code and sets a new PIN for the user
+func (uc *userUseCase) VerifyPINReset(ctx context.Context, identifier, verificationCode, newPIN string) error {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.VerifyPINReset")
+	defer span.End()
+
+	// Validate input
+	if identifier == "" {
+		return appErrors.NewValidationError("identifier is required", nil)
+	}
+	if verificationCode == "" {
+		return appErrors.NewValidationError("verification code is required", nil)
+	}
+	if err := validatePIN(newPIN); err != nil {
+		return err
+	}
+
+	user, err := uc.getPINUser(ctx, identifier)
+	if err != nil {
+		return err
+	}
+	// Codes only exist for accounts that can use PIN login, other identifiers
+	// fail the same way as wrong codes
+	if user == nil {
+		return resetCodeError(domain.ErrVerificationCodeNotFound)
+	}
+
+	// Verify and consume the verification code
+	err = uc.verificationRepo.ConsumeVerificationCode(ctx, domain.VerificationPurposePINReset, user.ID.String(), verificationCode)
+	if err != nil {
+		return resetCodeError(err)
+	}
+
+	pinHash, err := hashPIN(newPIN)
+	if err != nil {
+		return err
+	}
+
+	// Proving access to a verified channel also lifts a lockout
+	user.PINHash = pinHash
+	user.FailedLoginAttempts = 0
+	user.LockoutCount = 0
+	user.LockedUntil = sql.NullTime{}
+	user.UpdatedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return err
+	}
+
+	// Sessions of whoever knew the old PIN end with the reset
+	if err := uc.refreshTokenRepo.RevokeAllByUserID(ctx, user.ID); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	return nil
+}
+
+// getPINUser looks up an active account with a verified WhatsApp number by
+// WhatsApp number or email, nil when there is none
+func (uc *userUseCase) getPINUser(ctx context.Context, identifier string) (*domain.User, error) {
+	var user *domain.User
+	var err error
+	if strings.Contains(identifier, "@") {
+		user, err = uc.userRepo.GetByEmail(ctx, identifier)
+	} else {
+		user, err = uc.userRepo.GetByWhatsAppNumber(ctx, identifier)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// PIN login is only available for active accounts with a verified WhatsApp number
+	if user == nil || !user.IsActive || user.WhatsAppNumber == "" || !user.WhatsAppVerifiedAt.Valid {
+		return nil, nil
+	}
+
+	return user, nil
+}
+
+// hasChannel reports whether channel is one of channels
+func hasChannel(channels []domain.VerificationChannel, channel domain.VerificationChannel) bool {
+	for _, c := range channels {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
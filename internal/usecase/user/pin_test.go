@@ -0,0 +1,62 @@
+package user
+
+import "testing"
+
+func TestValidatePIN(t *testing.T) {
+	tests := []struct {
+		name    string
+		pin     string
+		wantErr bool
+	}{
+		{name: "six digits", pin: "042519"},
+		{name: "all zeros", pin: "000000"},
+		{name: "five digits", pin: "12345", wantErr: true},
+		{name: "seven digits", pin: "1234567", wantErr: true},
+		{name: "letter", pin: "12345a", wantErr: true},
+		{name: "space", pin: "123 45", wantErr: true},
+		{name: "non-ASCII digit", pin: "1234٦", wantErr: true},
+		{name: "empty", pin: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validatePIN(tt.pin); (err != nil) != tt.wantErr {
+				t.Errorf("validatePIN(%q) error = %v, wantErr %v", tt.pin, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestComparePIN(t *testing.T) {
+	hashed, err := hashPIN("042519")
+	if err != nil {
+		t.Fatalf("hashPIN() error = %v", err)
+	}
+	if hashed == "042519" {
+		t.Fatal("hashPIN() returned the PIN")
+	}
+
+	tests := []struct {
+		name       string
+		storedPIN  string
+		pin        string
+		wantMatch  bool
+		wantRehash bool
+	}{
+		{name: "hashed match", storedPIN: hashed, pin: "042519", wantMatch: true},
+		{name: "hashed mismatch", storedPIN: hashed, pin: "042518"},
+		{name: "legacy plaintext match", storedPIN: "042519", pin: "042519", wantMatch: true, wantRehash: true},
+		{name: "legacy plaintext mismatch", storedPIN: "042519", pin: "042518", wantRehash: true},
+		{name: "hash used as PIN", storedPIN: hashed, pin: hashed},
+		{name: "no PIN set", storedPIN: "", pin: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, rehash := comparePIN(tt.storedPIN, tt.pin)
+			if match != tt.wantMatch || rehash != tt.wantRehash {
+				t.Errorf("comparePIN() = %v, %v, want %v, %v", match, rehash, tt.wantMatch, tt.wantRehash)
+			}
+		})
+	}
+}
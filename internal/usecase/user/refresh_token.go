@@ -0,0 +1,59 @@
+package user
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"time"
+)
+
+// RefreshToken exchanges a refresh token for a new token pair.
+// The presented token is revoked; presenting an already revoked token is treated
+// as token theft and revokes every token of the same login session.
+func (uc *userUseCase) RefreshToken(ctx context.Context, refreshToken string) (*domain.AuthToken, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.RefreshToken")
+	defer span.End()
+
+	// Validate input
+	if refreshToken == "" {
+		return nil, appErrors.NewValidationError("refresh token is required", nil)
+	}
+
+	storedToken, err := uc.refreshTokenRepo.GetByTokenHash(ctx, uc.tokenService.HashRefreshToken(refreshToken))
+	if err != nil {
+		return nil, err
+	}
+	if storedToken == nil {
+		return nil, appErrors.NewUnauthorizedError("invalid refresh token", nil)
+	}
+
+	if time.Now().After(storedToken.ExpiresAt) {
+		return nil, appErrors.NewUnauthorizedError("invalid refresh token", nil)
+	}
+
+	// Rotate the token, a token that was already revoked means it has been reused
+	revoked, err := uc.refreshTokenRepo.Revoke(ctx, storedToken.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !revoked {
+		if err := uc.refreshTokenRepo.RevokeFamily(ctx, storedToken.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, appErrors.NewUnauthorizedError("invalid refresh token", nil)
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, storedToken.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || !user.IsActive {
+		return nil, appErrors.NewUnauthorizedError("invalid refresh token", nil)
+	}
+
+	// Clear sensitive data before returning
+	user.PasswordHash = ""
+	user.PINHash = ""
+	return uc.issueAuthToken(ctx, user, storedToken.AuthType, storedToken.FamilyID)
+}
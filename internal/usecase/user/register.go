@@ -2,9 +2,9 @@ package user
 
 import (
 	"context"
-	"errors"
 	"github/kijunpos/internal/domain"
 	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,7 +18,7 @@ func (uc *userUseCase) Register(ctx context.Context, authType domain.AuthType, u
 
 	// Validate common input
 	if username == "" {
-		return nil, errors.New("username is required")
+		return nil, appErrors.NewValidationError("username is required", nil)
 	}
 
 	// Check if user with the same username already exists
@@ -27,7 +27,7 @@ func (uc *userUseCase) Register(ctx context.Context, authType domain.AuthType, u
 		return nil, err
 	}
 	if existingUser != nil {
-		return nil, errors.New("user with this username already exists")
+		return nil, appErrors.NewDuplicateError("user with this username already exists", nil)
 	}
 
 	// Create new user with common fields
@@ -44,7 +44,7 @@ func (uc *userUseCase) Register(ctx context.Context, authType domain.AuthType, u
 		// Get WhatsApp number from params
 		whatsAppNumber, ok := params["whatsapp_number"]
 		if !ok || whatsAppNumber == "" {
-			return nil, errors.New("whatsapp number is required")
+			return nil, appErrors.NewValidationError("whatsapp number is required", nil)
 		}
 
 		// Check if user with the same WhatsApp number already exists
@@ -53,26 +53,28 @@ func (uc *userUseCase) Register(ctx context.Context, authType domain.AuthType, u
 			return nil, err
 		}
 		if existingUser != nil {
-			return nil, errors.New("user with this WhatsApp number already exists")
+			return nil, appErrors.NewDuplicateError("user with this WhatsApp number already exists", nil)
 		}
 
-		// Generate a temporary PIN for the user
-		// In a real application, this would be sent to the user via WhatsApp
-		temporaryPIN := generateTemporaryPIN()
+		// Send a one-time code to the number, the account stays inactive
+		// until the code is confirmed through VerifyWhatsApp
+		if err := uc.sendWhatsAppVerificationCode(ctx, whatsAppNumber); err != nil {
+			return nil, err
+		}
 
 		// Set WhatsApp-specific fields
 		user.WhatsAppNumber = whatsAppNumber
-		user.OTPPIN = temporaryPIN
+		user.IsActive = false
 
 	case domain.AuthTypeEmail:
 		// Get email and password from params
 		email, ok := params["email"]
 		if !ok || email == "" {
-			return nil, errors.New("email is required")
+			return nil, appErrors.NewValidationError("email is required", nil)
 		}
 		password, ok := params["password"]
 		if !ok || password == "" {
-			return nil, errors.New("password is required")
+			return nil, appErrors.NewValidationError("password is required", nil)
 		}
 
 		// Check if user with the same email already exists
@@ -81,7 +83,7 @@ func (uc *userUseCase) Register(ctx context.Context, authType domain.AuthType, u
 			return nil, err
 		}
 		if existingUser != nil {
-			return nil, errors.New("user with this email already exists")
+			return nil, appErrors.NewDuplicateError("user with this email already exists", nil)
 		}
 
 		// Hash the password
@@ -95,7 +97,7 @@ func (uc *userUseCase) Register(ctx context.Context, authType domain.AuthType, u
 		user.PasswordHash = string(hashedPassword)
 
 	default:
-		return nil, errors.New("invalid registration type")
+		return nil, appErrors.NewValidationError("invalid registration type", nil)
 	}
 
 	// Create user in the database
@@ -105,14 +107,6 @@ func (uc *userUseCase) Register(ctx context.Context, authType domain.AuthType, u
 
 	// Clear sensitive data before returning
 	user.PasswordHash = ""
-	user.OTPPIN = ""
+	user.PINHash = ""
 	return user, nil
 }
-
-// generateTemporaryPIN generates a 6-digit PIN for WhatsApp authentication
-// In a real application, this would be more secure and sent to the user via WhatsApp
-func generateTemporaryPIN() string {
-	// For simplicity, we're using a fixed PIN here
-	// In a real application, you would generate a random PIN
-	return "123456"
-}
@@ -2,24 +2,33 @@ package user
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"github/kijunpos/internal/domain"
 	"github/kijunpos/internal/pkg/apm"
-	"math/rand"
+	appErrors "github/kijunpos/internal/pkg/errors"
 	"time"
 
 	"golang.org/x/crypto/bcrypt"
 )
 
-// ResetPassword initiates a password reset process for a user with the given email
-// Returns a verification code that would be sent to the user via email
+// ResetPassword initiates a password reset process for a user with the given email.
+// Known and unknown emails get the same answer in the same time so the response
+// cannot be used to find registered accounts. The verification code is only
+// returned in development mode.
 func (uc *userUseCase) ResetPassword(ctx context.Context, email string) (string, error) {
 	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.ResetPassword")
 	defer span.End()
 
 	// Validate input
 	if email == "" {
-		return "", errors.New("email is required")
+		return "", appErrors.NewValidationError("email is required", nil)
+	}
+
+	defer equalizeResponseTime(ctx, time.Now().Add(uc.verificationPolicy.MinResponseTime))
+
+	// Limit how often codes can be requested for the mailbox, whether it is registered or not
+	if err := uc.throttleVerificationCode(ctx, domain.VerificationPurposePasswordReset, email); err != nil {
+		return "", err
 	}
 
 	// Get user by email
@@ -27,33 +36,43 @@ func (uc *userUseCase) ResetPassword(ctx context.Context, email string) (string,
 	if err != nil {
 		return "", err
 	}
-	if user == nil {
-		return "", errors.New("user not found")
-	}
 
-	// Check if user is active
-	if !user.IsActive {
-		return "", errors.New("user account is not active")
+	// Unknown and inactive accounts silently get no code
+	if user == nil || !user.IsActive {
+		return "", nil
 	}
 
 	// Generate verification code
-	verificationCode := generateVerificationCode()
+	verificationCode, err := generateVerificationCode()
+	if err != nil {
+		return "", err
+	}
 
 	// Store the verification code with 10 minutes expiration
-	if err := uc.verificationRepo.StoreVerificationCode(ctx, email, verificationCode, 10*time.Minute); err != nil {
+	if err := uc.verificationRepo.StoreVerificationCode(ctx, domain.VerificationPurposePasswordReset, email, verificationCode, verificationCodeExpiration); err != nil {
 		return "", fmt.Errorf("failed to store verification code: %w", err)
 	}
 
-	// Send the verification code via email
-	if err := uc.emailService.SendVerificationCode(ctx, email, verificationCode); err != nil {
-		// If sending fails, delete the stored code to prevent inconsistency
-		_ = uc.verificationRepo.DeleteVerificationCode(ctx, email)
-		return "", fmt.Errorf("failed to send verification code: %w", err)
+	// Send the verification code in the background, the mail server must not
+	// make known accounts slower to answer
+	go uc.sendPasswordResetCode(context.WithoutCancel(ctx), email, verificationCode)
+
+	if uc.verificationPolicy.EchoCode {
+		return verificationCode, nil
 	}
+	return "", nil
+}
+
+// sendPasswordResetCode sends a password reset code by email, the code is
+// deleted again when it cannot be sent
+func (uc *userUseCase) sendPasswordResetCode(ctx context.Context, email, verificationCode string) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.sendPasswordResetCode")
+	defer span.End()
 
-	// In a production environment, we would not return the verification code
-	// But for testing purposes, we'll return it
-	return verificationCode, nil
+	if err := uc.emailService.SendVerificationCode(ctx, email, verificationCode); err != nil {
+		apm.TraceError(ctx, fmt.Errorf("failed to send password reset code: %w", err))
+		_ = uc.verificationRepo.DeleteVerificationCode(ctx, domain.VerificationPurposePasswordReset, email)
+	}
 }
 
 // VerifyPasswordReset verifies the reset code and sets a new password for the user
@@ -63,13 +82,20 @@ func (uc *userUseCase) VerifyPasswordReset(ctx context.Context, email, verificat
 
 	// Validate input
 	if email == "" {
-		return errors.New("email is required")
+		return appErrors.NewValidationError("email is required", nil)
 	}
 	if verificationCode == "" {
-		return errors.New("verification code is required")
+		return appErrors.NewValidationError("verification code is required", nil)
 	}
 	if newPassword == "" {
-		return errors.New("new password is required")
+		return appErrors.NewValidationError("new password is required", nil)
+	}
+
+	// Verify and consume the verification code first, codes only exist for
+	// registered emails so unknown emails fail the same way as wrong codes
+	err := uc.verificationRepo.ConsumeVerificationCode(ctx, domain.VerificationPurposePasswordReset, email, verificationCode)
+	if err != nil {
+		return resetCodeError(err)
 	}
 
 	// Get user by email
@@ -78,17 +104,7 @@ func (uc *userUseCase) VerifyPasswordReset(ctx context.Context, email, verificat
 		return err
 	}
 	if user == nil {
-		return errors.New("user not found")
-	}
-
-	// Verify the verification code
-	storedCode, err := uc.verificationRepo.GetVerificationCode(ctx, email)
-	if err != nil {
-		return fmt.Errorf("verification failed: %w", err)
-	}
-
-	if storedCode != verificationCode {
-		return errors.New("invalid verification code")
+		return resetCodeError(domain.ErrVerificationCodeNotFound)
 	}
 
 	// Hash the new password
@@ -109,23 +125,10 @@ func (uc *userUseCase) VerifyPasswordReset(ctx context.Context, email, verificat
 		return err
 	}
 
-	// Delete the verification code after successful password reset
-	if err := uc.verificationRepo.DeleteVerificationCode(ctx, email); err != nil {
-		// Just log the error, don't fail the operation
-		// In a real application, you would log this error
-		fmt.Printf("Failed to delete verification code: %v\n", err)
+	// Sessions of whoever knew the old password end with the reset
+	if err := uc.refreshTokenRepo.RevokeAllByUserID(ctx, user.ID); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
 	}
 
 	return nil
 }
-
-// generateVerificationCode generates a 6-digit verification code
-func generateVerificationCode() string {
-	// Initialize random number generator with current time
-	rand.Seed(time.Now().UnixNano())
-	
-	// Generate a random 6-digit number
-	code := rand.Intn(900000) + 100000
-	
-	return fmt.Sprintf("%d", code)
-}
@@ -0,0 +1,53 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"time"
+)
+
+// SetPIN sets the first WhatsApp login PIN of the authenticated user,
+// which is chosen after the registration code has been verified
+func (uc *userUseCase) SetPIN(ctx context.Context, pin string) error {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.SetPIN")
+	defer span.End()
+
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return appErrors.NewUnauthorizedError("unauthenticated", nil)
+	}
+
+	// Validate input
+	if err := validatePIN(pin); err != nil {
+		return err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, principal.UserID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return appErrors.NewNotFoundError("user not found", nil)
+	}
+
+	// A PIN is only usable together with a verified WhatsApp number
+	if user.WhatsAppNumber == "" || !user.WhatsAppVerifiedAt.Valid {
+		return appErrors.NewBadRequestError("whatsapp number is not verified", nil)
+	}
+	if user.PINHash != "" {
+		return appErrors.NewBadRequestError("PIN is already set, use ChangePIN instead", nil)
+	}
+
+	pinHash, err := hashPIN(pin)
+	if err != nil {
+		return err
+	}
+
+	user.PINHash = pinHash
+	user.UpdatedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	return uc.userRepo.Update(ctx, user)
+}
@@ -0,0 +1,84 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"time"
+)
+
+// dailyWindow is the window of the daily verification code limits
+const dailyWindow = 24 * time.Hour
+
+// throttleVerificationCode enforces the resend cooldown and the daily limits
+// before a verification code is sent to identifier
+func (uc *userUseCase) throttleVerificationCode(ctx context.Context, purpose domain.VerificationPurpose, identifier string) error {
+	key := fmt.Sprintf("verification:%s:%s", purpose, identifier)
+
+	hits, err := uc.rateLimitRepo.Hit(ctx, key+":cooldown", uc.verificationPolicy.ResendCooldown)
+	if err != nil {
+		return fmt.Errorf("failed to check rate limit: %w", err)
+	}
+	if hits > 1 {
+		return appErrors.NewTooManyRequestsError("please wait before requesting a new verification code", nil)
+	}
+
+	hits, err = uc.rateLimitRepo.Hit(ctx, key+":daily", dailyWindow)
+	if err != nil {
+		return fmt.Errorf("failed to check rate limit: %w", err)
+	}
+	if hits > uc.verificationPolicy.DailyLimit {
+		return appErrors.NewTooManyRequestsError("daily verification code limit reached, please try again tomorrow", nil)
+	}
+
+	// Calls without a known client IP are only limited per identifier
+	if ip, ok := domain.ClientIPFromContext(ctx); ok {
+		hits, err = uc.rateLimitRepo.Hit(ctx, "verification:ip:"+ip+":daily", dailyWindow)
+		if err != nil {
+			return fmt.Errorf("failed to check rate limit: %w", err)
+		}
+		if hits > uc.verificationPolicy.IPDailyLimit {
+			return appErrors.NewTooManyRequestsError("too many verification codes requested, please try again later", nil)
+		}
+	}
+
+	return nil
+}
+
+// verificationError maps an error of ConsumeVerificationCode to the error returned to the caller
+func verificationError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrVerificationCodeAttemptsExceeded):
+		return appErrors.NewTooManyRequestsError(err.Error(), err)
+	case errors.Is(err, domain.ErrVerificationCodeNotFound),
+		errors.Is(err, domain.ErrVerificationCodeExpired),
+		errors.Is(err, domain.ErrVerificationCodeInvalid):
+		// One message for every failure, it must not tell whether a code is pending
+		return appErrors.NewBadRequestError("invalid or expired verification code", err)
+	default:
+		return err
+	}
+}
+
+// resetCodeError maps an error of ConsumeVerificationCode on the public reset
+// paths. Codes locked by too many wrong guesses fail like wrong codes, because
+// only registered accounts have codes that can be locked.
+func resetCodeError(err error) error {
+	if errors.Is(err, domain.ErrVerificationCodeAttemptsExceeded) {
+		return appErrors.NewBadRequestError("invalid or expired verification code", err)
+	}
+	return verificationError(err)
+}
+
+// equalizeResponseTime waits until deadline so that every path of a request takes the same time
+func equalizeResponseTime(ctx context.Context, deadline time.Time) {
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+}
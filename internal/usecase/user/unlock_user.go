@@ -0,0 +1,31 @@
+package user
+
+import (
+	"context"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// UnlockUser clears the lockout state of a user, the caller needs the user.manage permission
+func (uc *userUseCase) UnlockUser(ctx context.Context, id uuid.UUID) error {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.UnlockUser")
+	defer span.End()
+
+	if err := uc.authorizationService.Authorize(ctx, domain.PermissionUserManage); err != nil {
+		return err
+	}
+
+	// Check if user exists
+	existingUser, err := uc.userRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existingUser == nil {
+		return appErrors.NewNotFoundError("user not found", nil)
+	}
+
+	return uc.userRepo.Unlock(ctx, id)
+}
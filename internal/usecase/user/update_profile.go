@@ -0,0 +1,108 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"time"
+)
+
+// UpdateProfile changes the username, email address or WhatsApp number of the
+// authenticated user. A changed email address or WhatsApp number is stored
+// unverified and a verification code is sent to it.
+func (uc *userUseCase) UpdateProfile(ctx context.Context, update domain.ProfileUpdate) (*domain.User, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.UpdateProfile")
+	defer span.End()
+
+	user, err := uc.getCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if update.UserName != nil && *update.UserName != user.UserName {
+		existingUser, err := uc.userRepo.GetByUsername(ctx, *update.UserName)
+		if err != nil {
+			return nil, err
+		}
+		if existingUser != nil {
+			return nil, appErrors.NewDuplicateError("user with this username already exists", nil)
+		}
+		user.UserName = *update.UserName
+	}
+
+	emailChanged := update.Email != nil && *update.Email != user.Email
+	if emailChanged {
+		existingUser, err := uc.userRepo.GetByEmail(ctx, *update.Email)
+		if err != nil {
+			return nil, err
+		}
+		if existingUser != nil {
+			return nil, appErrors.NewDuplicateError("user with this email already exists", nil)
+		}
+	}
+
+	whatsAppChanged := update.WhatsAppNumber != nil && *update.WhatsAppNumber != user.WhatsAppNumber
+	if whatsAppChanged {
+		existingUser, err := uc.userRepo.GetByWhatsAppNumber(ctx, *update.WhatsAppNumber)
+		if err != nil {
+			return nil, err
+		}
+		if existingUser != nil {
+			return nil, appErrors.NewDuplicateError("user with this WhatsApp number already exists", nil)
+		}
+	}
+
+	// Send the codes before saving so a failed send leaves the profile unchanged.
+	// The new number is confirmed through VerifyWhatsApp, the new email through VerifyEmail.
+	if whatsAppChanged {
+		if err := uc.sendWhatsAppVerificationCode(ctx, *update.WhatsAppNumber); err != nil {
+			return nil, err
+		}
+		user.WhatsAppNumber = *update.WhatsAppNumber
+		user.WhatsAppVerifiedAt = sql.NullTime{}
+	}
+	if emailChanged {
+		if err := uc.sendEmailVerificationCode(ctx, *update.Email); err != nil {
+			return nil, err
+		}
+		user.Email = *update.Email
+		user.EmailVerifiedAt = sql.NullTime{}
+	}
+
+	user.UpdatedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, err
+	}
+
+	// Clear sensitive data before returning
+	user.PasswordHash = ""
+	user.PINHash = ""
+	return user, nil
+}
+
+// sendEmailVerificationCode generates, stores and sends a one-time code to an email address
+func (uc *userUseCase) sendEmailVerificationCode(ctx context.Context, email string) error {
+	if err := uc.throttleVerificationCode(ctx, domain.VerificationPurposeEmailVerify, email); err != nil {
+		return err
+	}
+
+	verificationCode, err := generateVerificationCode()
+	if err != nil {
+		return err
+	}
+
+	if err := uc.verificationRepo.StoreVerificationCode(ctx, domain.VerificationPurposeEmailVerify, email, verificationCode, verificationCodeExpiration); err != nil {
+		return fmt.Errorf("failed to store verification code: %w", err)
+	}
+
+	if err := uc.emailService.SendVerificationCode(ctx, email, verificationCode); err != nil {
+		// If sending fails, delete the stored code to prevent inconsistency
+		_ = uc.verificationRepo.DeleteVerificationCode(ctx, domain.VerificationPurposeEmailVerify, email)
+		return fmt.Errorf("failed to send verification code: %w", err)
+	}
+
+	return nil
+}
@@ -3,9 +3,9 @@ package user
 import (
 	"context"
 	"database/sql"
-	"errors"
 	"github/kijunpos/internal/domain"
 	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
 	"time"
 )
 
@@ -14,13 +14,18 @@ func (uc *userUseCase) UpdateUser(ctx context.Context, user *domain.User) error
 	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.UpdateUser")
 	defer span.End()
 
+	// Only the user themselves or staff allowed to manage users may update the account
+	if err := uc.authorizeSelfOr(ctx, user.ID, domain.PermissionUserManage); err != nil {
+		return err
+	}
+
 	// Check if user exists
 	existingUser, err := uc.userRepo.GetByID(ctx, user.ID)
 	if err != nil {
 		return err
 	}
 	if existingUser == nil {
-		return errors.New("user not found")
+		return appErrors.NewNotFoundError("user not found", nil)
 	}
 
 	// Update timestamp
@@ -7,18 +7,39 @@ import (
 type userUseCase struct {
 	userRepo             domain.UserRepository
 	verificationRepo     domain.VerificationRepository
+	refreshTokenRepo     domain.RefreshTokenRepository
+	rateLimitRepo        domain.RateLimitRepository
 	emailService         domain.EmailService
+	whatsAppService      domain.WhatsAppService
+	tokenService         domain.TokenService
+	authorizationService domain.AuthorizationService
+	lockoutPolicy        domain.LockoutPolicy
+	verificationPolicy   domain.VerificationPolicy
 }
 
 // NewUserUseCase creates a new user use case
 func NewUserUseCase(
 	userRepo domain.UserRepository,
 	verificationRepo domain.VerificationRepository,
+	refreshTokenRepo domain.RefreshTokenRepository,
+	rateLimitRepo domain.RateLimitRepository,
 	emailService domain.EmailService,
+	whatsAppService domain.WhatsAppService,
+	tokenService domain.TokenService,
+	authorizationService domain.AuthorizationService,
+	lockoutPolicy domain.LockoutPolicy,
+	verificationPolicy domain.VerificationPolicy,
 ) domain.UserUseCase {
 	return &userUseCase{
-		userRepo:         userRepo,
-		verificationRepo: verificationRepo,
-		emailService:     emailService,
+		userRepo:             userRepo,
+		verificationRepo:     verificationRepo,
+		refreshTokenRepo:     refreshTokenRepo,
+		rateLimitRepo:        rateLimitRepo,
+		emailService:         emailService,
+		whatsAppService:      whatsAppService,
+		tokenService:         tokenService,
+		authorizationService: authorizationService,
+		lockoutPolicy:        lockoutPolicy,
+		verificationPolicy:   verificationPolicy,
 	}
 }
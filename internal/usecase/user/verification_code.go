@@ -0,0 +1,20 @@
+package user
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// verificationCodeExpiration is how long a verification code stays valid
+const verificationCodeExpiration = 10 * time.Minute
+
+// generateVerificationCode generates a cryptographically random 6-digit code
+func generateVerificationCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", fmt.Errorf("failed to generate verification code: %w", err)
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
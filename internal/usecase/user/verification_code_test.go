@@ -0,0 +1,26 @@
+package user
+
+import "testing"
+
+func TestGenerateVerificationCode(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		code, err := generateVerificationCode()
+		if err != nil {
+			t.Fatalf("generateVerificationCode() error = %v", err)
+		}
+		if len(code) != 6 {
+			t.Fatalf("generateVerificationCode() = %q, want 6 digits", code)
+		}
+		for _, c := range code {
+			if c < '0' || c > '9' {
+				t.Fatalf("generateVerificationCode() = %q, want 6 digits", code)
+			}
+		}
+		seen[code] = true
+	}
+	// 100 draws from a million codes repeat with a chance of about 0.5%
+	if len(seen) < 98 {
+		t.Errorf("generateVerificationCode() returned %d distinct codes in 100 calls", len(seen))
+	}
+}
@@ -0,0 +1,44 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"time"
+)
+
+// VerifyEmail confirms the code sent to the email address of the authenticated user
+func (uc *userUseCase) VerifyEmail(ctx context.Context, verificationCode string) error {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.VerifyEmail")
+	defer span.End()
+
+	// Validate input
+	if verificationCode == "" {
+		return appErrors.NewValidationError("verification code is required", nil)
+	}
+
+	user, err := uc.getCurrentUser(ctx)
+	if err != nil {
+		return err
+	}
+	if user.Email == "" {
+		return appErrors.NewBadRequestError("account has no email address", nil)
+	}
+	if user.EmailVerifiedAt.Valid {
+		return appErrors.NewBadRequestError("email address is already verified", nil)
+	}
+
+	// Verify and consume the verification code
+	err = uc.verificationRepo.ConsumeVerificationCode(ctx, domain.VerificationPurposeEmailVerify, user.Email, verificationCode)
+	if err != nil {
+		return verificationError(err)
+	}
+
+	now := time.Now()
+	user.EmailVerifiedAt = sql.NullTime{Time: now, Valid: true}
+	user.UpdatedAt = sql.NullTime{Time: now, Valid: true}
+
+	return uc.userRepo.Update(ctx, user)
+}
@@ -0,0 +1,97 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github/kijunpos/internal/domain"
+	"github/kijunpos/internal/pkg/apm"
+	appErrors "github/kijunpos/internal/pkg/errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VerifyWhatsApp confirms the one-time code sent to a new WhatsApp number and
+// signs the user in. The code sent on registration activates the account, a
+// number changed later is only marked verified, so an account deactivated by
+// an administrator stays inactive.
+func (uc *userUseCase) VerifyWhatsApp(ctx context.Context, whatsAppNumber, verificationCode string) (*domain.AuthToken, error) {
+	ctx, span := apm.GetTracer().Start(ctx, "usecase.user.VerifyWhatsApp")
+	defer span.End()
+
+	// Validate input
+	if whatsAppNumber == "" {
+		return nil, appErrors.NewValidationError("whatsapp number is required", nil)
+	}
+	if verificationCode == "" {
+		return nil, appErrors.NewValidationError("verification code is required", nil)
+	}
+
+	// Get user by WhatsApp number
+	user, err := uc.userRepo.GetByWhatsAppNumber(ctx, whatsAppNumber)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, appErrors.NewBadRequestError("invalid WhatsApp number or verification code", nil)
+	}
+	if user.WhatsAppVerifiedAt.Valid {
+		return nil, appErrors.NewBadRequestError("whatsapp number is already verified", nil)
+	}
+
+	// Verify and consume the verification code
+	err = uc.verificationRepo.ConsumeVerificationCode(ctx, domain.VerificationPurposeWhatsAppVerify, whatsAppNumber, verificationCode)
+	if err != nil {
+		return nil, verificationError(err)
+	}
+
+	now := time.Now()
+	if user.AwaitsRegistration() {
+		// Activate the newly registered account
+		user.IsActive = true
+	}
+	user.WhatsAppVerifiedAt = sql.NullTime{Time: now, Valid: true}
+	if user.IsActive {
+		user.LastLoginAt = sql.NullTime{Time: now, Valid: true}
+	}
+	user.UpdatedAt = sql.NullTime{Time: now, Valid: true}
+
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return nil, err
+	}
+	if !user.IsActive {
+		return nil, appErrors.NewForbiddenError("user account is not active", nil)
+	}
+
+	// Clear sensitive data before returning
+	user.PasswordHash = ""
+	user.PINHash = ""
+
+	// The verified user is signed in right away
+	return uc.issueAuthToken(ctx, user, domain.AuthTypeWhatsApp, uuid.New())
+}
+
+// sendWhatsAppVerificationCode generates, stores and sends a one-time code to a WhatsApp number
+func (uc *userUseCase) sendWhatsAppVerificationCode(ctx context.Context, whatsAppNumber string) error {
+	if err := uc.throttleVerificationCode(ctx, domain.VerificationPurposeWhatsAppVerify, whatsAppNumber); err != nil {
+		return err
+	}
+
+	verificationCode, err := generateVerificationCode()
+	if err != nil {
+		return err
+	}
+
+	if err := uc.verificationRepo.StoreVerificationCode(ctx, domain.VerificationPurposeWhatsAppVerify, whatsAppNumber, verificationCode, verificationCodeExpiration); err != nil {
+		return fmt.Errorf("failed to store verification code: %w", err)
+	}
+
+	if err := uc.whatsAppService.SendVerificationCode(ctx, whatsAppNumber, verificationCode); err != nil {
+		// If sending fails, delete the stored code to prevent inconsistency
+		_ = uc.verificationRepo.DeleteVerificationCode(ctx, domain.VerificationPurposeWhatsAppVerify, whatsAppNumber)
+		return fmt.Errorf("failed to send verification code: %w", err)
+	}
+
+	return nil
+}
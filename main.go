@@ -3,6 +3,8 @@ package main
 import (
 	"github/kijunpos/internal/app"
 	"log"
+	// Outlet time zones load on images without a time zone database
+	_ "time/tzdata"
 )
 
 func main() {